@@ -127,6 +127,7 @@ import (
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	membership "github.com/noria-net/module-membership/x/membership"
+	membershipbindings "github.com/noria-net/module-membership/x/membership/bindings"
 	membershipclient "github.com/noria-net/module-membership/x/membership/client"
 	membershipkeeper "github.com/noria-net/module-membership/x/membership/keeper"
 	membershiptypes "github.com/noria-net/module-membership/x/membership/types"
@@ -202,6 +203,9 @@ func getGovProposalHandlers() []govclient.ProposalHandler {
 		membershipclient.AddGuardiansProposalHandler,
 		membershipclient.RemoveGuardiansProposalHandler,
 		membershipclient.UpdateTotalVotingWeightProposalHandler,
+		membershipclient.SetVotingWeightDecayProposalHandler,
+		membershipclient.PauseVotingWeightDecayProposalHandler,
+		membershipclient.UpdateGuardianWeightsProposalHandler,
 		// this line is used by starport scaffolding # stargate/app/govProposalHandler
 	)
 
@@ -470,12 +474,6 @@ func NewWasmApp(
 
 	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, keys[feegrant.StoreKey], app.AccountKeeper)
 
-	// register the staking hooks
-	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
-	app.StakingKeeper.SetHooks(
-		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
-	)
-
 	app.AuthzKeeper = authzkeeper.NewKeeper(keys[authzkeeper.StoreKey], appCodec, app.MsgServiceRouter(), app.AccountKeeper)
 
 	groupConfig := group.DefaultConfig()
@@ -607,6 +605,13 @@ func NewWasmApp(
 		panic(fmt.Sprintf("error while reading wasm config: %s", err))
 	}
 
+	// Let contracts query the membership module, and send it messages.
+	// Options passed in by the caller come last, so that they can override
+	// these plugins.
+	membershipOpts := membershipbindings.RegisterQueryPlugins(&app.MembershipKeeper, app.GRPCQueryRouter(), appCodec)
+	membershipOpts = append(membershipOpts, membershipbindings.RegisterMessageEncoders()...)
+	wasmOpts = append(membershipOpts, wasmOpts...)
+
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
 	availableCapabilities := strings.Join(AllCapabilities(), ",")
@@ -668,8 +673,19 @@ func NewWasmApp(
 		keys[membershiptypes.MemStoreKey],
 		app.GetSubspace(membershiptypes.ModuleName),
 		app.AccountKeeper,
+		app.BankKeeper,
 		extendedGovKeeper,
+		app.GroupKeeper,
+		app.StakingKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+
+	// register the staking hooks
+	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
+	app.StakingKeeper.SetHooks(
+		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks(), app.MembershipKeeper.StakingHooks()),
+	)
+
 	membershipModule := membership.NewAppModule(appCodec,
 		app.MembershipKeeper,
 		app.AccountKeeper,
@@ -918,6 +934,7 @@ func (app *WasmApp) setAnteHandler(txConfig client.TxConfig, wasmConfig wasmtype
 			IBCKeeper:         app.IBCKeeper,
 			WasmConfig:        &wasmConfig,
 			TXCounterStoreKey: txCounterStoreKey,
+			MembershipKeeper:  app.MembershipKeeper,
 		},
 	)
 	if err != nil {
@@ -0,0 +1,58 @@
+package e2e_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/noria-net/module-membership/tests/e2e"
+	"github.com/noria-net/module-membership/x/membership/bindings"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+func TestMembershipMessagesByContract(t *testing.T) {
+	wasmApp, ctx, member, _, _ := setupMembers(t)
+	k := wasmApp.MembershipKeeper
+
+	// A reflect contract to enroll, and one to act as a guardian
+	enrollee := e2e.InstantiateReflectContractInApp(t, wasmApp, ctx, member, "enrollee")
+	guardian := e2e.InstantiateReflectContractInApp(t, wasmApp, ctx, member, "guardian")
+	reflect := func(contract sdk.AccAddress, msg bindings.MembershipMsg) error {
+		return e2e.ExecViaReflectContractInApp(t, wasmApp, ctx, contract, member, e2e.ReflectMembershipMsg(t, msg))
+	}
+
+	// The contract enrolls itself
+	require.NoError(t, reflect(enrollee, bindings.MembershipMsg{Enroll: &bindings.EnrollMsg{Nickname: "kyc"}}))
+	m, found := k.GetMemberAccount(ctx, enrollee)
+	require.True(t, found)
+	require.Equal(t, types.MembershipStatus_MemberStatusPendingApproval, m.Status)
+	require.Equal(t, "kyc", k.GetMemberNickname(ctx, enrollee))
+
+	// Only guardians can approve members
+	err := reflect(guardian, bindings.MembershipMsg{ApproveMember: &bindings.ApproveMemberMsg{Member: enrollee.String()}})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	require.NoError(t, k.AppendMember(ctx, guardian))
+	require.NoError(t, k.UpdateMemberStatus(ctx, guardian, types.MembershipStatus_MemberElectorate))
+	require.NoError(t, k.SetMemberGuardianStatus(ctx, guardian, true))
+	dd := k.GetDirectDemocracySettings(ctx)
+	dd.Guardians = append(dd.Guardians, guardian.String())
+	k.SetDirectDemocracySettings(ctx, dd)
+
+	require.NoError(t, reflect(guardian, bindings.MembershipMsg{ApproveMember: &bindings.ApproveMemberMsg{Member: enrollee.String()}}))
+	m, _ = k.GetMemberAccount(ctx, enrollee)
+	require.Equal(t, types.MembershipStatus_MemberElectorate, m.Status)
+
+	// Only guardians can update member statuses
+	deactivate := bindings.MembershipMsg{UpdateStatus: &bindings.UpdateStatusMsg{Address: enrollee.String(), Status: "inactive"}}
+	err = reflect(enrollee, deactivate)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	m, _ = k.GetMemberAccount(ctx, enrollee)
+	require.Equal(t, types.MembershipStatus_MemberElectorate, m.Status)
+
+	require.NoError(t, reflect(guardian, deactivate))
+	m, _ = k.GetMemberAccount(ctx, enrollee)
+	require.Equal(t, types.MembershipStatus_MemberInactive, m.Status)
+}
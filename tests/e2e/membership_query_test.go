@@ -0,0 +1,113 @@
+package e2e_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/noria-net/module-membership/app"
+	"github.com/noria-net/module-membership/tests/e2e"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/noria-net/module-membership/x/membership/bindings"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+// setupMembers creates a membership app with an electorate member, a pending
+// member and a guardian
+func setupMembers(t *testing.T) (*app.WasmApp, sdk.Context, sdk.AccAddress, sdk.AccAddress, sdk.AccAddress) {
+	wasmApp := e2e.SetupMembershipApp(t)
+	ctx := wasmApp.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now()})
+	k := wasmApp.MembershipKeeper
+
+	var addrs []sdk.AccAddress
+	for i := 0; i < 3; i++ {
+		addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+		require.NoError(t, k.AppendMember(ctx, addr))
+		addrs = append(addrs, addr)
+	}
+	member, pending, guardian := addrs[0], addrs[1], addrs[2]
+	require.NoError(t, k.UpdateMemberStatus(ctx, member, types.MembershipStatus_MemberElectorate))
+	require.NoError(t, k.UpdateMemberStatus(ctx, guardian, types.MembershipStatus_MemberElectorate))
+	k.SetMemberNickname(ctx, member, "alice")
+
+	require.NoError(t, k.SetMemberGuardianStatus(ctx, guardian, true))
+	dd := types.DefaultDirectDemocracy()
+	dd.Guardians = []string{guardian.String()}
+	k.SetDirectDemocracySettings(ctx, &dd)
+
+	return wasmApp, ctx, member, pending, guardian
+}
+
+func TestMembershipQueriesByContract(t *testing.T) {
+	wasmApp, ctx, member, pending, guardian := setupMembers(t)
+	contractAddr := e2e.InstantiateReflectContractInApp(t, wasmApp, ctx, member, "reflect")
+	outsider := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	query := func(q bindings.MembershipQuery, res interface{}) error {
+		bz, err := e2e.QueryViaReflectContractInApp(t, wasmApp, ctx, contractAddr, e2e.ReflectMembershipQuery(t, q))
+		if err != nil {
+			return err
+		}
+		require.NoError(t, json.Unmarshal(bz, res))
+		return nil
+	}
+
+	var isMember bindings.IsMemberResponse
+	require.NoError(t, query(bindings.MembershipQuery{IsMember: &bindings.IsMemberQuery{Address: member.String()}}, &isMember))
+	require.Equal(t, bindings.IsMemberResponse{IsMember: true, Electorate: true}, isMember)
+	require.NoError(t, query(bindings.MembershipQuery{IsMember: &bindings.IsMemberQuery{Address: pending.String()}}, &isMember))
+	require.Equal(t, bindings.IsMemberResponse{IsMember: true}, isMember)
+	require.NoError(t, query(bindings.MembershipQuery{IsMember: &bindings.IsMemberQuery{Address: outsider.String()}}, &isMember))
+	require.Equal(t, bindings.IsMemberResponse{}, isMember)
+
+	var m bindings.MemberResponse
+	require.NoError(t, query(bindings.MembershipQuery{Member: &bindings.MemberQuery{Address: member.String()}}, &m))
+	require.Equal(t, bindings.Member{Address: member.String(), Nickname: "alice", Status: "electorate"}, m.Member)
+	require.NoError(t, query(bindings.MembershipQuery{Member: &bindings.MemberQuery{Address: guardian.String()}}, &m))
+	require.True(t, m.Member.IsGuardian)
+	require.Error(t, query(bindings.MembershipQuery{Member: &bindings.MemberQuery{Address: outsider.String()}}, &m))
+
+	var guardians bindings.GuardiansResponse
+	require.NoError(t, query(bindings.MembershipQuery{Guardians: &bindings.GuardiansQuery{}}, &guardians))
+	require.Equal(t, []bindings.Guardian{{Address: guardian.String(), Weight: types.DefaultGuardianWeight}}, guardians.Guardians)
+
+	var count bindings.MemberStatusCountResponse
+	require.NoError(t, query(bindings.MembershipQuery{MemberStatusCount: &bindings.MemberStatusCountQuery{Status: "electorate"}}, &count))
+	require.Equal(t, uint64(2), count.Count)
+	require.Error(t, query(bindings.MembershipQuery{MemberStatusCount: &bindings.MemberStatusCountQuery{Status: "unknown"}}, &count))
+
+	var dd bindings.DirectDemocracyResponse
+	require.NoError(t, query(bindings.MembershipQuery{DirectDemocracy: &bindings.DirectDemocracyQuery{}}, &dd))
+	require.Equal(t, types.DefaultDirectDemocracy().TotalVotingWeight.String(), dd.TotalVotingWeight)
+	require.Equal(t, guardians.Guardians, dd.Guardians)
+}
+
+func TestMembershipStargateQueriesByContract(t *testing.T) {
+	wasmApp, ctx, member, _, _ := setupMembers(t)
+	contractAddr := e2e.InstantiateReflectContractInApp(t, wasmApp, ctx, member, "reflect")
+
+	stargate := func(path string, request interface{ Marshal() ([]byte, error) }) ([]byte, error) {
+		data, err := request.Marshal()
+		require.NoError(t, err)
+		return e2e.QueryViaReflectContractInApp(t, wasmApp, ctx, contractAddr, wasmvmtypes.QueryRequest{
+			Stargate: &wasmvmtypes.StargateQuery{Path: path, Data: data},
+		})
+	}
+
+	// Membership queries on the accept list are answered
+	bz, err := stargate("/membershipmodule.membership.Query/Member", &types.QueryMemberRequest{Address: member.String()})
+	require.NoError(t, err)
+	var res types.QueryMemberResponse
+	require.NoError(t, wasmApp.AppCodec().UnmarshalJSON(bz, &res))
+	require.Equal(t, member.String(), res.Member.Address)
+	require.Equal(t, types.MembershipStatus_MemberElectorate, res.Member.Status)
+
+	// Paginated queries are not
+	_, err = stargate("/membershipmodule.membership.Query/Members", &types.QueryMembersRequest{})
+	require.ErrorContains(t, err, "Unsupported query")
+}
@@ -4,19 +4,24 @@ import (
 	"encoding/json"
 	"testing"
 
+	errorsmod "cosmossdk.io/errors"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 
 	"github.com/CosmWasm/wasmd/x/wasm/ibctesting"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	"github.com/CosmWasm/wasmd/x/wasm/keeper/testdata"
 	"github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/noria-net/module-membership/app"
+	"github.com/noria-net/module-membership/x/membership/bindings"
 )
 
 // InstantiateReflectContract store and instantiate a reflect contract instance
 func InstantiateReflectContract(t *testing.T, chain *ibctesting.TestChain) sdk.AccAddress {
-	codeID := chain.StoreCodeFile("../../x/wasm/keeper/testdata/reflect_1_1.wasm").CodeID
+	codeID := chain.StoreCode(testdata.ReflectContractWasm()).CodeID
 	contractAddr := chain.InstantiateContract(codeID, []byte(`{}`))
 	require.NotEmpty(t, contractAddr)
 	return contractAddr
@@ -66,3 +71,100 @@ func ExecViaReflectContract(t *testing.T, chain *ibctesting.TestChain, contractA
 	}
 	return chain.SendMsgs(execMsg)
 }
+
+// ReflectCustomMsg is the custom message of the reflect contract, which
+// passes on the raw bytes it is given
+type ReflectCustomMsg struct {
+	Raw []byte `json:"raw,omitempty"`
+}
+
+// ReflectCustomQuery is the custom query of the reflect contract. The text
+// of a capitalized query is passed on as it is.
+type ReflectCustomQuery struct {
+	Capitalized *testdata.Text `json:"capitalized,omitempty"`
+}
+
+// SetupMembershipApp creates a membership app in which reflect contracts can
+// send membership custom messages and queries, wrapped in the reflect
+// contract's own custom types
+func SetupMembershipApp(t *testing.T) *app.WasmApp {
+	var wasmApp *app.WasmApp
+	encoders := wasmkeeper.WithMessageEncoders(&wasmkeeper.MessageEncoders{
+		Custom: func(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error) {
+			var custom ReflectCustomMsg
+			if err := json.Unmarshal(msg, &custom); err != nil {
+				return nil, errorsmod.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+			}
+			return bindings.CustomEncoder(sender, custom.Raw)
+		},
+	})
+	queriers := wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
+		Custom: func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+			var custom ReflectCustomQuery
+			if err := json.Unmarshal(request, &custom); err != nil || custom.Capitalized == nil {
+				return nil, errorsmod.Wrap(sdkerrors.ErrJSONUnmarshal, "expected a capitalized query")
+			}
+			return bindings.CustomQuerier(&wasmApp.MembershipKeeper)(ctx, []byte(custom.Capitalized.Text))
+		},
+	})
+	wasmApp = app.Setup(t, encoders, queriers)
+	return wasmApp
+}
+
+// InstantiateReflectContractInApp store and instantiate a reflect contract
+// instance in a membership app, owned by the creator
+func InstantiateReflectContractInApp(t *testing.T, wasmApp *app.WasmApp, ctx sdk.Context, creator sdk.AccAddress, label string) sdk.AccAddress {
+	contractKeeper := wasmkeeper.NewGovPermissionKeeper(wasmApp.WasmKeeper)
+	codeID, _, err := contractKeeper.Create(ctx, creator, testdata.ReflectContractWasm(), nil)
+	require.NoError(t, err)
+	contractAddr, _, err := contractKeeper.Instantiate(ctx, codeID, creator, creator, []byte(`{}`), label, nil)
+	require.NoError(t, err)
+	return contractAddr
+}
+
+// ExecViaReflectContractInApp has the owner of a reflect contract send the
+// payload through it
+func ExecViaReflectContractInApp(t *testing.T, wasmApp *app.WasmApp, ctx sdk.Context, contractAddr sdk.AccAddress, owner sdk.AccAddress, msgs ...wasmvmtypes.CosmosMsg) error {
+	require.NotEmpty(t, msgs)
+	reflectSendBz, err := json.Marshal(testdata.ReflectHandleMsg{
+		Reflect: &testdata.ReflectPayload{Msgs: msgs},
+	})
+	require.NoError(t, err)
+	contractKeeper := wasmkeeper.NewGovPermissionKeeper(wasmApp.WasmKeeper)
+	_, err = contractKeeper.Execute(ctx, contractAddr, owner, reflectSendBz, nil)
+	return err
+}
+
+// QueryViaReflectContractInApp has a reflect contract run the query against
+// the chain, and returns the chain's response
+func QueryViaReflectContractInApp(t *testing.T, wasmApp *app.WasmApp, ctx sdk.Context, contractAddr sdk.AccAddress, request wasmvmtypes.QueryRequest) ([]byte, error) {
+	reflectQueryBz, err := json.Marshal(testdata.ReflectQueryMsg{
+		Chain: &testdata.ChainQuery{Request: &request},
+	})
+	require.NoError(t, err)
+	bz, err := wasmApp.WasmKeeper.QuerySmart(ctx, contractAddr, reflectQueryBz)
+	if err != nil {
+		return nil, err
+	}
+	var chainRes testdata.ChainResponse
+	require.NoError(t, json.Unmarshal(bz, &chainRes))
+	return chainRes.Data, nil
+}
+
+// ReflectMembershipMsg wraps a membership message for the reflect contract
+func ReflectMembershipMsg(t *testing.T, msg bindings.MembershipMsg) wasmvmtypes.CosmosMsg {
+	raw, err := bindings.EncodeMsg(msg)
+	require.NoError(t, err)
+	custom, err := json.Marshal(ReflectCustomMsg{Raw: raw})
+	require.NoError(t, err)
+	return wasmvmtypes.CosmosMsg{Custom: custom}
+}
+
+// ReflectMembershipQuery wraps a membership query for the reflect contract
+func ReflectMembershipQuery(t *testing.T, q bindings.MembershipQuery) wasmvmtypes.QueryRequest {
+	raw, err := bindings.EncodeQuery(q)
+	require.NoError(t, err)
+	custom, err := json.Marshal(ReflectCustomQuery{Capitalized: &testdata.Text{Text: string(raw)}})
+	require.NoError(t, err)
+	return wasmvmtypes.QueryRequest{Custom: custom}
+}
@@ -0,0 +1,45 @@
+package e2e_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/stretchr/testify/require"
+
+	"github.com/noria-net/module-membership/x/membership/keeper"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+func TestEnableSecretBallotOnSubmission(t *testing.T) {
+	// Given a proposer and another electorate member
+	// When  the proposer submits a proposal and enables its secret ballot in
+	//       the same transaction, without knowing its ID
+	// Then  the proposal has a secret ballot
+	// And   nobody else can make the latest proposal secret
+
+	wasmApp, ctx, member, _, guardian := setupMembers(t)
+	govServer := govkeeper.NewMsgServerImpl(&wasmApp.GovKeeper)
+	membershipServer := keeper.NewMsgServerImpl(wasmApp.MembershipKeeper)
+
+	submit := func(ctx sdk.Context, proposer sdk.AccAddress) uint64 {
+		msg, err := v1.NewMsgSubmitProposal(nil, nil, proposer.String(), "", "my proposal", "testing")
+		require.NoError(t, err)
+		rsp, err := govServer.SubmitProposal(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+		return rsp.ProposalId
+	}
+
+	txCtx, write := ctx.CacheContext()
+	proposalID := submit(txCtx, member)
+	_, err := membershipServer.EnableSecretBallot(sdk.WrapSDKContext(txCtx), types.NewMsgEnableSecretBallot(member.String(), 0))
+	require.NoError(t, err)
+	write()
+	require.True(t, wasmApp.MembershipKeeper.IsSecretBallot(ctx, proposalID))
+
+	otherID := submit(ctx, member)
+	_, err = membershipServer.EnableSecretBallot(sdk.WrapSDKContext(ctx), types.NewMsgEnableSecretBallot(guardian.String(), 0))
+	require.ErrorIs(t, err, types.ErrInvalidSecretBallot)
+	require.False(t, wasmApp.MembershipKeeper.IsSecretBallot(ctx, otherID))
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// mockAccountKeeper is an in-memory stand-in for the auth module's account
+// keeper, so that members can be enrolled in keeper tests
+type mockAccountKeeper struct {
+	accounts      map[string]authtypes.AccountI
+	nextAccNumber uint64
+}
+
+func newMockAccountKeeper() *mockAccountKeeper {
+	return &mockAccountKeeper{
+		accounts: make(map[string]authtypes.AccountI),
+	}
+}
+
+func (ak *mockAccountKeeper) GetAccount(_ sdk.Context, addr sdk.AccAddress) authtypes.AccountI {
+	return ak.accounts[addr.String()]
+}
+
+func (ak *mockAccountKeeper) HasAccount(_ sdk.Context, addr sdk.AccAddress) bool {
+	_, ok := ak.accounts[addr.String()]
+	return ok
+}
+
+func (ak *mockAccountKeeper) NewAccountWithAddress(_ sdk.Context, addr sdk.AccAddress) authtypes.AccountI {
+	acc := authtypes.NewBaseAccountWithAddress(addr)
+	acc.AccountNumber = ak.nextAccNumber
+	ak.nextAccNumber++
+	return acc
+}
+
+func (ak *mockAccountKeeper) SetAccount(_ sdk.Context, acc authtypes.AccountI) {
+	ak.accounts[acc.GetAddress().String()] = acc
+}
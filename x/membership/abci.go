@@ -19,12 +19,47 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 	keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal v1.Proposal) (stop bool) {
 		return processActiveProposal(ctx, keeper, proposal)
 	})
+
+	// close recall petitions that expired before meeting their threshold
+	keeper.PruneExpiredRecallPetitions(ctx)
+
+	// finalize expulsions whose appeal windows have closed
+	keeper.FinalizeExpiredExpulsionAppeals(ctx)
+
+	// lift suspensions that have reached their end time
+	keeper.RestoreExpiredSuspensions(ctx)
+
+	// revoke guardianship from guardians who have left the electorate
+	keeper.ReconcileGuardians(ctx)
+
+	// revoke guardianship from guardians whose terms have ended
+	keeper.ExpireGuardianTerms(ctx)
+
+	// lower the guardians' total voting weight on schedule
+	keeper.ApplyVotingWeightDecay(ctx)
+
+	// open and close guardian elections
+	keeper.ProcessElections(ctx)
+
+	// stream treasury spends to their recipients
+	keeper.PayOutTreasuryStreams(ctx)
+
+	// owe each electorate member their share of the dividend epoch
+	keeper.DistributeMemberDividend(ctx)
+
+	// mirror the block's changes to the electorate in the electorate group
+	keeper.SyncElectorateGroup(ctx)
 }
 
 func processActiveProposal(ctx sdk.Context, keeper *keeper.Keeper, proposal v1.Proposal) (stop bool) {
 	logger := keeper.Logger(ctx)
 	var tagValue, logMsg string
 
+	// secret ballots are only tallied once their votes have been revealed
+	if keeper.OpenRevealWindow(ctx, proposal) {
+		return false
+	}
+
 	passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
 
 	if burnDeposits {
@@ -88,6 +123,9 @@ func processActiveProposal(ctx sdk.Context, keeper *keeper.Keeper, proposal v1.P
 	keeper.SetProposal(ctx, proposal)
 	keeper.RemoveFromActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
 
+	// clean up any membership state tied to this proposal
+	keeper.OnProposalFinalized(ctx, proposal.Id)
+
 	// when proposal become active
 	keeper.GovHooks().AfterProposalVotingPeriodEnded(ctx, proposal.Id)
 
@@ -0,0 +1,124 @@
+package ante
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govtypes_v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govtypes_v1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+// MembershipKeeper defines the membership keeper methods needed by the ante decorators
+type MembershipKeeper interface {
+	GetMemberAccount(ctx sdk.Context, address sdk.AccAddress) (types.Member, bool)
+	RestrictGovMessages(ctx sdk.Context) bool
+	FeeWaiverMsgTypes(ctx sdk.Context) []string
+	ConsumeFeeWaiver(ctx sdk.Context, member sdk.AccAddress) bool
+	FeeWaiverMaxGas(ctx sdk.Context) uint64
+	WasmAccessRole(ctx sdk.Context) types.WasmAccessRole
+	IsGuardian(ctx sdk.Context, addr sdk.AccAddress) bool
+	RestrictValidators(ctx sdk.Context) bool
+	IsSecretBallot(ctx sdk.Context, proposalID uint64) bool
+}
+
+// MembershipGovDecorator rejects governance proposals and votes from signers
+// who are not electorate members, as well as public votes on secret ballot
+// proposals, all of which would otherwise only be discarded when the proposal
+// is tallied. Messages executed through authz are checked on behalf of their
+// granter.
+type MembershipGovDecorator struct {
+	keeper MembershipKeeper
+}
+
+// NewMembershipGovDecorator creates a new MembershipGovDecorator
+func NewMembershipGovDecorator(keeper MembershipKeeper) MembershipGovDecorator {
+	return MembershipGovDecorator{keeper: keeper}
+}
+
+// AnteHandle implements sdk.AnteDecorator
+func (d MembershipGovDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	restrict := d.keeper.RestrictGovMessages(ctx)
+	for _, msg := range tx.GetMsgs() {
+		if err := d.checkMsg(ctx, msg, restrict); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// checkMsg checks that the message, or any message it executes, is not a
+// public vote on a secret ballot, nor, if restricted, a governance proposal or
+// vote from outside the electorate
+func (d MembershipGovDecorator) checkMsg(ctx sdk.Context, msg sdk.Msg, restrict bool) error {
+	if exec, ok := msg.(*authz.MsgExec); ok {
+		msgs, err := exec.GetMessages()
+		if err != nil {
+			return err
+		}
+		for _, nested := range msgs {
+			if err := d.checkMsg(ctx, nested, restrict); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if proposalID, ok := govVoteProposalID(msg); ok && d.keeper.IsSecretBallot(ctx, proposalID) {
+		return errors.Wrapf(types.ErrInvalidSecretBallot, "votes on proposal %d must be committed and revealed", proposalID)
+	}
+
+	signer, ok := govMsgSigner(msg)
+	if !ok || !restrict {
+		return nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(signer)
+	if err != nil {
+		return err
+	}
+	member, found := d.keeper.GetMemberAccount(ctx, addr)
+	if !found || member.Status != types.MembershipStatus_MemberElectorate {
+		return errors.Wrapf(types.ErrSignerNotElectorate, "%s cannot be sent by %s", sdk.MsgTypeURL(msg), signer)
+	}
+	return nil
+}
+
+// govVoteProposalID returns the proposal a governance vote is cast on, and
+// false for any other message
+func govVoteProposalID(msg sdk.Msg) (uint64, bool) {
+	switch msg := msg.(type) {
+	case *govtypes_v1.MsgVote:
+		return msg.ProposalId, true
+	case *govtypes_v1.MsgVoteWeighted:
+		return msg.ProposalId, true
+	case *govtypes_v1beta1.MsgVote:
+		return msg.ProposalId, true
+	case *govtypes_v1beta1.MsgVoteWeighted:
+		return msg.ProposalId, true
+	default:
+		return 0, false
+	}
+}
+
+// govMsgSigner returns the proposer or voter of a governance message, and
+// false for any other message
+func govMsgSigner(msg sdk.Msg) (string, bool) {
+	switch msg := msg.(type) {
+	case *govtypes_v1.MsgSubmitProposal:
+		return msg.Proposer, true
+	case *govtypes_v1.MsgVote:
+		return msg.Voter, true
+	case *govtypes_v1.MsgVoteWeighted:
+		return msg.Voter, true
+	case *govtypes_v1beta1.MsgSubmitProposal:
+		return msg.Proposer, true
+	case *govtypes_v1beta1.MsgVote:
+		return msg.Voter, true
+	case *govtypes_v1beta1.MsgVoteWeighted:
+		return msg.Voter, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,230 @@
+package ante_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes_v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govtypes_v1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/noria-net/module-membership/x/membership/ante"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/stretchr/testify/require"
+)
+
+// mockMembershipKeeper holds members by address, and their remaining
+// fee-free transactions
+type mockMembershipKeeper struct {
+	members            map[string]types.Member
+	restrict           bool
+	msgTypes           []string
+	remaining          map[string]int
+	wasmRole           types.WasmAccessRole
+	restrictValidators bool
+	secretBallots      map[uint64]bool
+	maxGas             uint64
+}
+
+func (k mockMembershipKeeper) GetMemberAccount(_ sdk.Context, address sdk.AccAddress) (types.Member, bool) {
+	member, found := k.members[address.String()]
+	return member, found
+}
+
+func (k mockMembershipKeeper) RestrictGovMessages(_ sdk.Context) bool {
+	return k.restrict
+}
+
+func (k mockMembershipKeeper) FeeWaiverMsgTypes(_ sdk.Context) []string {
+	return k.msgTypes
+}
+
+func (k mockMembershipKeeper) ConsumeFeeWaiver(_ sdk.Context, member sdk.AccAddress) bool {
+	if k.remaining[member.String()] <= 0 {
+		return false
+	}
+	k.remaining[member.String()]--
+	return true
+}
+
+func (k mockMembershipKeeper) FeeWaiverMaxGas(_ sdk.Context) uint64 {
+	return k.maxGas
+}
+
+func (k mockMembershipKeeper) WasmAccessRole(_ sdk.Context) types.WasmAccessRole {
+	return k.wasmRole
+}
+
+func (k mockMembershipKeeper) IsGuardian(_ sdk.Context, addr sdk.AccAddress) bool {
+	member, found := k.members[addr.String()]
+	return found && member.IsGuardian && member.Status == types.MembershipStatus_MemberElectorate
+}
+
+func (k mockMembershipKeeper) RestrictValidators(_ sdk.Context) bool {
+	return k.restrictValidators
+}
+
+func (k mockMembershipKeeper) IsSecretBallot(_ sdk.Context, proposalID uint64) bool {
+	return k.secretBallots[proposalID]
+}
+
+// mockTx is a transaction made of the given messages
+type mockTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx mockTx) GetMsgs() []sdk.Msg   { return tx.msgs }
+func (tx mockTx) ValidateBasic() error { return nil }
+
+func next(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+	return ctx, nil
+}
+
+func TestMembershipGovDecorator(t *testing.T) {
+	electorate := sample.AccAddress()
+	suspended := sample.AccAddress()
+	outsider := sample.AccAddress()
+	keeper := mockMembershipKeeper{
+		members: map[string]types.Member{
+			electorate: {Status: types.MembershipStatus_MemberElectorate},
+			suspended:  {Status: types.MembershipStatus_MemberSuspended},
+		},
+		restrict: true,
+	}
+
+	vote := func(voter string) sdk.Msg {
+		return govtypes_v1.NewMsgVote(sdk.MustAccAddressFromBech32(voter), 1, govtypes_v1.OptionYes, "")
+	}
+	exec := func(msgs ...sdk.Msg) sdk.Msg {
+		msg := authz.NewMsgExec(sdk.MustAccAddressFromBech32(sample.AccAddress()), msgs)
+		return &msg
+	}
+
+	tests := []struct {
+		name string
+		msgs []sdk.Msg
+		err  error
+	}{
+		{
+			name: "vote from the electorate",
+			msgs: []sdk.Msg{vote(electorate)},
+		}, {
+			name: "vote from a non-member",
+			msgs: []sdk.Msg{vote(outsider)},
+			err:  types.ErrSignerNotElectorate,
+		}, {
+			name: "vote from a suspended member",
+			msgs: []sdk.Msg{vote(suspended)},
+			err:  types.ErrSignerNotElectorate,
+		}, {
+			name: "weighted vote from a non-member",
+			msgs: []sdk.Msg{govtypes_v1.NewMsgVoteWeighted(sdk.MustAccAddressFromBech32(outsider), 1, govtypes_v1.NewNonSplitVoteOption(govtypes_v1.OptionYes), "")},
+			err:  types.ErrSignerNotElectorate,
+		}, {
+			name: "legacy vote from a non-member",
+			msgs: []sdk.Msg{govtypes_v1beta1.NewMsgVote(sdk.MustAccAddressFromBech32(outsider), 1, govtypes_v1beta1.OptionYes)},
+			err:  types.ErrSignerNotElectorate,
+		}, {
+			name: "proposal from a non-member",
+			msgs: []sdk.Msg{&govtypes_v1.MsgSubmitProposal{Proposer: outsider}},
+			err:  types.ErrSignerNotElectorate,
+		}, {
+			name: "proposal from the electorate",
+			msgs: []sdk.Msg{&govtypes_v1.MsgSubmitProposal{Proposer: electorate}},
+		}, {
+			name: "non-member vote after a member vote",
+			msgs: []sdk.Msg{vote(electorate), vote(outsider)},
+			err:  types.ErrSignerNotElectorate,
+		}, {
+			name: "authz vote for the electorate",
+			msgs: []sdk.Msg{exec(vote(electorate))},
+		}, {
+			name: "authz vote for a non-member",
+			msgs: []sdk.Msg{exec(vote(outsider))},
+			err:  types.ErrSignerNotElectorate,
+		}, {
+			name: "nested authz vote for a non-member",
+			msgs: []sdk.Msg{exec(exec(vote(outsider)))},
+			err:  types.ErrSignerNotElectorate,
+		}, {
+			name: "other messages from a non-member",
+			msgs: []sdk.Msg{banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(outsider), sdk.MustAccAddressFromBech32(electorate), nil)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decorator := ante.NewMembershipGovDecorator(keeper)
+			_, err := decorator.AnteHandle(sdk.Context{}, mockTx{msgs: tt.msgs}, false, next)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	// Everyone can send gov messages when the restriction is disabled
+	keeper.restrict = false
+	decorator := ante.NewMembershipGovDecorator(keeper)
+	_, err := decorator.AnteHandle(sdk.Context{}, mockTx{msgs: []sdk.Msg{vote(outsider)}}, false, next)
+	require.NoError(t, err)
+}
+
+func TestMembershipGovDecoratorSecretBallot(t *testing.T) {
+	electorate := sample.AccAddress()
+	voter := sdk.MustAccAddressFromBech32(electorate)
+	keeper := mockMembershipKeeper{
+		members: map[string]types.Member{
+			electorate: {Status: types.MembershipStatus_MemberElectorate},
+		},
+		secretBallots: map[uint64]bool{2: true},
+	}
+	exec := func(msgs ...sdk.Msg) sdk.Msg {
+		msg := authz.NewMsgExec(sdk.MustAccAddressFromBech32(sample.AccAddress()), msgs)
+		return &msg
+	}
+
+	tests := []struct {
+		name string
+		msgs []sdk.Msg
+		err  error
+	}{
+		{
+			name: "vote on a public ballot",
+			msgs: []sdk.Msg{govtypes_v1.NewMsgVote(voter, 1, govtypes_v1.OptionYes, "")},
+		}, {
+			name: "vote on a secret ballot",
+			msgs: []sdk.Msg{govtypes_v1.NewMsgVote(voter, 2, govtypes_v1.OptionYes, "")},
+			err:  types.ErrInvalidSecretBallot,
+		}, {
+			name: "weighted vote on a secret ballot",
+			msgs: []sdk.Msg{govtypes_v1.NewMsgVoteWeighted(voter, 2, govtypes_v1.NewNonSplitVoteOption(govtypes_v1.OptionYes), "")},
+			err:  types.ErrInvalidSecretBallot,
+		}, {
+			name: "legacy vote on a secret ballot",
+			msgs: []sdk.Msg{govtypes_v1beta1.NewMsgVote(voter, 2, govtypes_v1beta1.OptionYes)},
+			err:  types.ErrInvalidSecretBallot,
+		}, {
+			name: "authz vote on a secret ballot",
+			msgs: []sdk.Msg{exec(govtypes_v1.NewMsgVote(voter, 2, govtypes_v1.OptionYes, ""))},
+			err:  types.ErrInvalidSecretBallot,
+		},
+	}
+	// Public votes on a secret ballot are rejected whether or not gov
+	// messages are restricted to the electorate
+	for _, restrict := range []bool{false, true} {
+		keeper.restrict = restrict
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				decorator := ante.NewMembershipGovDecorator(keeper)
+				_, err := decorator.AnteHandle(sdk.Context{}, mockTx{msgs: tt.msgs}, false, next)
+				if tt.err != nil {
+					require.ErrorIs(t, err, tt.err)
+					return
+				}
+				require.NoError(t, err)
+			})
+		}
+	}
+}
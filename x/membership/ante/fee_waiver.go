@@ -0,0 +1,63 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MembershipFeeWaiverDecorator lets electorate members send transactions made
+// up only of fee-free message types without paying fees, up to their
+// allowance for the epoch. Every other transaction is passed on to the
+// wrapped fee decorator, which deducts its fees as usual.
+type MembershipFeeWaiverDecorator struct {
+	keeper    MembershipKeeper
+	deductFee sdk.AnteDecorator
+}
+
+// NewMembershipFeeWaiverDecorator creates a new MembershipFeeWaiverDecorator,
+// wrapping the decorator that deducts fees
+func NewMembershipFeeWaiverDecorator(keeper MembershipKeeper, deductFee sdk.AnteDecorator) MembershipFeeWaiverDecorator {
+	return MembershipFeeWaiverDecorator{
+		keeper:    keeper,
+		deductFee: deductFee,
+	}
+}
+
+// AnteHandle implements sdk.AnteDecorator
+func (d MembershipFeeWaiverDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if d.isWaived(ctx, tx) {
+		return next(ctx, tx, simulate)
+	}
+	return d.deductFee.AnteHandle(ctx, tx, simulate, next)
+}
+
+// isWaived returns true if the transaction offers no fee, stays within the
+// gas limit for fee-free transactions, is paid for and signed by its fee payer
+// alone, and only contains fee-free message types. Waiving the fee uses up one
+// of the fee payer's fee-free transactions.
+func (d MembershipFeeWaiverDecorator) isWaived(ctx sdk.Context, tx sdk.Tx) bool {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || !feeTx.GetFee().IsZero() || feeTx.FeeGranter() != nil {
+		return false
+	}
+	if feeTx.GetGas() > d.keeper.FeeWaiverMaxGas(ctx) {
+		return false
+	}
+
+	payer := feeTx.FeePayer()
+	msgTypes := make(map[string]bool)
+	for _, msgType := range d.keeper.FeeWaiverMsgTypes(ctx) {
+		msgTypes[msgType] = true
+	}
+	for _, msg := range tx.GetMsgs() {
+		if !msgTypes[sdk.MsgTypeURL(msg)] {
+			return false
+		}
+		for _, signer := range msg.GetSigners() {
+			if !signer.Equals(payer) {
+				return false
+			}
+		}
+	}
+
+	return len(tx.GetMsgs()) > 0 && d.keeper.ConsumeFeeWaiver(ctx, payer)
+}
@@ -0,0 +1,93 @@
+package ante_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes_v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/noria-net/module-membership/x/membership/ante"
+	"github.com/stretchr/testify/require"
+)
+
+// mockFeeTx is a transaction made of the given messages, paid for by its
+// first signer
+type mockFeeTx struct {
+	mockTx
+	fee     sdk.Coins
+	granter sdk.AccAddress
+	gas     uint64
+}
+
+func (tx mockFeeTx) GetGas() uint64             { return tx.gas }
+func (tx mockFeeTx) GetFee() sdk.Coins          { return tx.fee }
+func (tx mockFeeTx) FeePayer() sdk.AccAddress   { return tx.msgs[0].GetSigners()[0] }
+func (tx mockFeeTx) FeeGranter() sdk.AccAddress { return tx.granter }
+
+// mockDeductFeeDecorator records whether fees were deducted
+type mockDeductFeeDecorator struct {
+	deducted *bool
+}
+
+func (d mockDeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	*d.deducted = true
+	return next(ctx, tx, simulate)
+}
+
+func TestMembershipFeeWaiverDecorator(t *testing.T) {
+	member := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	other := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	keeper := mockMembershipKeeper{
+		msgTypes:  []string{sdk.MsgTypeURL(&govtypes_v1.MsgVote{})},
+		remaining: map[string]int{member.String(): 1},
+		maxGas:    200000,
+	}
+
+	vote := func(voter sdk.AccAddress) sdk.Msg {
+		return govtypes_v1.NewMsgVote(voter, 1, govtypes_v1.OptionYes, "")
+	}
+	send := banktypes.NewMsgSend(member, other, nil)
+
+	tests := []struct {
+		name   string
+		tx     mockFeeTx
+		waived bool
+	}{
+		{
+			name: "transaction that offers a fee",
+			tx:   mockFeeTx{mockTx: mockTx{msgs: []sdk.Msg{vote(member)}}, fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 1))},
+		}, {
+			name: "transaction with a fee granter",
+			tx:   mockFeeTx{mockTx: mockTx{msgs: []sdk.Msg{vote(member)}}, granter: other},
+		}, {
+			name: "transaction with other message types",
+			tx:   mockFeeTx{mockTx: mockTx{msgs: []sdk.Msg{vote(member), send}}},
+		}, {
+			name: "transaction with other signers",
+			tx:   mockFeeTx{mockTx: mockTx{msgs: []sdk.Msg{vote(member), vote(other)}}},
+		}, {
+			name: "transaction above the gas limit",
+			tx:   mockFeeTx{mockTx: mockTx{msgs: []sdk.Msg{vote(member)}}, gas: 200001},
+		}, {
+			name:   "fee-free transaction",
+			tx:     mockFeeTx{mockTx: mockTx{msgs: []sdk.Msg{vote(member), vote(member)}}, gas: 200000},
+			waived: true,
+		}, {
+			name: "fee-free transaction beyond the allowance",
+			tx:   mockFeeTx{mockTx: mockTx{msgs: []sdk.Msg{vote(member)}}},
+		}, {
+			name: "fee-free transaction without an allowance",
+			tx:   mockFeeTx{mockTx: mockTx{msgs: []sdk.Msg{vote(other)}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deducted := false
+			decorator := ante.NewMembershipFeeWaiverDecorator(keeper, mockDeductFeeDecorator{deducted: &deducted})
+			_, err := decorator.AnteHandle(sdk.Context{}, tt.tx, false, next)
+			require.NoError(t, err)
+			require.Equal(t, !tt.waived, deducted)
+		})
+	}
+}
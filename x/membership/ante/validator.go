@@ -0,0 +1,85 @@
+package ante
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+// MembershipValidatorDecorator rejects validator creations and unjailings
+// from operators who are not electorate members, when validators are
+// restricted to the electorate. Validators jailed when their operator left the
+// electorate thus stay jailed until the operator rejoins it. Messages executed
+// through authz are checked on behalf of their granter.
+type MembershipValidatorDecorator struct {
+	keeper MembershipKeeper
+}
+
+// NewMembershipValidatorDecorator creates a new MembershipValidatorDecorator
+func NewMembershipValidatorDecorator(keeper MembershipKeeper) MembershipValidatorDecorator {
+	return MembershipValidatorDecorator{keeper: keeper}
+}
+
+// AnteHandle implements sdk.AnteDecorator
+func (d MembershipValidatorDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !d.keeper.RestrictValidators(ctx) {
+		return next(ctx, tx, simulate)
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		if err := d.checkMsg(ctx, msg); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// checkMsg checks that the message, or any message it executes, does not
+// create or unjail a validator operated from outside the electorate
+func (d MembershipValidatorDecorator) checkMsg(ctx sdk.Context, msg sdk.Msg) error {
+	if exec, ok := msg.(*authz.MsgExec); ok {
+		msgs, err := exec.GetMessages()
+		if err != nil {
+			return err
+		}
+		for _, nested := range msgs {
+			if err := d.checkMsg(ctx, nested); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	operator, ok := validatorMsgOperator(msg)
+	if !ok {
+		return nil
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(operator)
+	if err != nil {
+		return err
+	}
+	addr := sdk.AccAddress(valAddr)
+	member, found := d.keeper.GetMemberAccount(ctx, addr)
+	if !found || member.Status != types.MembershipStatus_MemberElectorate {
+		return errors.Wrapf(types.ErrSignerNotElectorate, "%s cannot be sent by %s", sdk.MsgTypeURL(msg), addr.String())
+	}
+	return nil
+}
+
+// validatorMsgOperator returns the operator of the validator created or
+// unjailed by the message, and false for any other message
+func validatorMsgOperator(msg sdk.Msg) (string, bool) {
+	switch msg := msg.(type) {
+	case *stakingtypes.MsgCreateValidator:
+		return msg.ValidatorAddress, true
+	case *slashingtypes.MsgUnjail:
+		return msg.ValidatorAddr, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,79 @@
+package ante_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/noria-net/module-membership/x/membership/ante"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMembershipValidatorDecorator(t *testing.T) {
+	electorate := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	suspended := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	outsider := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	keeper := mockMembershipKeeper{
+		members: map[string]types.Member{
+			electorate.String(): {Status: types.MembershipStatus_MemberElectorate},
+			suspended.String():  {Status: types.MembershipStatus_MemberSuspended},
+		},
+		restrictValidators: true,
+	}
+
+	unjail := func(operator sdk.AccAddress) sdk.Msg {
+		return slashingtypes.NewMsgUnjail(sdk.ValAddress(operator))
+	}
+	create := func(operator sdk.AccAddress) sdk.Msg {
+		return &stakingtypes.MsgCreateValidator{
+			DelegatorAddress: operator.String(),
+			ValidatorAddress: sdk.ValAddress(operator).String(),
+		}
+	}
+
+	tests := []struct {
+		name string
+		msgs []sdk.Msg
+		err  error
+	}{
+		{
+			name: "validator created by the electorate",
+			msgs: []sdk.Msg{create(electorate)},
+		}, {
+			name: "validator created by a non-member",
+			msgs: []sdk.Msg{create(outsider)},
+			err:  types.ErrSignerNotElectorate,
+		}, {
+			name: "validator unjailed by the electorate",
+			msgs: []sdk.Msg{unjail(electorate)},
+		}, {
+			name: "validator unjailed by a suspended member",
+			msgs: []sdk.Msg{unjail(suspended)},
+			err:  types.ErrSignerNotElectorate,
+		}, {
+			name: "other messages from a non-member",
+			msgs: []sdk.Msg{banktypes.NewMsgSend(outsider, electorate, nil)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decorator := ante.NewMembershipValidatorDecorator(keeper)
+			_, err := decorator.AnteHandle(sdk.Context{}, mockTx{msgs: tt.msgs}, false, next)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	// Anyone can operate a validator when validators are not restricted
+	keeper.restrictValidators = false
+	decorator := ante.NewMembershipValidatorDecorator(keeper)
+	_, err := decorator.AnteHandle(sdk.Context{}, mockTx{msgs: []sdk.Msg{create(outsider), unjail(suspended)}}, false, next)
+	require.NoError(t, err)
+}
@@ -0,0 +1,93 @@
+package ante
+
+import (
+	"cosmossdk.io/errors"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+// MembershipWasmDecorator rejects contract uploads and instantiations from
+// signers who do not hold the role required by the wasm access role param.
+// Messages executed through authz are checked on behalf of their granter.
+// Messages dispatched by contracts or executed by governance proposals do not
+// pass through the ante handler, and are left to the wasm module's own
+// permissions.
+type MembershipWasmDecorator struct {
+	keeper MembershipKeeper
+}
+
+// NewMembershipWasmDecorator creates a new MembershipWasmDecorator
+func NewMembershipWasmDecorator(keeper MembershipKeeper) MembershipWasmDecorator {
+	return MembershipWasmDecorator{keeper: keeper}
+}
+
+// AnteHandle implements sdk.AnteDecorator
+func (d MembershipWasmDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	role := d.keeper.WasmAccessRole(ctx)
+	if role == types.WasmAccessRole_WasmAccessRoleAnyone {
+		return next(ctx, tx, simulate)
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		if err := d.checkMsg(ctx, role, msg); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// checkMsg checks that the message, or any message it executes, is not a
+// contract upload or instantiation from a signer without the given role
+func (d MembershipWasmDecorator) checkMsg(ctx sdk.Context, role types.WasmAccessRole, msg sdk.Msg) error {
+	if exec, ok := msg.(*authz.MsgExec); ok {
+		msgs, err := exec.GetMessages()
+		if err != nil {
+			return err
+		}
+		for _, nested := range msgs {
+			if err := d.checkMsg(ctx, role, nested); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	signer, ok := wasmMsgSigner(msg)
+	if !ok {
+		return nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(signer)
+	if err != nil {
+		return err
+	}
+	if role == types.WasmAccessRole_WasmAccessRoleGuardian {
+		if !d.keeper.IsGuardian(ctx, addr) {
+			return errors.Wrapf(types.ErrSignerNotGuardian, "%s cannot be sent by %s", sdk.MsgTypeURL(msg), signer)
+		}
+		return nil
+	}
+	member, found := d.keeper.GetMemberAccount(ctx, addr)
+	if !found || member.Status != types.MembershipStatus_MemberElectorate {
+		return errors.Wrapf(types.ErrSignerNotElectorate, "%s cannot be sent by %s", sdk.MsgTypeURL(msg), signer)
+	}
+	return nil
+}
+
+// wasmMsgSigner returns the sender of a contract upload or instantiation, and
+// false for any other message
+func wasmMsgSigner(msg sdk.Msg) (string, bool) {
+	switch msg := msg.(type) {
+	case *wasmtypes.MsgStoreCode:
+		return msg.Sender, true
+	case *wasmtypes.MsgInstantiateContract:
+		return msg.Sender, true
+	case *wasmtypes.MsgInstantiateContract2:
+		return msg.Sender, true
+	default:
+		return "", false
+	}
+}
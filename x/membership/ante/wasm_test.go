@@ -0,0 +1,117 @@
+package ante_test
+
+import (
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/noria-net/module-membership/x/membership/ante"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMembershipWasmDecorator(t *testing.T) {
+	guardian := sample.AccAddress()
+	electorate := sample.AccAddress()
+	suspended := sample.AccAddress()
+	outsider := sample.AccAddress()
+	keeper := mockMembershipKeeper{
+		members: map[string]types.Member{
+			guardian:   {Status: types.MembershipStatus_MemberElectorate, IsGuardian: true},
+			electorate: {Status: types.MembershipStatus_MemberElectorate},
+			suspended:  {Status: types.MembershipStatus_MemberSuspended},
+		},
+	}
+
+	store := func(sender string) sdk.Msg {
+		return &wasmtypes.MsgStoreCode{Sender: sender}
+	}
+	instantiate := func(sender string) sdk.Msg {
+		return &wasmtypes.MsgInstantiateContract{Sender: sender, CodeID: 1}
+	}
+	instantiate2 := func(sender string) sdk.Msg {
+		return &wasmtypes.MsgInstantiateContract2{Sender: sender, CodeID: 1}
+	}
+	exec := func(msgs ...sdk.Msg) sdk.Msg {
+		msg := authz.NewMsgExec(sdk.MustAccAddressFromBech32(sample.AccAddress()), msgs)
+		return &msg
+	}
+
+	tests := []struct {
+		name string
+		role types.WasmAccessRole
+		msgs []sdk.Msg
+		err  error
+	}{
+		{
+			name: "upload from a non-member when anyone can upload",
+			role: types.WasmAccessRole_WasmAccessRoleAnyone,
+			msgs: []sdk.Msg{store(outsider), instantiate(outsider)},
+		}, {
+			name: "upload from the electorate",
+			role: types.WasmAccessRole_WasmAccessRoleElectorate,
+			msgs: []sdk.Msg{store(electorate)},
+		}, {
+			name: "instantiation from the electorate",
+			role: types.WasmAccessRole_WasmAccessRoleElectorate,
+			msgs: []sdk.Msg{instantiate(electorate), instantiate2(electorate)},
+		}, {
+			name: "upload from a non-member",
+			role: types.WasmAccessRole_WasmAccessRoleElectorate,
+			msgs: []sdk.Msg{store(outsider)},
+			err:  types.ErrSignerNotElectorate,
+		}, {
+			name: "instantiation from a suspended member",
+			role: types.WasmAccessRole_WasmAccessRoleElectorate,
+			msgs: []sdk.Msg{instantiate(suspended)},
+			err:  types.ErrSignerNotElectorate,
+		}, {
+			name: "predictable instantiation from a non-member",
+			role: types.WasmAccessRole_WasmAccessRoleElectorate,
+			msgs: []sdk.Msg{instantiate2(outsider)},
+			err:  types.ErrSignerNotElectorate,
+		}, {
+			name: "authz upload for a non-member",
+			role: types.WasmAccessRole_WasmAccessRoleElectorate,
+			msgs: []sdk.Msg{exec(exec(store(outsider)))},
+			err:  types.ErrSignerNotElectorate,
+		}, {
+			name: "upload from a guardian",
+			role: types.WasmAccessRole_WasmAccessRoleGuardian,
+			msgs: []sdk.Msg{store(guardian), instantiate(guardian)},
+		}, {
+			name: "upload from an electorate member who is not a guardian",
+			role: types.WasmAccessRole_WasmAccessRoleGuardian,
+			msgs: []sdk.Msg{store(electorate)},
+			err:  types.ErrSignerNotGuardian,
+		}, {
+			name: "authz instantiation for an electorate member who is not a guardian",
+			role: types.WasmAccessRole_WasmAccessRoleGuardian,
+			msgs: []sdk.Msg{exec(instantiate(electorate))},
+			err:  types.ErrSignerNotGuardian,
+		}, {
+			name: "contract execution from a non-member",
+			role: types.WasmAccessRole_WasmAccessRoleGuardian,
+			msgs: []sdk.Msg{&wasmtypes.MsgExecuteContract{Sender: outsider, Contract: electorate}},
+		}, {
+			name: "other messages from a non-member",
+			role: types.WasmAccessRole_WasmAccessRoleGuardian,
+			msgs: []sdk.Msg{banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(outsider), sdk.MustAccAddressFromBech32(electorate), nil)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keeper.wasmRole = tt.role
+			decorator := ante.NewMembershipWasmDecorator(keeper)
+			_, err := decorator.AnteHandle(sdk.Context{}, mockTx{msgs: tt.msgs}, false, next)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package bindings
+
+// MembershipCustomMsg is the custom message that contracts send to the chain
+type MembershipCustomMsg struct {
+	Membership *MembershipMsg `json:"membership,omitempty"`
+}
+
+// MembershipMsg contains exactly one of the membership messages. Each one
+// is sent on behalf of the contract itself.
+type MembershipMsg struct {
+	// Enroll enrolls the contract as a member
+	Enroll *EnrollMsg `json:"enroll,omitempty"`
+	// ApproveMember approves a pending member, if the contract is a guardian
+	ApproveMember *ApproveMemberMsg `json:"approve_member,omitempty"`
+	// UpdateStatus updates the status of a member who is not a guardian, if
+	// the contract is a guardian
+	UpdateStatus *UpdateStatusMsg `json:"update_status,omitempty"`
+}
+
+type EnrollMsg struct {
+	Nickname         string `json:"nickname"`
+	InvitationSecret string `json:"invitation_secret,omitempty"`
+}
+
+type ApproveMemberMsg struct {
+	Member string `json:"member"`
+}
+
+// UpdateStatusMsg updates a member's status, given in its short form, such
+// as "inactive"
+type UpdateStatusMsg struct {
+	Address string `json:"address"`
+	Status  string `json:"status"`
+}
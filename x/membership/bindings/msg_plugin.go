@@ -0,0 +1,60 @@
+package bindings
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+// CustomEncoder turns the membership custom messages of contracts into the
+// module's messages. The contract is always their signer, so that it can
+// only act as itself, and the messages go through the same checks as a
+// transaction would.
+func CustomEncoder(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error) {
+	custom, err := DecodeMsg(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	var sdkMsg sdk.Msg
+	switch {
+	case custom.Enroll != nil:
+		sdkMsg = types.NewMsgEnroll(sender.String(), custom.Enroll.Nickname, custom.Enroll.InvitationSecret)
+	case custom.ApproveMember != nil:
+		sdkMsg = types.NewMsgApproveMember(sender.String(), custom.ApproveMember.Member)
+	case custom.UpdateStatus != nil:
+		status := types.ParseShortFormMembershipStatus(custom.UpdateStatus.Status)
+		if !status.IsValid() {
+			return nil, errorsmod.Wrapf(types.ErrInvalidMembershipStatus, "expected one of: %s", types.GetAllShortFormMembershipStatusesAsString())
+		}
+		sdkMsg = types.NewMsgUpdateStatus(sender.String(), custom.UpdateStatus.Address, status)
+	default:
+		return nil, errorsmod.Wrap(wasmtypes.ErrUnknownMsg, "unknown membership message variant")
+	}
+
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return []sdk.Msg{sdkMsg}, nil
+}
+
+// EncodeMsg encodes a membership message as a contract would send it
+func EncodeMsg(msg MembershipMsg) ([]byte, error) {
+	return json.Marshal(MembershipCustomMsg{Membership: &msg})
+}
+
+// DecodeMsg decodes a membership message sent by a contract
+func DecodeMsg(msg []byte) (*MembershipMsg, error) {
+	var custom MembershipCustomMsg
+	if err := json.Unmarshal(msg, &custom); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if custom.Membership == nil {
+		return nil, errorsmod.Wrap(wasmtypes.ErrUnknownMsg, "only membership custom messages are supported")
+	}
+	return custom.Membership, nil
+}
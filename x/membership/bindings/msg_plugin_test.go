@@ -0,0 +1,69 @@
+package bindings_test
+
+import (
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/noria-net/module-membership/x/membership/bindings"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomEncoder(t *testing.T) {
+	sender := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	member := sample.AccAddress()
+
+	tests := []struct {
+		name string
+		msg  bindings.MembershipMsg
+		want sdk.Msg
+		err  error
+	}{
+		{
+			name: "enroll",
+			msg:  bindings.MembershipMsg{Enroll: &bindings.EnrollMsg{Nickname: "contract", InvitationSecret: "secret"}},
+			want: types.NewMsgEnroll(sender.String(), "contract", "secret"),
+		}, {
+			name: "approve member",
+			msg:  bindings.MembershipMsg{ApproveMember: &bindings.ApproveMemberMsg{Member: member}},
+			want: types.NewMsgApproveMember(sender.String(), member),
+		}, {
+			name: "approve invalid member",
+			msg:  bindings.MembershipMsg{ApproveMember: &bindings.ApproveMemberMsg{Member: "invalid"}},
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "update status",
+			msg:  bindings.MembershipMsg{UpdateStatus: &bindings.UpdateStatusMsg{Address: member, Status: "inactive"}},
+			want: types.NewMsgUpdateStatus(sender.String(), member, types.MembershipStatus_MemberInactive),
+		}, {
+			name: "update to invalid status",
+			msg:  bindings.MembershipMsg{UpdateStatus: &bindings.UpdateStatusMsg{Address: member, Status: "unknown"}},
+			err:  types.ErrInvalidMembershipStatus,
+		}, {
+			name: "no variant",
+			msg:  bindings.MembershipMsg{},
+			err:  wasmtypes.ErrUnknownMsg,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bz, err := bindings.EncodeMsg(tt.msg)
+			require.NoError(t, err)
+
+			msgs, err := bindings.CustomEncoder(sender, bz)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []sdk.Msg{tt.want}, msgs)
+		})
+	}
+
+	// Other custom messages are rejected
+	_, err := bindings.CustomEncoder(sender, []byte(`{"other":{}}`))
+	require.ErrorIs(t, err, wasmtypes.ErrUnknownMsg)
+}
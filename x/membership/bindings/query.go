@@ -0,0 +1,78 @@
+package bindings
+
+// MembershipCustomQuery is the custom query that contracts send to the chain
+type MembershipCustomQuery struct {
+	Membership *MembershipQuery `json:"membership,omitempty"`
+}
+
+// MembershipQuery contains exactly one of the membership queries
+type MembershipQuery struct {
+	// IsMember reports whether an address is a member, and whether they are
+	// in the electorate
+	IsMember *IsMemberQuery `json:"is_member,omitempty"`
+	// Member returns a member's details
+	Member *MemberQuery `json:"member,omitempty"`
+	// Guardians returns the guardians of the electorate and their weights
+	Guardians *GuardiansQuery `json:"guardians,omitempty"`
+	// MemberStatusCount returns the number of members with a status
+	MemberStatusCount *MemberStatusCountQuery `json:"member_status_count,omitempty"`
+	// DirectDemocracy returns the direct democracy settings
+	DirectDemocracy *DirectDemocracyQuery `json:"direct_democracy,omitempty"`
+}
+
+type IsMemberQuery struct {
+	Address string `json:"address"`
+}
+
+type IsMemberResponse struct {
+	IsMember   bool `json:"is_member"`
+	Electorate bool `json:"electorate"`
+}
+
+type MemberQuery struct {
+	Address string `json:"address"`
+}
+
+type MemberResponse struct {
+	Member Member `json:"member"`
+}
+
+// Member is a member as seen by contracts. The status is in its short form,
+// such as "electorate".
+type Member struct {
+	Address    string `json:"address"`
+	Nickname   string `json:"nickname"`
+	Status     string `json:"status"`
+	IsGuardian bool   `json:"is_guardian"`
+}
+
+type GuardiansQuery struct{}
+
+type GuardiansResponse struct {
+	Guardians []Guardian `json:"guardians"`
+}
+
+// Guardian is a guardian and their relative weight
+type Guardian struct {
+	Address string `json:"address"`
+	Weight  uint64 `json:"weight"`
+}
+
+// MemberStatusCountQuery counts the members with a status, given in its
+// short form, such as "electorate"
+type MemberStatusCountQuery struct {
+	Status string `json:"status"`
+}
+
+type MemberStatusCountResponse struct {
+	Count uint64 `json:"count"`
+}
+
+type DirectDemocracyQuery struct{}
+
+// DirectDemocracyResponse contains the direct democracy settings. Without
+// any settings, the guardians have no voting weight.
+type DirectDemocracyResponse struct {
+	TotalVotingWeight string     `json:"total_voting_weight"`
+	Guardians         []Guardian `json:"guardians"`
+}
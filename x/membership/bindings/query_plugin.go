@@ -0,0 +1,128 @@
+package bindings
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noria-net/module-membership/x/membership/keeper"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+// CustomQuerier answers the membership custom queries of contracts
+func CustomQuerier(k *keeper.Keeper) func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		query, err := DecodeQuery(request)
+		if err != nil {
+			return nil, err
+		}
+
+		var res interface{}
+		switch {
+		case query.IsMember != nil:
+			res, err = isMember(ctx, k, query.IsMember)
+		case query.Member != nil:
+			res, err = member(ctx, k, query.Member)
+		case query.Guardians != nil:
+			res = GuardiansResponse{Guardians: guardians(ctx, k)}
+		case query.MemberStatusCount != nil:
+			res, err = memberStatusCount(ctx, k, query.MemberStatusCount)
+		case query.DirectDemocracy != nil:
+			res = directDemocracy(ctx, k)
+		default:
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown membership query variant"}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return json.Marshal(res)
+	}
+}
+
+// EncodeQuery encodes a membership query as a contract would send it
+func EncodeQuery(query MembershipQuery) ([]byte, error) {
+	return json.Marshal(MembershipCustomQuery{Membership: &query})
+}
+
+// DecodeQuery decodes a membership query sent by a contract
+func DecodeQuery(request []byte) (*MembershipQuery, error) {
+	var custom MembershipCustomQuery
+	if err := json.Unmarshal(request, &custom); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if custom.Membership == nil {
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "only membership custom queries are supported"}
+	}
+	return custom.Membership, nil
+}
+
+func isMember(ctx sdk.Context, k *keeper.Keeper, query *IsMemberQuery) (IsMemberResponse, error) {
+	addr, err := sdk.AccAddressFromBech32(query.Address)
+	if err != nil {
+		return IsMemberResponse{}, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, query.Address)
+	}
+
+	m, found := k.GetMemberAccount(ctx, addr)
+	return IsMemberResponse{
+		IsMember:   found,
+		Electorate: found && m.Status == types.MembershipStatus_MemberElectorate,
+	}, nil
+}
+
+func member(ctx sdk.Context, k *keeper.Keeper, query *MemberQuery) (MemberResponse, error) {
+	addr, err := sdk.AccAddressFromBech32(query.Address)
+	if err != nil {
+		return MemberResponse{}, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, query.Address)
+	}
+
+	m, found := k.GetMemberAccount(ctx, addr)
+	if !found {
+		return MemberResponse{}, errorsmod.Wrapf(types.ErrMemberNotFound, "member not found: %s", query.Address)
+	}
+
+	return MemberResponse{
+		Member: Member{
+			Address:    addr.String(),
+			Nickname:   k.GetMemberNickname(ctx, addr),
+			Status:     m.Status.ToLowerCaseShortForm(),
+			IsGuardian: m.IsGuardian,
+		},
+	}, nil
+}
+
+func guardians(ctx sdk.Context, k *keeper.Keeper) []Guardian {
+	dd := k.GetDirectDemocracySettings(ctx)
+
+	res := []Guardian{}
+	for _, g := range k.GetGuardians(ctx) {
+		res = append(res, Guardian{
+			Address: g.Address,
+			Weight:  dd.GetGuardianWeight(g.Address),
+		})
+	}
+	return res
+}
+
+func memberStatusCount(ctx sdk.Context, k *keeper.Keeper, query *MemberStatusCountQuery) (MemberStatusCountResponse, error) {
+	status := types.ParseShortFormMembershipStatus(query.Status)
+	if !status.IsValid() {
+		return MemberStatusCountResponse{}, errorsmod.Wrapf(types.ErrInvalidMembershipStatus, "expected one of: %s", types.GetAllShortFormMembershipStatusesAsString())
+	}
+
+	return MemberStatusCountResponse{Count: k.GetMemberStatusCount(ctx, status)}, nil
+}
+
+func directDemocracy(ctx sdk.Context, k *keeper.Keeper) DirectDemocracyResponse {
+	dd := k.GetDirectDemocracySettings(ctx)
+	if dd == nil {
+		return DirectDemocracyResponse{TotalVotingWeight: sdk.ZeroDec().String(), Guardians: []Guardian{}}
+	}
+
+	return DirectDemocracyResponse{
+		TotalVotingWeight: dd.TotalVotingWeight.String(),
+		Guardians:         guardians(ctx, k),
+	}
+}
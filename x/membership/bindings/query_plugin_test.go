@@ -0,0 +1,97 @@
+package bindings_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noria-net/module-membership/app"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/noria-net/module-membership/x/membership/bindings"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/stretchr/testify/require"
+)
+
+// setupMembers creates an app with an electorate member, a pending member and
+// a guardian
+func setupMembers(t *testing.T) (*app.WasmApp, sdk.Context, sdk.AccAddress, sdk.AccAddress, sdk.AccAddress) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now()})
+	k := wasmApp.MembershipKeeper
+
+	var addrs []sdk.AccAddress
+	for i := 0; i < 3; i++ {
+		addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+		require.NoError(t, k.AppendMember(ctx, addr))
+		addrs = append(addrs, addr)
+	}
+	member, pending, guardian := addrs[0], addrs[1], addrs[2]
+	require.NoError(t, k.UpdateMemberStatus(ctx, member, types.MembershipStatus_MemberElectorate))
+	require.NoError(t, k.UpdateMemberStatus(ctx, guardian, types.MembershipStatus_MemberElectorate))
+	k.SetMemberNickname(ctx, member, "alice")
+
+	require.NoError(t, k.SetMemberGuardianStatus(ctx, guardian, true))
+	dd := types.DefaultDirectDemocracy()
+	dd.Guardians = []string{guardian.String()}
+	k.SetDirectDemocracySettings(ctx, &dd)
+
+	return wasmApp, ctx, member, pending, guardian
+}
+
+// query runs a membership custom query and decodes its response
+func query(t *testing.T, wasmApp *app.WasmApp, ctx sdk.Context, q bindings.MembershipQuery, res interface{}) error {
+	request, err := bindings.EncodeQuery(q)
+	require.NoError(t, err)
+
+	bz, err := bindings.CustomQuerier(&wasmApp.MembershipKeeper)(ctx, request)
+	if err != nil {
+		return err
+	}
+	require.NoError(t, json.Unmarshal(bz, res))
+	return nil
+}
+
+func TestCustomQuerier(t *testing.T) {
+	wasmApp, ctx, member, pending, guardian := setupMembers(t)
+	outsider := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	var isMember bindings.IsMemberResponse
+	require.NoError(t, query(t, wasmApp, ctx, bindings.MembershipQuery{IsMember: &bindings.IsMemberQuery{Address: member.String()}}, &isMember))
+	require.Equal(t, bindings.IsMemberResponse{IsMember: true, Electorate: true}, isMember)
+	require.NoError(t, query(t, wasmApp, ctx, bindings.MembershipQuery{IsMember: &bindings.IsMemberQuery{Address: pending.String()}}, &isMember))
+	require.Equal(t, bindings.IsMemberResponse{IsMember: true}, isMember)
+	require.NoError(t, query(t, wasmApp, ctx, bindings.MembershipQuery{IsMember: &bindings.IsMemberQuery{Address: outsider.String()}}, &isMember))
+	require.Equal(t, bindings.IsMemberResponse{}, isMember)
+	require.Error(t, query(t, wasmApp, ctx, bindings.MembershipQuery{IsMember: &bindings.IsMemberQuery{Address: "invalid"}}, &isMember))
+
+	var m bindings.MemberResponse
+	require.NoError(t, query(t, wasmApp, ctx, bindings.MembershipQuery{Member: &bindings.MemberQuery{Address: member.String()}}, &m))
+	require.Equal(t, bindings.Member{Address: member.String(), Nickname: "alice", Status: "electorate"}, m.Member)
+	require.NoError(t, query(t, wasmApp, ctx, bindings.MembershipQuery{Member: &bindings.MemberQuery{Address: guardian.String()}}, &m))
+	require.True(t, m.Member.IsGuardian)
+	require.ErrorIs(t, query(t, wasmApp, ctx, bindings.MembershipQuery{Member: &bindings.MemberQuery{Address: outsider.String()}}, &m), types.ErrMemberNotFound)
+
+	var guardians bindings.GuardiansResponse
+	require.NoError(t, query(t, wasmApp, ctx, bindings.MembershipQuery{Guardians: &bindings.GuardiansQuery{}}, &guardians))
+	require.Equal(t, []bindings.Guardian{{Address: guardian.String(), Weight: types.DefaultGuardianWeight}}, guardians.Guardians)
+
+	var count bindings.MemberStatusCountResponse
+	require.NoError(t, query(t, wasmApp, ctx, bindings.MembershipQuery{MemberStatusCount: &bindings.MemberStatusCountQuery{Status: "electorate"}}, &count))
+	require.Equal(t, uint64(2), count.Count)
+	require.NoError(t, query(t, wasmApp, ctx, bindings.MembershipQuery{MemberStatusCount: &bindings.MemberStatusCountQuery{Status: "pending_approval"}}, &count))
+	require.Equal(t, uint64(1), count.Count)
+	require.ErrorIs(t, query(t, wasmApp, ctx, bindings.MembershipQuery{MemberStatusCount: &bindings.MemberStatusCountQuery{Status: "unknown"}}, &count), types.ErrInvalidMembershipStatus)
+
+	var dd bindings.DirectDemocracyResponse
+	require.NoError(t, query(t, wasmApp, ctx, bindings.MembershipQuery{DirectDemocracy: &bindings.DirectDemocracyQuery{}}, &dd))
+	require.Equal(t, types.DefaultDirectDemocracy().TotalVotingWeight.String(), dd.TotalVotingWeight)
+	require.Equal(t, guardians.Guardians, dd.Guardians)
+
+	// Queries without a known variant are rejected
+	_, err := bindings.CustomQuerier(&wasmApp.MembershipKeeper)(ctx, []byte(`{"membership":{}}`))
+	require.Error(t, err)
+	_, err = bindings.CustomQuerier(&wasmApp.MembershipKeeper)(ctx, []byte(`{"other":{}}`))
+	require.Error(t, err)
+}
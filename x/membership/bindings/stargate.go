@@ -0,0 +1,29 @@
+package bindings
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+// AcceptedStargateQueries lists the membership gRPC queries that contracts
+// can make as stargate queries. Paginated queries are left out, since their
+// cost grows with the size of the membership.
+func AcceptedStargateQueries() wasmkeeper.AcceptedStargateQueries {
+	return wasmkeeper.AcceptedStargateQueries{
+		"/membershipmodule.membership.Query/Params":             &types.QueryParamsResponse{},
+		"/membershipmodule.membership.Query/Member":             &types.QueryMemberResponse{},
+		"/membershipmodule.membership.Query/Guardians":          &types.QueryGuardiansResponse{},
+		"/membershipmodule.membership.Query/Invitation":         &types.QueryInvitationResponse{},
+		"/membershipmodule.membership.Query/RecallPetition":     &types.QueryRecallPetitionResponse{},
+		"/membershipmodule.membership.Query/ExpulsionAppeal":    &types.QueryExpulsionAppealResponse{},
+		"/membershipmodule.membership.Query/CurrentElection":    &types.QueryCurrentElectionResponse{},
+		"/membershipmodule.membership.Query/ElectionResult":     &types.QueryElectionResultResponse{},
+		"/membershipmodule.membership.Query/TallyBreakdown":     &types.QueryTallyBreakdownResponse{},
+		"/membershipmodule.membership.Query/TallyRule":          &types.QueryTallyRuleResponse{},
+		"/membershipmodule.membership.Query/VoteDelegation":     &types.QueryVoteDelegationResponse{},
+		"/membershipmodule.membership.Query/EffectiveVotePower": &types.QueryEffectiveVotePowerResponse{},
+		"/membershipmodule.membership.Query/SecretBallot":       &types.QuerySecretBallotResponse{},
+		"/membershipmodule.membership.Query/VoteCommit":         &types.QueryVoteCommitResponse{},
+		"/membershipmodule.membership.Query/FeeWaiver":          &types.QueryFeeWaiverResponse{},
+	}
+}
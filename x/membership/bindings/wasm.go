@@ -0,0 +1,31 @@
+package bindings
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/noria-net/module-membership/x/membership/keeper"
+)
+
+// RegisterQueryPlugins returns the wasm keeper options that let contracts
+// query the membership module, through custom queries and the stargate
+// queries on the accept list. The keeper is only used once contracts run, so
+// it can be set up after the wasm keeper.
+func RegisterQueryPlugins(k *keeper.Keeper, queryRouter *baseapp.GRPCQueryRouter, cdc codec.Codec) []wasmkeeper.Option {
+	return []wasmkeeper.Option{
+		wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
+			Custom:   CustomQuerier(k),
+			Stargate: wasmkeeper.AcceptListStargateQuerier(AcceptedStargateQueries(), queryRouter, cdc),
+		}),
+	}
+}
+
+// RegisterMessageEncoders returns the wasm keeper options that let contracts
+// send membership messages as custom messages
+func RegisterMessageEncoders() []wasmkeeper.Option {
+	return []wasmkeeper.Option{
+		wasmkeeper.WithMessageEncoders(&wasmkeeper.MessageEncoders{
+			Custom: CustomEncoder,
+		}),
+	}
+}
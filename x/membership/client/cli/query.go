@@ -32,6 +32,50 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(CmdGuardians())
 
+	cmd.AddCommand(CmdInvitation())
+
+	cmd.AddCommand(CmdRecallPetition())
+
+	cmd.AddCommand(CmdExpulsionAppeal())
+
+	cmd.AddCommand(CmdExpulsionAppeals())
+
+	cmd.AddCommand(CmdSuspensions())
+
+	cmd.AddCommand(CmdCandidates())
+
+	cmd.AddCommand(CmdCurrentElection())
+
+	cmd.AddCommand(CmdElectionResult())
+
+	cmd.AddCommand(CmdGuardianTermExpirations())
+
+	cmd.AddCommand(CmdTallyBreakdown())
+
+	cmd.AddCommand(CmdTallyRule())
+
+	cmd.AddCommand(CmdVoteDelegation())
+
+	cmd.AddCommand(CmdVoteDelegations())
+
+	cmd.AddCommand(CmdEffectiveVotePower())
+
+	cmd.AddCommand(CmdSecretBallot())
+
+	cmd.AddCommand(CmdVoteCommit())
+
+	cmd.AddCommand(CmdFeeWaiver())
+
+	cmd.AddCommand(CmdElectorateGroup())
+
+	cmd.AddCommand(CmdTreasury())
+
+	cmd.AddCommand(CmdTreasurySpends())
+
+	cmd.AddCommand(CmdDividendPool())
+
+	cmd.AddCommand(CmdMemberRewards())
+
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdElectorateGroup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "electorate-group",
+		Short: "Query the x/group group that mirrors the electorate",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryElectorateGroupRequest{}
+
+			res, err := queryClient.ElectorateGroup(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,122 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdVoteDelegation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-delegation [delegator]",
+		Short: "Query a member's vote delegation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryVoteDelegationRequest{
+				Delegator: args[0],
+			}
+
+			res, err := queryClient.VoteDelegation(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdVoteDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-delegations [delegate]",
+		Short: "Query the vote delegations made to a member",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryVoteDelegationsRequest{
+				Delegate: args[0],
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			params.Pagination = pageReq
+
+			res, err := queryClient.VoteDelegations(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func CmdEffectiveVotePower() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "effective-vote-power [address] [proposal-id]",
+		Short: "Query the votes a member casts, including those delegated to them",
+		Long:  "Query the votes a member casts, including those delegated to them. Without a proposal ID, only delegations that are not limited to particular message types are counted.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var proposalID uint64
+			if len(args) > 1 {
+				proposalID, err = strconv.ParseUint(args[1], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryEffectiveVotePowerRequest{
+				Address:    args[0],
+				ProposalId: proposalID,
+			}
+
+			res, err := queryClient.EffectiveVotePower(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
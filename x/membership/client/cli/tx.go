@@ -33,6 +33,21 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdEnroll())
 	cmd.AddCommand(CmdUpdateStatus())
 	cmd.AddCommand(CmdApproveMember())
+	cmd.AddCommand(CmdCreateInvitation())
+	cmd.AddCommand(CmdRevokeInvitation())
+	cmd.AddCommand(CmdPetitionRecall())
+	cmd.AddCommand(CmdSignRecallPetition())
+	cmd.AddCommand(CmdAppealExpulsion())
+	cmd.AddCommand(CmdSuspendMember())
+	cmd.AddCommand(CmdDeclareCandidacy())
+	cmd.AddCommand(CmdCastElectionBallot())
+	cmd.AddCommand(CmdDelegateVote())
+	cmd.AddCommand(CmdUndelegateVote())
+	cmd.AddCommand(CmdEnableSecretBallot())
+	cmd.AddCommand(CmdCommitVote())
+	cmd.AddCommand(CmdRevealVote())
+	cmd.AddCommand(CmdDonate())
+	cmd.AddCommand(CmdClaimMemberRewards())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdAppealExpulsion() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "appeal-expulsion [statement]",
+		Short: "Appeal the caller's expulsion",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Appeal the caller's expulsion.
+
+Filing an appeal submits a proposal for the electorate to vote on the caller's reinstatement. Appeals must be filed before the appeal period following the expulsion ends.
+
+Example:
+$ %s tx membership appeal-expulsion "<statement>" --from=<key_or_address>
+`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argStatement := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAppealExpulsion(
+				clientCtx.GetFromAddress().String(),
+				argStatement,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdCastElectionBallot() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cast-election-ballot [election-id] [candidate] [candidate]...",
+		Short: "Cast a ballot in the open guardian election",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cast a ballot in the open guardian election.
+
+The ballot approves each of the listed candidates. When the election uses single transferable vote, candidates are ranked in the order they are listed. Casting another ballot replaces the previous one.
+
+Example:
+$ %s tx membership cast-election-ballot 1 <candidate1> <candidate2> --from=<key_or_address>
+`, version.AppName)),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argElectionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCastElectionBallot(
+				clientCtx.GetFromAddress().String(),
+				argElectionID,
+				args[1:],
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	govtypes_v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdCommitVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-vote [proposal-id] [options] [salt]",
+		Short: "Commit a secret vote on a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Commit a secret vote on a proposal voted on by secret ballot.
+
+Only the hash of the vote and salt is sent. Keep the options and salt to reveal the vote once the voting period ends, since unrevealed votes do not choose an option. Committing again replaces the previous commitment.
+
+Example:
+$ %s tx membership commit-vote 1 yes <salt> --from=<key_or_address>
+$ %s tx membership commit-vote 1 yes=0.6,no=0.4 <salt> --from=<key_or_address>
+`, version.AppName, version.AppName)),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argProposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argOptions, err := govtypes_v1.WeightedVoteOptionsFromString(args[1])
+			if err != nil {
+				return err
+			}
+			argSalt := args[2]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			voter := clientCtx.GetFromAddress().String()
+			msg := types.NewMsgCommitVote(
+				voter,
+				argProposalID,
+				types.SecretVoteHash(argProposalID, voter, argOptions, argSalt),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
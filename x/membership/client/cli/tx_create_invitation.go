@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagExpiresIn = "expires-in"
+	FlagMaxUses   = "max-uses"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdCreateInvitation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-invitation [secret] [invitee]",
+		Short: "Create an invitation that pre-authorizes enrollment",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create an invitation that pre-authorizes enrollment.
+
+Only the sha256 hash of the secret and the invitee's address is broadcast. The invitee may enroll straight into the electorate by passing the secret to the enroll command's --invitation flag, until the invitation expires, is revoked or runs out of uses. The secret cannot be used to enroll any other address.
+
+NOTE: Only Guardians may execute this command.
+
+Example:
+$ %s tx membership create-invitation <secret> <invitee_address> --expires-in=168h --from=<key_or_address>
+`, version.AppName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argSecret := args[0]
+			argInvitee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			expiresIn, err := cmd.Flags().GetDuration(FlagExpiresIn)
+			if err != nil {
+				return err
+			}
+
+			maxUses, err := cmd.Flags().GetUint64(FlagMaxUses)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateInvitation(
+				clientCtx.GetFromAddress().String(),
+				types.InvitationHash(argSecret, argInvitee),
+				time.Now().Add(expiresIn).UTC(),
+				maxUses,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Duration(FlagExpiresIn, 7*24*time.Hour, "how long the invitation remains valid")
+	cmd.Flags().Uint64(FlagMaxUses, 1, "number of enrollments the invitation may admit")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdDeclareCandidacy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "declare-candidacy [statement]",
+		Short: "Stand for guardian in the next guardian election",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Stand for guardian in the next guardian election.
+
+Only electorate members can stand. A candidacy lasts until the next guardian election closes.
+
+Example:
+$ %s tx membership declare-candidacy "<statement>" --from=<key_or_address>
+`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argStatement := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeclareCandidacy(
+				clientCtx.GetFromAddress().String(),
+				argStatement,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdDelegateVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-vote [delegate] [msg-type-url]...",
+		Short: "Name a member who votes on your behalf",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Name a member who votes on your behalf whenever you do not vote.
+
+The delegation can be limited to proposals whose messages all have one of the listed types. Delegating again replaces the previous delegation. Guardians cannot delegate their vote.
+
+Example:
+$ %s tx membership delegate-vote <delegate> --from=<key_or_address>
+$ %s tx membership delegate-vote <delegate> /cosmos.bank.v1beta1.MsgSend --from=<key_or_address>
+`, version.AppName, version.AppName)),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argDelegate := args[0]
+			argMsgTypeURLs := args[1:]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDelegateVote(
+				clientCtx.GetFromAddress().String(),
+				argDelegate,
+				argMsgTypeURLs,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdEnableSecretBallot() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "enable-secret-ballot [proposal-id]",
+		Short: "Have your proposal voted on by secret ballot",
+		Long:  "Have your proposal voted on by secret ballot. Votes are committed as hashes during the voting period, and revealed afterwards. The ballot can only be made secret before anyone has voted. A proposal ID of 0 refers to the proposal submitted most recently, so that a transaction can submit a proposal and make its ballot secret at once.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argProposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgEnableSecretBallot(
+				clientCtx.GetFromAddress().String(),
+				argProposalID,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
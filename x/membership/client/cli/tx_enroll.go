@@ -14,7 +14,8 @@ import (
 )
 
 const (
-	FlagNickname = "nickname"
+	FlagNickname   = "nickname"
+	FlagInvitation = "invitation"
 )
 
 var _ = strconv.Itoa(0)
@@ -27,10 +28,12 @@ func CmdEnroll() *cobra.Command {
 			fmt.Sprintf(`Enroll the caller as an electorate member.
 
 NOTE: A Guardian will need to approve the member's enrollment before it becomes active. This is done with the approve-member command.
+Enrolling with a valid invitation secret skips the approval step.
 
 Example:
 $ %s tx membership enroll --from=<key_or_address> --nickname=<nickname>
-`, version.AppName)),
+$ %s tx membership enroll --from=<key_or_address> --invitation=<secret>
+`, version.AppName, version.AppName)),
 		Args: cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -43,9 +46,15 @@ $ %s tx membership enroll --from=<key_or_address> --nickname=<nickname>
 				return err
 			}
 
+			invitation, err := cmd.Flags().GetString(FlagInvitation)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgEnroll(
 				clientCtx.GetFromAddress().String(),
 				nickname,
+				invitation,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -56,6 +65,7 @@ $ %s tx membership enroll --from=<key_or_address> --nickname=<nickname>
 
 	// Add nickname flag
 	cmd.Flags().String(FlagNickname, "", "nickname of the member")
+	cmd.Flags().String(FlagInvitation, "", "invitation secret that pre-authorizes the enrollment")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
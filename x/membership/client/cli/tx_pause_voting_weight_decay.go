@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	gov_v1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+const flagResume = "resume"
+
+func NewSubmitPauseVotingWeightDecayProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-voting-weight-decay",
+		Short: "Submit a proposal to pause or resume the decay schedule of the guardians' total voting weight",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to pause or resume the decay schedule of the guardians' total voting weight.
+
+A resumed schedule continues from where it was paused.
+
+NOTE: Only a guardian may submit this proposal.
+
+Example: Pausing the decay schedule
+$ %s tx membership pause-voting-weight-decay --deposit=1000000unoria --from=<key_or_address>
+
+Example: Resuming the decay schedule
+$ %s tx membership pause-voting-weight-decay --resume --deposit=1000000unoria --from=<key_or_address>
+
+`, version.AppName, version.AppName)),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			resume, err := cmd.Flags().GetBool(flagResume)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewPauseVotingWeightDecayProposal(
+				title,
+				description,
+				from.String(),
+				!resume,
+			)
+			// Validate the proposal
+			err = content.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			msg, err := gov_v1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "The proposal title")
+	cmd.Flags().String(cli.FlagDescription, "", "The proposal description")
+	cmd.Flags().String(cli.FlagDeposit, "", "The proposal deposit")
+	cmd.Flags().Bool(flagResume, false, "Resume the schedule instead of pausing it")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
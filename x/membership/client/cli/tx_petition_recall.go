@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdPetitionRecall() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "petition-recall [address] [reason]",
+		Short: "Open a petition to recall a member",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Open a petition to recall a member.
+
+Other electorate members can co-sign the petition with the sign-recall-petition command. Once the recall threshold fraction of the electorate has signed, a recall proposal is submitted to governance on the petitioner's behalf.
+
+NOTE: Only electorate members may execute this command.
+
+Example:
+$ %s tx membership petition-recall <address> "<reason>" --from=<key_or_address>
+`, version.AppName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argTarget := args[0]
+			argReason := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPetitionRecall(
+				clientCtx.GetFromAddress().String(),
+				argTarget,
+				argReason,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
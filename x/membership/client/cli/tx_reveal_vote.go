@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	govtypes_v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdRevealVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal-vote [proposal-id] [options] [salt]",
+		Short: "Reveal a committed secret vote on a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Reveal a committed secret vote once the proposal's voting period has ended.
+
+The options and salt must be exactly those the vote was committed with.
+
+Example:
+$ %s tx membership reveal-vote 1 yes <salt> --from=<key_or_address>
+`, version.AppName)),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argProposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argOptions, err := govtypes_v1.WeightedVoteOptionsFromString(args[1])
+			if err != nil {
+				return err
+			}
+			argSalt := args[2]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevealVote(
+				clientCtx.GetFromAddress().String(),
+				argProposalID,
+				argOptions,
+				argSalt,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
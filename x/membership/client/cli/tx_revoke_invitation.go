@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdRevokeInvitation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-invitation [hash]",
+		Short: "Revoke an invitation using the hash of its secret",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke an invitation using the hash of its secret.
+
+NOTE: Only Guardians may execute this command.
+
+Example:
+$ %s tx membership revoke-invitation <hash> --from=<key_or_address>
+`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argHash := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeInvitation(
+				clientCtx.GetFromAddress().String(),
+				argHash,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
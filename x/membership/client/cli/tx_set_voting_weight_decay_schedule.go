@@ -0,0 +1,190 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	gov_v1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+const (
+	flagStartTime = "start-time"
+	flagStep      = "step"
+	flagInterval  = "interval"
+	flagSpan      = "span"
+)
+
+func NewSubmitSetVotingWeightDecayScheduleProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-voting-weight-decay-schedule [step|linear|none] [start-weight] [floor-weight]",
+		Short: "Submit a proposal to replace the decay schedule of the guardians' total voting weight",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to replace the decay schedule of the guardians' total voting weight.
+
+In step mode, the weight is lowered by --step at every --interval. In linear mode, the weight is lowered continuously until it reaches the floor weight after --span. The schedule starts at --start-time, or immediately if it is not set. Use the "none" mode to remove the current schedule.
+
+NOTE: Only a guardian may submit this proposal.
+
+NOTE: The weights must be >= 0 and <= 1, and the floor weight cannot exceed the start weight
+
+Example: Lowering the weight by 0.1 every 30 days
+$ %s tx membership set-voting-weight-decay-schedule step 0.5 0 --step=0.1 --interval=720h --deposit=1000000unoria --from=<key_or_address>
+
+Example: Lowering the weight linearly over a year
+$ %s tx membership set-voting-weight-decay-schedule linear 0.5 0.1 --span=8760h --deposit=1000000unoria --from=<key_or_address>
+
+`, version.AppName, version.AppName)),
+		Args: cobra.RangeArgs(1, 3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			schedule, err := parseVotingWeightDecaySchedule(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewSetVotingWeightDecayScheduleProposal(
+				title,
+				description,
+				from.String(),
+				schedule,
+			)
+			// Validate the proposal
+			err = content.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			msg, err := gov_v1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "The proposal title")
+	cmd.Flags().String(cli.FlagDescription, "", "The proposal description")
+	cmd.Flags().String(cli.FlagDeposit, "", "The proposal deposit")
+	cmd.Flags().String(flagStartTime, "", "The time the schedule starts, in RFC3339 format")
+	cmd.Flags().String(flagStep, "0", "The amount the weight is lowered by at every interval, in step mode")
+	cmd.Flags().Duration(flagInterval, 0, "The time between steps, in step mode")
+	cmd.Flags().Duration(flagSpan, 0, "The time taken to reach the floor weight, in linear mode")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// parseVotingWeightDecaySchedule builds a decay schedule from the command's
+// arguments and flags, or returns nil if the schedule should be removed
+func parseVotingWeightDecaySchedule(cmd *cobra.Command, args []string) (*types.VotingWeightDecaySchedule, error) {
+	var mode types.DecayMode
+	switch args[0] {
+	case "none":
+		return nil, nil
+	case "step":
+		mode = types.DecayMode_DecayModeStep
+	case "linear":
+		mode = types.DecayMode_DecayModeLinear
+	default:
+		return nil, fmt.Errorf("invalid decay mode: %s", args[0])
+	}
+	if len(args) != 3 {
+		return nil, fmt.Errorf("the start and floor weights are required")
+	}
+
+	startWeight, err := math.LegacyNewDecFromStr(args[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid start weight: %w", err)
+	}
+	floorWeight, err := math.LegacyNewDecFromStr(args[2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid floor weight: %w", err)
+	}
+
+	startTime := time.Now().UTC()
+	startTimeStr, err := cmd.Flags().GetString(flagStartTime)
+	if err != nil {
+		return nil, err
+	}
+	if startTimeStr != "" {
+		if startTime, err = time.Parse(time.RFC3339, startTimeStr); err != nil {
+			return nil, fmt.Errorf("invalid start time: %w", err)
+		}
+	}
+
+	stepStr, err := cmd.Flags().GetString(flagStep)
+	if err != nil {
+		return nil, err
+	}
+	step, err := math.LegacyNewDecFromStr(stepStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid step: %w", err)
+	}
+	interval, err := cmd.Flags().GetDuration(flagInterval)
+	if err != nil {
+		return nil, err
+	}
+	span, err := cmd.Flags().GetDuration(flagSpan)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.VotingWeightDecaySchedule{
+		Mode:        mode,
+		StartWeight: startWeight,
+		FloorWeight: floorWeight,
+		StartTime:   startTime,
+		Step:        step,
+		Interval:    interval,
+		Span:        span,
+	}, nil
+}
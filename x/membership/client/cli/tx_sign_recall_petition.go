@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdSignRecallPetition() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-recall-petition [address]",
+		Short: "Sign the open recall petition against a member",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Sign the open recall petition against a member.
+
+NOTE: Only electorate members may execute this command.
+
+Example:
+$ %s tx membership sign-recall-petition <address> --from=<key_or_address>
+`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argTarget := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSignRecallPetition(
+				clientCtx.GetFromAddress().String(),
+				argTarget,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdSuspendMember() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "suspend-member [address] [duration] [reason]",
+		Short: "Temporarily suspend a member",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Temporarily suspend a member.
+
+A suspended member cannot vote or propose. Their previous status is restored automatically once the duration has passed, which cannot be longer than the max_suspension_duration param.
+
+NOTE: Only Guardians may execute this command, and they cannot suspend other Guardians.
+
+Example:
+$ %s tx membership suspend-member <address> 72h "<reason>" --from=<key_or_address>
+`, version.AppName)),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argMember := args[0]
+			argReason := args[2]
+
+			argDuration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSuspendMember(
+				clientCtx.GetFromAddress().String(),
+				argMember,
+				argReason,
+				argDuration,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
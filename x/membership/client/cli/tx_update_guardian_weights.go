@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	gov_v1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func NewSubmitUpdateGuardianWeightsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-guardian-weights [address=weight]",
+		Short: "Submit a proposal to update the relative weights of one or more guardians",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to update the relative weights of one or more guardians.
+Separate multiple weights with commas.
+
+The guardians share the total voting weight in proportion to their weights.
+Guardians without a weight of their own have a weight of 1.
+
+NOTE: Only a guardian may submit this proposal.
+
+Example: Giving a founding guardian three times the weight of the others
+$ %s tx membership update-guardian-weights <address>=3 --deposit=1000000unoria --from=<key_or_address>
+
+Example: Updating the weights of multiple guardians
+$ %s tx membership update-guardian-weights <address1>=3,<address2>=2 --deposit=1000000unoria --from=<key_or_address>
+
+`, version.AppName, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var argWeights []types.GuardianWeight
+			for _, entry := range strings.Split(args[0], listSeparator) {
+				guardian, weightStr, ok := strings.Cut(entry, "=")
+				if !ok {
+					return fmt.Errorf("invalid guardian weight, expected address=weight: %s", entry)
+				}
+				weight, err := strconv.ParseUint(weightStr, 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid weight for %s: %w", guardian, err)
+				}
+				argWeights = append(argWeights, types.GuardianWeight{Guardian: guardian, Weight: weight})
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewUpdateGuardianWeightsProposal(
+				title,
+				description,
+				from.String(),
+				argWeights,
+			)
+			// Validate the proposal
+			err = content.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			msg, err := gov_v1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "The proposal title")
+	cmd.Flags().String(cli.FlagDescription, "", "The proposal description")
+	cmd.Flags().String(cli.FlagDeposit, "", "The proposal deposit")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
@@ -18,7 +18,7 @@ var _ = strconv.Itoa(0)
 func CmdUpdateStatus() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-status [address] [status]",
-		Short: "Update an electorate member's status",
+		Short: "Update an electorate member's status, as a guardian",
 		Long:  getLongDescription(),
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
@@ -51,7 +51,9 @@ func CmdUpdateStatus() *cobra.Command {
 
 func getLongDescription() string {
 	return strings.TrimSpace(
-		fmt.Sprintf(`Update a user's membership status on The Denom.
+		fmt.Sprintf(`Update a user's membership status on The Denom. Only guardians, or governance
+through a proposal, can update a member's status. Only governance can expel or
+reinstate a member, or change the status of a guardian.
 
 Example:
   To update a member's status to Inactive
@@ -9,4 +9,7 @@ var (
 	AddGuardiansProposalHandler            = govclient.NewProposalHandler(cli.NewSubmitAddGuardiansProposal)
 	RemoveGuardiansProposalHandler         = govclient.NewProposalHandler(cli.NewSubmitRemoveGuardiansProposal)
 	UpdateTotalVotingWeightProposalHandler = govclient.NewProposalHandler(cli.NewSubmitUpdateTotalVotingWeightProposal)
+	SetVotingWeightDecayProposalHandler    = govclient.NewProposalHandler(cli.NewSubmitSetVotingWeightDecayScheduleProposal)
+	PauseVotingWeightDecayProposalHandler  = govclient.NewProposalHandler(cli.NewSubmitPauseVotingWeightDecayProposal)
+	UpdateGuardianWeightsProposalHandler   = govclient.NewProposalHandler(cli.NewSubmitUpdateGuardianWeightsProposal)
 )
@@ -8,11 +8,16 @@ import (
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// Params are needed to start guardian terms
+	k.SetParams(ctx, genState.Params)
+
 	k.SetDirectDemocracySettings(ctx, &genState.DirectDemocracy)
 
 	// Enroll and add guardians
+	var guardians []sdk.AccAddress
 	for _, address := range genState.DirectDemocracy.Guardians {
 		guardian := sdk.MustAccAddressFromBech32(address)
+		guardians = append(guardians, guardian)
 		if !k.IsMember(ctx, guardian) {
 			// Add the member
 			k.AppendMember(ctx, guardian)
@@ -24,8 +29,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		}
 	}
 
+	// Stagger the genesis guardians' terms so they are not all replaced at once
+	k.StaggerGuardianTerms(ctx, guardians)
+
 	// this line is used by starport scaffolding # genesis/module/init
-	k.SetParams(ctx, genState.Params)
 }
 
 // ExportGenesis returns the module's exported genesis
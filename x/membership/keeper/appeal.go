@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+// GetExpulsionAppeal fetches the expulsion appeal of the given member
+func (k Keeper) GetExpulsionAppeal(ctx sdk.Context, member sdk.AccAddress) (types.ExpulsionAppeal, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	var appeal types.ExpulsionAppeal
+
+	bz := store.Get(types.ExpulsionAppealKey(member))
+	if bz == nil {
+		return appeal, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &appeal)
+	return appeal, true
+}
+
+// SetExpulsionAppeal saves an expulsion appeal to the store
+func (k Keeper) SetExpulsionAppeal(ctx sdk.Context, appeal types.ExpulsionAppeal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	member := sdk.MustAccAddressFromBech32(appeal.Member)
+
+	bz := k.cdc.MustMarshal(&appeal)
+	store.Set(types.ExpulsionAppealKey(member), bz)
+}
+
+// RemoveExpulsionAppeal deletes a member's expulsion appeal along with its
+// queue entry and proposal index, if any
+func (k Keeper) RemoveExpulsionAppeal(ctx sdk.Context, member sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+
+	appeal, found := k.GetExpulsionAppeal(ctx, member)
+	if !found {
+		return
+	}
+
+	store.Delete(types.AppealQueueKey(appeal.Deadline, member))
+	if appeal.ProposalId != 0 {
+		store.Delete(types.AppealProposalKey(appeal.ProposalId))
+	}
+	store.Delete(types.ExpulsionAppealKey(member))
+}
+
+// OpenExpulsionAppealWindow gives a newly expelled member until the end of
+// the appeal period to appeal their expulsion
+func (k Keeper) OpenExpulsionAppealWindow(ctx sdk.Context, member sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+
+	// Replace any earlier appeal from a previous expulsion
+	k.RemoveExpulsionAppeal(ctx, member)
+
+	expelledAt := ctx.BlockTime()
+	deadline := expelledAt.Add(k.AppealPeriod(ctx))
+
+	k.SetExpulsionAppeal(ctx, types.ExpulsionAppeal{
+		Member:     member.String(),
+		ExpelledAt: expelledAt,
+		Deadline:   deadline,
+		Status:     types.AppealStatus_AppealWindowOpen,
+	})
+	store.Set(types.AppealQueueKey(deadline, member), member)
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventExpulsionAppealWindowOpened{
+			MemberAddress: member.String(),
+			Deadline:      deadline,
+		},
+	)
+}
+
+// FileExpulsionAppeal closes the member's appeal window and records the
+// reinstatement proposal created by their appeal
+func (k Keeper) FileExpulsionAppeal(ctx sdk.Context, appeal types.ExpulsionAppeal, statement string, proposalID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	member := sdk.MustAccAddressFromBech32(appeal.Member)
+
+	store.Delete(types.AppealQueueKey(appeal.Deadline, member))
+	store.Set(types.AppealProposalKey(proposalID), member)
+
+	appeal.Statement = statement
+	appeal.ProposalId = proposalID
+	appeal.Status = types.AppealStatus_AppealFiled
+	k.SetExpulsionAppeal(ctx, appeal)
+}
+
+// ReinstateMember returns an expelled member to the electorate, which also
+// closes their appeal. Members are only reinstated through a filed appeal,
+// and reinstatement bypasses the status transition table.
+func (k Keeper) ReinstateMember(ctx sdk.Context, target sdk.AccAddress) error {
+	member, found := k.GetMemberAccount(ctx, target)
+	if !found {
+		return errors.Wrapf(types.ErrMemberNotFound, "member not found: %s", target.String())
+	}
+	if member.Status != types.MembershipStatus_MemberExpulsed {
+		return errors.Wrapf(types.ErrMembershipStatusChangeNotAllowed, "transition %s is not allowed", member.Status.DescribeTransition(types.MembershipStatus_MemberElectorate))
+	}
+
+	appeal, found := k.GetExpulsionAppeal(ctx, target)
+	if !found {
+		return types.ErrExpulsionAppealNotFound
+	}
+	if appeal.Status != types.AppealStatus_AppealFiled {
+		return errors.Wrap(types.ErrInvalidExpulsionAppeal, "member has no pending appeal")
+	}
+
+	return k.setMemberStatus(ctx, member, types.MembershipStatus_MemberElectorate)
+}
+
+// FinalizeExpulsionAppeal makes an expulsion final, either because the appeal
+// window closed or because the appeal was unsuccessful
+func (k Keeper) FinalizeExpulsionAppeal(ctx sdk.Context, appeal types.ExpulsionAppeal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	member := sdk.MustAccAddressFromBech32(appeal.Member)
+
+	store.Delete(types.AppealQueueKey(appeal.Deadline, member))
+	if appeal.ProposalId != 0 {
+		store.Delete(types.AppealProposalKey(appeal.ProposalId))
+	}
+
+	appeal.Status = types.AppealStatus_AppealFinalized
+	k.SetExpulsionAppeal(ctx, appeal)
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventExpulsionFinalized{
+			MemberAddress: appeal.Member,
+		},
+	)
+}
+
+// FinalizeExpiredExpulsionAppeals finalizes every expulsion whose appeal
+// window has closed without an appeal being filed
+func (k Keeper) FinalizeExpiredExpulsionAppeals(ctx sdk.Context) {
+	var expired []types.ExpulsionAppeal
+
+	k.IterateAppealQueue(ctx, ctx.BlockTime(), func(member sdk.AccAddress) (stop bool) {
+		if appeal, found := k.GetExpulsionAppeal(ctx, member); found {
+			expired = append(expired, appeal)
+		}
+		return false
+	})
+
+	// Finalize outside of the iterator, as finalizing mutates the queue
+	for _, appeal := range expired {
+		k.FinalizeExpulsionAppeal(ctx, appeal)
+	}
+}
+
+// IterateAppealQueue iterates over the members whose appeal window closes at
+// or before endTime and performs a callback function
+func (k Keeper) IterateAppealQueue(ctx sdk.Context, endTime time.Time, cb func(member sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.AppealQueueKeyPrefix, sdk.PrefixEndBytes(types.AppealQueueByTimeKey(endTime)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(iterator.Value()) {
+			break
+		}
+	}
+}
+
+// appealingMember returns the member appealing through the given proposal, if any
+func (k Keeper) appealingMember(ctx sdk.Context, proposalID uint64) (sdk.AccAddress, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	bz := store.Get(types.AppealProposalKey(proposalID))
+	if bz == nil {
+		return nil, false
+	}
+	return sdk.AccAddress(bz), true
+}
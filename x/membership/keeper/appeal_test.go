@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	testkeeper "github.com/noria-net/module-membership/testutil/keeper"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/noria-net/module-membership/x/membership/keeper"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFinalizeExpiredExpulsionAppeals(t *testing.T) {
+	k, ctx := testkeeper.MembershipKeeper(t)
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+
+	member := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	k.OpenExpulsionAppealWindow(ctx, member)
+
+	appeal, found := k.GetExpulsionAppeal(ctx, member)
+	require.True(t, found)
+	require.Equal(t, types.AppealStatus_AppealWindowOpen, appeal.Status)
+	require.Equal(t, now.Add(types.DefaultAppealPeriod), appeal.Deadline)
+	require.True(t, appeal.CanAppeal(now))
+
+	// The window stays open until the deadline
+	ctx = ctx.WithBlockTime(appeal.Deadline.Add(-time.Second))
+	k.FinalizeExpiredExpulsionAppeals(ctx)
+	appeal, _ = k.GetExpulsionAppeal(ctx, member)
+	require.Equal(t, types.AppealStatus_AppealWindowOpen, appeal.Status)
+
+	// The expulsion is finalized once the deadline passes
+	ctx = ctx.WithBlockTime(appeal.Deadline)
+	k.FinalizeExpiredExpulsionAppeals(ctx)
+	appeal, _ = k.GetExpulsionAppeal(ctx, member)
+	require.Equal(t, types.AppealStatus_AppealFinalized, appeal.Status)
+	require.False(t, appeal.CanAppeal(ctx.BlockTime()))
+
+	// The queue is empty afterwards
+	k.IterateAppealQueue(ctx, appeal.Deadline.Add(time.Hour), func(sdk.AccAddress) bool {
+		t.Fatal("appeal queue should be empty")
+		return true
+	})
+}
+
+func TestFiledExpulsionAppealIsNotFinalizedByDeadline(t *testing.T) {
+	k, ctx := testkeeper.MembershipKeeper(t)
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+
+	member := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	k.OpenExpulsionAppealWindow(ctx, member)
+
+	appeal, _ := k.GetExpulsionAppeal(ctx, member)
+	k.FileExpulsionAppeal(ctx, appeal, "statement", 7)
+
+	ctx = ctx.WithBlockTime(appeal.Deadline.Add(time.Hour))
+	k.FinalizeExpiredExpulsionAppeals(ctx)
+
+	appeal, _ = k.GetExpulsionAppeal(ctx, member)
+	require.Equal(t, types.AppealStatus_AppealFiled, appeal.Status)
+	require.Equal(t, uint64(7), appeal.ProposalId)
+	require.Equal(t, "statement", appeal.Statement)
+
+	// An unsuccessful appeal finalizes the expulsion
+	k.OnProposalFinalized(ctx, 7)
+	appeal, _ = k.GetExpulsionAppeal(ctx, member)
+	require.Equal(t, types.AppealStatus_AppealFinalized, appeal.Status)
+}
+
+func TestReinstateMember(t *testing.T) {
+	k, ctx := testkeeper.MembershipKeeper(t)
+	ctx = ctx.WithBlockTime(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	member := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	require.NoError(t, k.AppendMember(ctx, member))
+	require.NoError(t, k.UpdateMemberStatus(ctx, member, types.MembershipStatus_MemberElectorate))
+	require.NoError(t, k.UpdateMemberStatus(ctx, member, types.MembershipStatus_MemberExpulsed))
+
+	// Expelled members can only be reinstated through an appeal while it is open
+	err := k.UpdateMemberStatus(ctx, member, types.MembershipStatus_MemberElectorate)
+	require.ErrorIs(t, err, types.ErrInvalidExpulsionAppeal)
+	require.ErrorIs(t, k.ReinstateMember(ctx, member), types.ErrInvalidExpulsionAppeal)
+
+	appeal, _ := k.GetExpulsionAppeal(ctx, member)
+	k.FileExpulsionAppeal(ctx, appeal, "statement", 7)
+	err = k.UpdateMemberStatus(ctx, member, types.MembershipStatus_MemberElectorate)
+	require.ErrorIs(t, err, types.ErrInvalidExpulsionAppeal)
+	require.NoError(t, k.ReinstateMember(ctx, member))
+	m, _ := k.GetMemberAccount(ctx, member)
+	require.Equal(t, types.MembershipStatus_MemberElectorate, m.Status)
+	_, found := k.GetExpulsionAppeal(ctx, member)
+	require.False(t, found)
+	require.ErrorIs(t, k.ReinstateMember(ctx, member), types.ErrMembershipStatusChangeNotAllowed)
+
+	// Finalized expulsions cannot be appealed, but governance can still
+	// reinstate the member
+	require.NoError(t, k.UpdateMemberStatus(ctx, member, types.MembershipStatus_MemberExpulsed))
+	appeal, _ = k.GetExpulsionAppeal(ctx, member)
+	k.FinalizeExpulsionAppeal(ctx, appeal)
+	require.ErrorIs(t, k.ReinstateMember(ctx, member), types.ErrInvalidExpulsionAppeal)
+	require.NoError(t, k.UpdateMemberStatus(ctx, member, types.MembershipStatus_MemberElectorate))
+	m, _ = k.GetMemberAccount(ctx, member)
+	require.Equal(t, types.MembershipStatus_MemberElectorate, m.Status)
+	_, found = k.GetExpulsionAppeal(ctx, member)
+	require.False(t, found)
+}
+
+func TestOnlyGovernanceReinstatesExpelledMembers(t *testing.T) {
+	k, ctx := testkeeper.MembershipKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	guardian := setupGuardian(t, k, ctx)
+	dd := types.DefaultDirectDemocracy()
+	dd.Guardians = []string{guardian.String()}
+	k.SetDirectDemocracySettings(ctx, &dd)
+
+	member := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	require.NoError(t, k.AppendMember(ctx, member))
+	require.NoError(t, k.UpdateMemberStatus(ctx, member, types.MembershipStatus_MemberElectorate))
+	require.NoError(t, k.UpdateMemberStatus(ctx, member, types.MembershipStatus_MemberExpulsed))
+	appeal, _ := k.GetExpulsionAppeal(ctx, member)
+	k.FinalizeExpulsionAppeal(ctx, appeal)
+
+	_, err := msgServer.UpdateStatus(ctx, types.NewMsgUpdateStatus(guardian.String(), member.String(), types.MembershipStatus_MemberElectorate))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.UpdateStatus(ctx, types.NewMsgUpdateStatus(authority, member.String(), types.MembershipStatus_MemberElectorate))
+	require.NoError(t, err)
+	m, _ := k.GetMemberAccount(ctx, member)
+	require.Equal(t, types.MembershipStatus_MemberElectorate, m.Status)
+}
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes_v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+// GetTallyBreakdown fetches how each chamber voted on a tallied proposal
+func (k Keeper) GetTallyBreakdown(ctx sdk.Context, proposalID uint64) (types.TallyBreakdown, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	var breakdown types.TallyBreakdown
+
+	bz := store.Get(types.TallyBreakdownKey(proposalID))
+	if bz == nil {
+		return breakdown, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &breakdown)
+	return breakdown, true
+}
+
+// SetTallyBreakdown saves how each chamber voted on a proposal
+func (k Keeper) SetTallyBreakdown(ctx sdk.Context, breakdown types.TallyBreakdown) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	store.Set(types.TallyBreakdownKey(breakdown.ProposalId), k.cdc.MustMarshal(&breakdown))
+}
+
+// IsBicameralProposal returns true if any of the proposal's messages must be
+// approved by each chamber
+func (k Keeper) IsBicameralProposal(ctx sdk.Context, proposal govtypes_v1.Proposal) bool {
+	bicameral := k.BicameralMsgTypes(ctx)
+	for _, msgType := range proposalMsgTypes(proposal) {
+		if containsString(bicameral, msgType) {
+			return true
+		}
+	}
+	return false
+}
+
+// proposalMsgTypes returns the type URLs of the proposal's messages, as well
+// as the content of any legacy proposal
+func proposalMsgTypes(proposal govtypes_v1.Proposal) []string {
+	var msgTypes []string
+	for _, msg := range proposal.Messages {
+		msgTypes = append(msgTypes, msgTypeURLs(msg)...)
+	}
+	return msgTypes
+}
+
+// msgTypeURLs returns the type URL of the message, preceded by the type URL
+// of its content if it is a legacy proposal
+func msgTypeURLs(msg *codectypes.Any) []string {
+	if msg.TypeUrl != sdk.MsgTypeURL(&govtypes_v1.MsgExecLegacyContent{}) {
+		return []string{msg.TypeUrl}
+	}
+
+	var legacy govtypes_v1.MsgExecLegacyContent
+	if err := legacy.Unmarshal(msg.Value); err != nil || legacy.Content == nil {
+		return []string{msg.TypeUrl}
+	}
+	return []string{legacy.Content.TypeUrl, msg.TypeUrl}
+}
+
+// getChamberGovParams returns the gov params a chamber holds the proposal to.
+// The chamber's rule, when set, takes the place of the gov quorum, threshold
+// and veto threshold, while the tally rules of the proposal's messages still
+// apply.
+func (k Keeper) getChamberGovParams(ctx sdk.Context, proposal govtypes_v1.Proposal, chamberRule types.ChamberRule) govtypes_v1.Params {
+	return resolveChamberGovParams(k.TallyRules(ctx), proposal, k.GetGovParams(ctx), chamberRule)
+}
+
+// resolveChamberGovParams applies the chamber's rule to the gov params, then
+// holds the proposal's messages to their tally rules
+func resolveChamberGovParams(rules []types.TallyRule, proposal govtypes_v1.Proposal, govParams govtypes_v1.Params, chamberRule types.ChamberRule) govtypes_v1.Params {
+	govParams = chamberRule.ApplyTo(govParams)
+	rule, _ := resolveTallyRule(rules, proposal, govParams)
+	return rule.ApplyTo(govParams)
+}
+
+// calculateChamberTally decides whether a chamber approves the proposal, by
+// applying the quorum, threshold and veto threshold to its votes alone
+func calculateChamberTally(results voteOptions, eligible math.Int, govParams govtypes_v1.Params) types.ChamberTally {
+	chamber := types.ChamberTally{
+		YesCount:        results[govtypes_v1.OptionYes],
+		AbstainCount:    results[govtypes_v1.OptionAbstain],
+		NoCount:         results[govtypes_v1.OptionNo],
+		NoWithVetoCount: results[govtypes_v1.OptionNoWithVeto],
+		Eligible:        eligible,
+	}
+
+	// Nobody sits in this chamber
+	if !eligible.IsPositive() {
+		return chamber
+	}
+
+	votes := chamber.YesCount.Add(chamber.AbstainCount).Add(chamber.NoCount).Add(chamber.NoWithVetoCount)
+	turnout := votes.Quo(math.LegacyNewDecFromInt(eligible))
+	chamber.QuorumReached = turnout.GTE(sdk.MustNewDecFromStr(govParams.Quorum))
+
+	// If no one votes (everyone abstains), the chamber does not approve
+	nonAbstaining := votes.Sub(chamber.AbstainCount)
+	if nonAbstaining.IsZero() {
+		return chamber
+	}
+
+	veto := chamber.NoWithVetoCount.Quo(nonAbstaining)
+	chamber.Vetoed = veto.GT(sdk.MustNewDecFromStr(govParams.VetoThreshold))
+
+	yes := chamber.YesCount.Quo(nonAbstaining)
+	chamber.Passed = chamber.QuorumReached && !chamber.Vetoed && yes.GT(sdk.MustNewDecFromStr(govParams.Threshold))
+
+	return chamber
+}
+
+// calculateBicameralVoteResults passes the proposal only if every chamber
+// with eligible voters approves it. Either chamber missing quorum or vetoing
+// the proposal has the same consequences as it would for the whole electorate.
+func calculateBicameralVoteResults(breakdown types.TallyBreakdown, govParams govtypes_v1.Params) (passes bool, burnDeposits bool) {
+	var chambers []types.ChamberTally
+	for _, chamber := range []types.ChamberTally{breakdown.Guardians, breakdown.Members} {
+		if chamber.Eligible.IsPositive() {
+			chambers = append(chambers, chamber)
+		}
+	}
+
+	// Nobody can vote
+	if len(chambers) == 0 {
+		return false, false
+	}
+
+	for _, chamber := range chambers {
+		if !chamber.QuorumReached {
+			return false, govParams.BurnVoteQuorum
+		}
+	}
+	for _, chamber := range chambers {
+		if chamber.Vetoed {
+			return false, govParams.BurnVoteVeto
+		}
+	}
+	for _, chamber := range chambers {
+		if !chamber.Passed {
+			return false, false
+		}
+	}
+
+	return true, false
+}
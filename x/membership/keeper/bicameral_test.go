@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes_v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	testkeeper "github.com/noria-net/module-membership/testutil/keeper"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsBicameralProposal(t *testing.T) {
+	k, ctx := testkeeper.MembershipKeeper(t)
+
+	content, err := codectypes.NewAnyWithValue(&types.RemoveGuardiansProposal{Title: "title"})
+	require.NoError(t, err)
+	legacy, err := codectypes.NewAnyWithValue(&govtypes_v1.MsgExecLegacyContent{Content: content})
+	require.NoError(t, err)
+	proposal := govtypes_v1.Proposal{Id: 1, Messages: []*codectypes.Any{legacy}}
+
+	require.False(t, k.IsBicameralProposal(ctx, proposal))
+
+	params := types.DefaultParams()
+	params.BicameralMsgTypes = []string{"/membershipmodule.membership.RemoveGuardiansProposal"}
+	k.SetParams(ctx, params)
+	require.True(t, k.IsBicameralProposal(ctx, proposal))
+}
+
+func TestTallyBreakdownQuery(t *testing.T) {
+	k, ctx := testkeeper.MembershipKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	_, err := k.TallyBreakdown(wctx, &types.QueryTallyBreakdownRequest{ProposalId: 1})
+	require.Error(t, err)
+
+	breakdown := types.TallyBreakdown{
+		ProposalId: 1,
+		Bicameral:  true,
+		Guardians:  types.ChamberTally{YesCount: sdk.NewDec(2), AbstainCount: sdk.ZeroDec(), NoCount: sdk.ZeroDec(), NoWithVetoCount: sdk.ZeroDec(), Eligible: sdk.NewInt(2), QuorumReached: true, Passed: true},
+		Members:    types.ChamberTally{YesCount: sdk.ZeroDec(), AbstainCount: sdk.ZeroDec(), NoCount: sdk.NewDec(3), NoWithVetoCount: sdk.ZeroDec(), Eligible: sdk.NewInt(4), QuorumReached: true},
+	}
+	k.SetTallyBreakdown(ctx, breakdown)
+
+	res, err := k.TallyBreakdown(wctx, &types.QueryTallyBreakdownRequest{ProposalId: 1})
+	require.NoError(t, err)
+	require.Equal(t, breakdown, *res.Breakdown)
+}
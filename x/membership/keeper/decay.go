@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+// setTotalVotingWeight saves the direct democracy settings with the new total
+// voting weight, and publishes the change
+func (k Keeper) setTotalVotingWeight(ctx sdk.Context, dd *types.DirectDemocracy, weight sdk.Dec) error {
+	// Save the old value and update to the new value
+	oldTotalVotingWeight := dd.TotalVotingWeight
+	dd.TotalVotingWeight = weight
+	k.SetDirectDemocracySettings(ctx, dd)
+
+	// Emit an event saying the total voting weight has changed
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventTotalVotingWeightChanged{
+			OldTotalVotingWeight: oldTotalVotingWeight,
+			NewTotalVotingWeight: weight,
+		},
+	)
+}
+
+// ApplyVotingWeightDecay lowers the total voting weight as prescribed by the
+// decay schedule. The schedule is removed once it reaches its floor weight.
+func (k Keeper) ApplyVotingWeightDecay(ctx sdk.Context) {
+	dd := k.GetDirectDemocracySettings(ctx)
+	if dd == nil || dd.DecaySchedule == nil {
+		return
+	}
+
+	schedule := dd.DecaySchedule
+	if schedule.Paused || ctx.BlockTime().Before(schedule.StartTime) {
+		return
+	}
+
+	weight := schedule.WeightAt(ctx.BlockTime())
+	completed := weight.Equal(schedule.FloorWeight)
+	if completed {
+		dd.DecaySchedule = nil
+	}
+
+	if weight.Equal(dd.TotalVotingWeight) {
+		if completed {
+			k.SetDirectDemocracySettings(ctx, dd)
+		}
+		return
+	}
+
+	if err := k.setTotalVotingWeight(ctx, dd, weight); err != nil {
+		k.Logger(ctx).Error("failed to apply voting weight decay", "error", err)
+	}
+}
+
+// PauseVotingWeightDecay stops the decay schedule from lowering the total
+// voting weight until it is resumed
+func (k Keeper) PauseVotingWeightDecay(ctx sdk.Context) error {
+	dd := k.GetDirectDemocracySettings(ctx)
+	if dd == nil || dd.DecaySchedule == nil {
+		return errors.Wrap(types.ErrInvalidDecaySchedule, "no decay schedule")
+	}
+	if dd.DecaySchedule.Paused {
+		return errors.Wrap(types.ErrInvalidDecaySchedule, "decay schedule is already paused")
+	}
+
+	dd.DecaySchedule.Paused = true
+	dd.DecaySchedule.PausedAt = ctx.BlockTime()
+	k.SetDirectDemocracySettings(ctx, dd)
+
+	return nil
+}
+
+// ResumeVotingWeightDecay resumes a paused decay schedule from where it left
+// off, by delaying its start by the time spent paused
+func (k Keeper) ResumeVotingWeightDecay(ctx sdk.Context) error {
+	dd := k.GetDirectDemocracySettings(ctx)
+	if dd == nil || dd.DecaySchedule == nil {
+		return errors.Wrap(types.ErrInvalidDecaySchedule, "no decay schedule")
+	}
+	if !dd.DecaySchedule.Paused {
+		return errors.Wrap(types.ErrInvalidDecaySchedule, "decay schedule is not paused")
+	}
+
+	schedule := dd.DecaySchedule
+	if schedule.PausedAt.After(schedule.StartTime) {
+		schedule.StartTime = schedule.StartTime.Add(ctx.BlockTime().Sub(schedule.PausedAt))
+	} else if ctx.BlockTime().After(schedule.StartTime) {
+		// Paused before the schedule started, so it starts now
+		schedule.StartTime = ctx.BlockTime()
+	}
+	schedule.Paused = false
+	schedule.PausedAt = time.Time{}
+	k.SetDirectDemocracySettings(ctx, dd)
+
+	return nil
+}
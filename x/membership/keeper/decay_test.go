@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	testkeeper "github.com/noria-net/module-membership/testutil/keeper"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyVotingWeightDecay(t *testing.T) {
+	k, ctx := testkeeper.MembershipKeeper(t)
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	dd := types.DefaultDirectDemocracy()
+	dd.TotalVotingWeight = sdk.NewDecWithPrec(5, 1)
+	dd.DecaySchedule = &types.VotingWeightDecaySchedule{
+		Mode:        types.DecayMode_DecayModeStep,
+		StartWeight: sdk.NewDecWithPrec(5, 1),
+		FloorWeight: sdk.NewDecWithPrec(3, 1),
+		StartTime:   now,
+		Step:        sdk.NewDecWithPrec(1, 1),
+		Interval:    time.Hour,
+	}
+	k.SetDirectDemocracySettings(ctx, &dd)
+
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	k.ApplyVotingWeightDecay(ctx)
+	require.Equal(t, sdk.NewDecWithPrec(4, 1), k.GetDirectDemocracySettings(ctx).TotalVotingWeight)
+
+	// Pausing stops the decay
+	require.NoError(t, k.PauseVotingWeightDecay(ctx))
+	require.ErrorIs(t, k.PauseVotingWeightDecay(ctx), types.ErrInvalidDecaySchedule)
+	ctx = ctx.WithBlockTime(now.Add(5 * time.Hour))
+	k.ApplyVotingWeightDecay(ctx)
+	require.Equal(t, sdk.NewDecWithPrec(4, 1), k.GetDirectDemocracySettings(ctx).TotalVotingWeight)
+
+	// Resuming continues from where the schedule was paused
+	require.NoError(t, k.ResumeVotingWeightDecay(ctx))
+	k.ApplyVotingWeightDecay(ctx)
+	require.Equal(t, sdk.NewDecWithPrec(4, 1), k.GetDirectDemocracySettings(ctx).TotalVotingWeight)
+
+	ctx = ctx.WithBlockTime(now.Add(6 * time.Hour))
+	k.ApplyVotingWeightDecay(ctx)
+	settings := k.GetDirectDemocracySettings(ctx)
+	require.Equal(t, sdk.NewDecWithPrec(3, 1), settings.TotalVotingWeight)
+
+	// The schedule is removed once it reaches the floor
+	require.Nil(t, settings.DecaySchedule)
+	require.ErrorIs(t, k.ResumeVotingWeightDecay(ctx), types.ErrInvalidDecaySchedule)
+}
@@ -0,0 +1,245 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes_v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+// castVotes is a map of voters to the options of the vote they cast, which
+// are nil if the vote was not valid
+type castVotes map[string][]*govtypes_v1.WeightedVoteOption
+
+// GetVoteDelegation fetches the vote delegation of the given member
+func (k Keeper) GetVoteDelegation(ctx sdk.Context, delegator sdk.AccAddress) (types.VoteDelegation, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	var delegation types.VoteDelegation
+
+	bz := store.Get(types.VoteDelegationKey(delegator))
+	if bz == nil {
+		return delegation, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &delegation)
+	return delegation, true
+}
+
+// GetAllVoteDelegations returns every vote delegation
+func (k Keeper) GetAllVoteDelegations(ctx sdk.Context) []types.VoteDelegation {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoteDelegationKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var delegations []types.VoteDelegation
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.VoteDelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &delegation)
+		delegations = append(delegations, delegation)
+	}
+
+	return delegations
+}
+
+// DelegateVote names a member to vote on behalf of an electorate member who
+// does not vote themselves, replacing any previous delegation. Guardians
+// cannot delegate their vote.
+func (k Keeper) DelegateVote(ctx sdk.Context, delegator sdk.AccAddress, delegate sdk.AccAddress, msgTypeURLs []string) error {
+	member, found := k.GetMemberAccount(ctx, delegator)
+	if !found {
+		return errors.Wrapf(types.ErrMemberNotFound, "member not found: %s", delegator.String())
+	}
+	if member.Status != types.MembershipStatus_MemberElectorate {
+		return errors.Wrap(types.ErrInvalidVoteDelegation, "only electorate members can delegate their vote")
+	}
+	if member.IsGuardian {
+		return errors.Wrap(types.ErrInvalidVoteDelegation, "guardians cannot delegate their vote")
+	}
+	if delegator.Equals(delegate) {
+		return errors.Wrap(types.ErrInvalidVoteDelegation, "cannot delegate a vote to oneself")
+	}
+	if !k.isElectorate(ctx, delegate) {
+		return errors.Wrapf(types.ErrInvalidVoteDelegation, "delegate is not an electorate member: %s", delegate.String())
+	}
+
+	delegation := types.VoteDelegation{
+		Delegator:   delegator.String(),
+		Delegate:    delegate.String(),
+		MsgTypeUrls: msgTypeURLs,
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	store.Set(types.VoteDelegationKey(delegator), k.cdc.MustMarshal(&delegation))
+
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventVoteDelegated{
+			Delegator:   delegation.Delegator,
+			Delegate:    delegation.Delegate,
+			MsgTypeUrls: delegation.MsgTypeUrls,
+		},
+	)
+}
+
+// UndelegateVote removes the member's vote delegation
+func (k Keeper) UndelegateVote(ctx sdk.Context, delegator sdk.AccAddress) error {
+	if _, found := k.GetVoteDelegation(ctx, delegator); !found {
+		return errors.Wrapf(types.ErrVoteDelegationNotFound, "no vote delegation for %s", delegator.String())
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	store.Delete(types.VoteDelegationKey(delegator))
+
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventVoteUndelegated{
+			Delegator: delegator.String(),
+		},
+	)
+}
+
+// getProposalDelegations returns the vote delegations that cover the
+// proposal, by delegator
+func (k Keeper) getProposalDelegations(ctx sdk.Context, proposal govtypes_v1.Proposal) (map[string]types.VoteDelegation, []types.VoteDelegation) {
+	var msgTypes [][]string
+	for _, msg := range proposal.Messages {
+		msgTypes = append(msgTypes, msgTypeURLs(msg))
+	}
+
+	byDelegator := make(map[string]types.VoteDelegation)
+	var delegations []types.VoteDelegation
+	for _, delegation := range k.GetAllVoteDelegations(ctx) {
+		if delegation.AppliesTo(msgTypes) {
+			byDelegator[delegation.Delegator] = delegation
+			delegations = append(delegations, delegation)
+		}
+	}
+	return byDelegator, delegations
+}
+
+// getDelegatingMember returns the member if they can have a vote cast on
+// their behalf, which excludes guardians
+func (k Keeper) getDelegatingMember(ctx sdk.Context, delegator string) (*types.Member, bool) {
+	member, found := k.GetMemberAccount(ctx, sdk.MustAccAddressFromBech32(delegator))
+	if !found || member.Status != types.MembershipStatus_MemberElectorate || member.IsGuardian {
+		return nil, false
+	}
+	return &member, true
+}
+
+// processDelegatedVotes counts a vote for each electorate member who did not
+// vote, but delegated their vote to a member who did. Delegated votes are
+// always counted as member votes, even when the delegate is a guardian.
+func (k Keeper) processDelegatedVotes(ctx sdk.Context,
+	proposal govtypes_v1.Proposal,
+	votes castVotes,
+	allowSplitVotes bool,
+	memberResults voteOptions,
+	guardianResults voteOptions) {
+
+	maxDepth := k.MaxDelegationDepth(ctx)
+	if maxDepth == 0 {
+		return
+	}
+
+	byDelegator, delegations := k.getProposalDelegations(ctx, proposal)
+	for _, delegation := range delegations {
+		// Members who voted keep their own vote
+		if _, voted := votes[delegation.Delegator]; voted {
+			continue
+		}
+
+		member, ok := k.getDelegatingMember(ctx, delegation.Delegator)
+		if !ok {
+			continue
+		}
+
+		delegate, found := resolveDelegate(delegation.Delegator, byDelegator, votes, maxDepth)
+		// The delegate's vote must have been valid
+		if !found || votes[delegate] == nil {
+			continue
+		}
+
+		vote := govtypes_v1.Vote{
+			ProposalId: proposal.Id,
+			Voter:      delegation.Delegator,
+			Options:    votes[delegate],
+		}
+		err := processSingleVote(vote, member, true, types.DefaultGuardianWeight, allowSplitVotes, memberResults, guardianResults)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Error processing delegated vote: %s", err.Error()), "voter", delegation.Delegator)
+		}
+	}
+}
+
+// GetEffectiveDelegators returns the members whose votes the given member
+// casts on their behalf. Members who already voted on the proposal keep their
+// own vote. Without a proposal, only unscoped delegations are followed, as if
+// nobody else voted.
+func (k Keeper) GetEffectiveDelegators(ctx sdk.Context, addr sdk.AccAddress, proposal *govtypes_v1.Proposal) (delegators []string) {
+	if !k.isElectorate(ctx, addr) || k.MaxDelegationDepth(ctx) == 0 {
+		return nil
+	}
+
+	votes := make(castVotes)
+	if proposal == nil {
+		proposal = &govtypes_v1.Proposal{}
+	} else {
+		k.IterateVotes(ctx, proposal.Id, func(vote govtypes_v1.Vote) (stop bool) {
+			votes[vote.Voter] = nil
+			return false
+		})
+		for _, commit := range k.GetVoteCommits(ctx, proposal.Id) {
+			votes[commit.Voter] = nil
+		}
+	}
+	// Only the member's presence among the voters matters
+	votes[addr.String()] = nil
+
+	byDelegator, delegations := k.getProposalDelegations(ctx, *proposal)
+	for _, delegation := range delegations {
+		if _, voted := votes[delegation.Delegator]; voted {
+			continue
+		}
+		if _, ok := k.getDelegatingMember(ctx, delegation.Delegator); !ok {
+			continue
+		}
+
+		delegate, found := resolveDelegate(delegation.Delegator, byDelegator, votes, k.MaxDelegationDepth(ctx))
+		if found && delegate == addr.String() {
+			delegators = append(delegators, delegation.Delegator)
+		}
+	}
+	return delegators
+}
+
+// resolveDelegate follows the delegator's delegations, through at most
+// maxDepth members, to the first member who cast a vote. Nobody is found if
+// the chain is too long, loops back on itself, or ends without a vote.
+func resolveDelegate(delegator string,
+	delegations map[string]types.VoteDelegation,
+	votes castVotes,
+	maxDepth uint64) (string, bool) {
+
+	visited := map[string]bool{delegator: true}
+	current := delegator
+	for depth := uint64(0); depth < maxDepth; depth++ {
+		delegation, found := delegations[current]
+		if !found {
+			return "", false
+		}
+
+		delegate := delegation.Delegate
+		if _, voted := votes[delegate]; voted {
+			return delegate, true
+		}
+		// Cycles never reach a vote
+		if visited[delegate] {
+			return "", false
+		}
+
+		visited[delegate] = true
+		current = delegate
+	}
+	return "", false
+}
@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	testkeeper "github.com/noria-net/module-membership/testutil/keeper"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVoteDelegation(t *testing.T) {
+	k, ctx := testkeeper.MembershipKeeper(t)
+	k.SetParams(ctx, types.DefaultParams())
+	dd := types.DefaultDirectDemocracy()
+	k.SetDirectDemocracySettings(ctx, &dd)
+
+	var members []sdk.AccAddress
+	for i := 0; i < 4; i++ {
+		addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+		require.NoError(t, k.AppendMember(ctx, addr))
+		require.NoError(t, k.UpdateMemberStatus(ctx, addr, types.MembershipStatus_MemberElectorate))
+		members = append(members, addr)
+	}
+	guardian := setupGuardian(t, k, ctx)
+	outsider := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	// Only electorate members can delegate, and only to electorate members
+	require.ErrorIs(t, k.DelegateVote(ctx, outsider, members[0], nil), types.ErrMemberNotFound)
+	require.ErrorIs(t, k.DelegateVote(ctx, members[0], outsider, nil), types.ErrInvalidVoteDelegation)
+	require.ErrorIs(t, k.DelegateVote(ctx, members[0], members[0], nil), types.ErrInvalidVoteDelegation)
+	// Guardians cannot delegate their vote, but can be delegates
+	require.ErrorIs(t, k.DelegateVote(ctx, guardian, members[0], nil), types.ErrInvalidVoteDelegation)
+	require.NoError(t, k.DelegateVote(ctx, members[0], guardian, nil))
+
+	// Delegating again replaces the delegation
+	require.NoError(t, k.DelegateVote(ctx, members[0], members[1], nil))
+	delegation, found := k.GetVoteDelegation(ctx, members[0])
+	require.True(t, found)
+	require.Equal(t, members[1].String(), delegation.Delegate)
+	require.Len(t, k.GetAllVoteDelegations(ctx), 1)
+
+	// Votes flow through the chain of delegations
+	require.NoError(t, k.DelegateVote(ctx, members[1], members[2], nil))
+	require.Empty(t, k.GetEffectiveDelegators(ctx, members[0], nil))
+	require.Equal(t, []string{members[0].String()}, k.GetEffectiveDelegators(ctx, members[1], nil))
+	require.ElementsMatch(t, []string{members[0].String(), members[1].String()}, k.GetEffectiveDelegators(ctx, members[2], nil))
+
+	// Scoped delegations are not counted without a proposal
+	require.NoError(t, k.DelegateVote(ctx, members[3], members[2], []string{"/cosmos.bank.v1beta1.MsgSend"}))
+	require.Len(t, k.GetEffectiveDelegators(ctx, members[2], nil), 2)
+
+	// Chains longer than the depth limit do not reach the delegate
+	params := types.DefaultParams()
+	params.MaxDelegationDepth = 1
+	k.SetParams(ctx, params)
+	require.Equal(t, []string{members[1].String()}, k.GetEffectiveDelegators(ctx, members[2], nil))
+
+	// Delegations are ignored when the depth limit is zero
+	params.MaxDelegationDepth = 0
+	k.SetParams(ctx, params)
+	require.Empty(t, k.GetEffectiveDelegators(ctx, members[2], nil))
+
+	require.NoError(t, k.UndelegateVote(ctx, members[0]))
+	_, found = k.GetVoteDelegation(ctx, members[0])
+	require.False(t, found)
+	require.ErrorIs(t, k.UndelegateVote(ctx, members[0]), types.ErrVoteDelegationNotFound)
+}
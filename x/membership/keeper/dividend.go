@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+// GetDividendPool returns the member dividend's reward index and unclaimed
+// reserve
+func (k Keeper) GetDividendPool(ctx sdk.Context) types.DividendPool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	pool := types.DividendPool{
+		Index:   sdk.NewCoins(),
+		Reserve: sdk.NewCoins(),
+	}
+
+	bz := store.Get(types.DividendPoolKey)
+	if bz == nil {
+		return pool
+	}
+
+	k.cdc.MustUnmarshal(bz, &pool)
+	return pool
+}
+
+func (k Keeper) setDividendPool(ctx sdk.Context, pool types.DividendPool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	store.Set(types.DividendPoolKey, k.cdc.MustMarshal(&pool))
+}
+
+// GetMemberDividend fetches the member's dividend position. Members without
+// one have not changed status since the first dividend epoch.
+func (k Keeper) GetMemberDividend(ctx sdk.Context, member sdk.AccAddress) (types.MemberDividend, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	dividend := types.MemberDividend{
+		Member:  member.String(),
+		Index:   sdk.NewCoins(),
+		Pending: sdk.NewCoins(),
+	}
+
+	bz := store.Get(types.MemberDividendKey(member))
+	if bz == nil {
+		return dividend, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &dividend)
+	return dividend, true
+}
+
+func (k Keeper) setMemberDividend(ctx sdk.Context, dividend types.MemberDividend) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	member := sdk.MustAccAddressFromBech32(dividend.Member)
+	store.Set(types.MemberDividendKey(member), k.cdc.MustMarshal(&dividend))
+}
+
+// DistributeMemberDividend owes each electorate member an equal share of the
+// dividend's part of the treasury's available funds, on dividend epoch
+// boundaries. Only the reward index moves, so that members are never
+// iterated over: they claim what they are owed by the index.
+func (k Keeper) DistributeMemberDividend(ctx sdk.Context) {
+	epoch := k.DividendEpoch(ctx)
+	if epoch == 0 || ctx.BlockHeight()%int64(epoch) != 0 {
+		return
+	}
+
+	members := k.GetMemberStatusCount(ctx, types.MembershipStatus_MemberElectorate)
+	if members == 0 {
+		return
+	}
+	available, ok := k.getTreasuryAvailable(ctx)
+	if !ok {
+		return
+	}
+
+	// Amounts are rounded down, leaving the remainder in the treasury
+	share := k.DividendTreasuryShare(ctx)
+	perMember := sdk.NewCoins()
+	for _, coin := range available {
+		amount := sdk.NewDecFromInt(coin.Amount).Mul(share).TruncateInt().QuoRaw(int64(members))
+		perMember = perMember.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	if perMember.IsZero() {
+		return
+	}
+
+	pool := k.GetDividendPool(ctx)
+	pool.Index = pool.Index.Add(perMember...)
+	pool.Reserve = pool.Reserve.Add(perMember.MulInt(sdk.NewIntFromUint64(members))...)
+	k.setDividendPool(ctx, pool)
+
+	_ = ctx.EventManager().EmitTypedEvent(
+		&types.EventMemberDividendDistributed{
+			AmountPerMember: perMember,
+			Members:         members,
+		},
+	)
+}
+
+// GetMemberRewards returns the dividend the member is owed
+func (k Keeper) GetMemberRewards(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	dividend, _ := k.GetMemberDividend(ctx, addr)
+	if !k.isElectorate(ctx, addr) {
+		return dividend.Pending
+	}
+	return dividend.Pending.Add(k.GetDividendPool(ctx).Index.Sub(dividend.Index...)...)
+}
+
+// settleMemberDividend adds what the member has been owed since their last
+// settlement to their pending rewards, if they were in the electorate under
+// the given status, and brings their position up to the reward index. It
+// must be called before the member's status changes.
+func (k Keeper) settleMemberDividend(ctx sdk.Context, addr sdk.AccAddress, status types.MembershipStatus) types.MemberDividend {
+	index := k.GetDividendPool(ctx).Index
+	dividend, _ := k.GetMemberDividend(ctx, addr)
+	if status == types.MembershipStatus_MemberElectorate {
+		dividend.Pending = dividend.Pending.Add(index.Sub(dividend.Index...)...)
+	}
+	dividend.Index = index
+	k.setMemberDividend(ctx, dividend)
+	return dividend
+}
+
+// ClaimMemberRewards pays the member the dividend they are owed out of the
+// treasury. Members who left the electorate can still claim what they were
+// owed while they were in it.
+func (k Keeper) ClaimMemberRewards(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coins, error) {
+	member, found := k.GetMemberAccount(ctx, addr)
+	if !found {
+		return nil, errors.Wrapf(types.ErrMemberNotFound, "member not found: %s", addr.String())
+	}
+
+	dividend := k.settleMemberDividend(ctx, addr, member.Status)
+	rewards := dividend.Pending
+	if rewards.IsZero() {
+		return nil, errors.Wrapf(types.ErrNoMemberRewards, "no rewards owed to %s", addr.String())
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, rewards); err != nil {
+		return nil, err
+	}
+	dividend.Pending = sdk.NewCoins()
+	k.setMemberDividend(ctx, dividend)
+
+	pool := k.GetDividendPool(ctx)
+	pool.Reserve = pool.Reserve.Sub(rewards...)
+	k.setDividendPool(ctx, pool)
+
+	err := ctx.EventManager().EmitTypedEvent(
+		&types.EventMemberRewardsClaimed{
+			Member: addr.String(),
+			Amount: rewards,
+		},
+	)
+	return rewards, err
+}
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/noria-net/module-membership/app"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/noria-net/module-membership/x/membership/keeper"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemberDividend(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now()})
+	k := wasmApp.MembershipKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+	claim := func(member sdk.AccAddress) (sdk.Coins, error) {
+		res, err := msgServer.ClaimMemberRewards(ctx, types.NewMsgClaimMemberRewards(member.String()))
+		if err != nil {
+			return nil, err
+		}
+		return res.Amount, nil
+	}
+
+	params := k.GetParams(ctx)
+	params.DividendEpoch = 10
+	params.DividendTreasuryShare = sdk.NewDecWithPrec(5, 1)
+	k.SetParams(ctx, params)
+
+	addrs := app.AddTestAddrsIncremental(wasmApp, ctx, 4, sdk.NewInt(10000))
+	first, second, pending, donor := addrs[0], addrs[1], addrs[2], addrs[3]
+	for _, addr := range []sdk.AccAddress{first, second, pending} {
+		require.NoError(t, k.AppendMember(ctx, addr))
+	}
+	require.NoError(t, k.UpdateMemberStatus(ctx, first, types.MembershipStatus_MemberElectorate))
+	require.NoError(t, k.UpdateMemberStatus(ctx, second, types.MembershipStatus_MemberElectorate))
+	_, err := msgServer.Donate(ctx, types.NewMsgDonate(donor.String(), coins(1000)))
+	require.NoError(t, err)
+
+	// Nothing is distributed between epoch boundaries
+	ctx = ctx.WithBlockHeight(9)
+	k.DistributeMemberDividend(ctx)
+	require.True(t, k.GetDividendPool(ctx).Index.IsZero())
+
+	// Each electorate member is owed an equal share of the dividend
+	ctx = ctx.WithBlockHeight(10)
+	k.DistributeMemberDividend(ctx)
+	pool := k.GetDividendPool(ctx)
+	require.Equal(t, coins(250), pool.Index)
+	require.Equal(t, coins(500), pool.Reserve)
+	require.Equal(t, coins(250), k.GetMemberRewards(ctx, first))
+	require.Equal(t, coins(250), k.GetMemberRewards(ctx, second))
+	require.True(t, k.GetMemberRewards(ctx, pending).IsZero())
+
+	// The treasury cannot spend what members are owed
+	_, err = msgServer.TreasurySpend(ctx, types.NewMsgTreasurySpend(authority, sample.AccAddress(), coins(501), 0))
+	require.ErrorIs(t, err, types.ErrInsufficientTreasuryFunds)
+
+	// Members who leave the electorate keep what they were owed, but stop
+	// accruing, and members who join only accrue from then on
+	require.NoError(t, k.UpdateMemberStatus(ctx, second, types.MembershipStatus_MemberSuspended))
+	require.NoError(t, k.UpdateMemberStatus(ctx, pending, types.MembershipStatus_MemberElectorate))
+
+	ctx = ctx.WithBlockHeight(20)
+	k.DistributeMemberDividend(ctx)
+	require.Equal(t, coins(375), k.GetDividendPool(ctx).Index)
+	require.Equal(t, coins(375), k.GetMemberRewards(ctx, first))
+	require.Equal(t, coins(250), k.GetMemberRewards(ctx, second))
+	require.Equal(t, coins(125), k.GetMemberRewards(ctx, pending))
+
+	// Members claim what they are owed from the treasury
+	amount, err := claim(first)
+	require.NoError(t, err)
+	require.Equal(t, coins(375), amount)
+	require.Equal(t, coins(10375), wasmApp.BankKeeper.GetAllBalances(ctx, first))
+	_, err = claim(first)
+	require.ErrorIs(t, err, types.ErrNoMemberRewards)
+
+	amount, err = claim(second)
+	require.NoError(t, err)
+	require.Equal(t, coins(250), amount)
+	require.Equal(t, coins(125), k.GetDividendPool(ctx).Reserve)
+	require.Equal(t, coins(125), k.GetTreasuryCommitted(ctx))
+	require.Equal(t, coins(375), k.GetTreasuryBalance(ctx))
+
+	_, err = claim(donor)
+	require.ErrorIs(t, err, types.ErrMemberNotFound)
+
+	rewards, err := k.MemberRewards(ctx, &types.QueryMemberRewardsRequest{Address: pending.String()})
+	require.NoError(t, err)
+	require.Equal(t, coins(125), rewards.Rewards)
+}
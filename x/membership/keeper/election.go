@@ -0,0 +1,317 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+// GetCandidacy fetches the candidacy of the given member
+func (k Keeper) GetCandidacy(ctx sdk.Context, candidate sdk.AccAddress) (types.Candidacy, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	var candidacy types.Candidacy
+
+	bz := store.Get(types.CandidacyKey(candidate))
+	if bz == nil {
+		return candidacy, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &candidacy)
+	return candidacy, true
+}
+
+// GetAllCandidacies returns every declared candidacy
+func (k Keeper) GetAllCandidacies(ctx sdk.Context) []types.Candidacy {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CandidacyKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var candidacies []types.Candidacy
+	for ; iterator.Valid(); iterator.Next() {
+		var candidacy types.Candidacy
+		k.cdc.MustUnmarshal(iterator.Value(), &candidacy)
+		candidacies = append(candidacies, candidacy)
+	}
+
+	return candidacies
+}
+
+// DeclareCandidacy declares an electorate member a candidate in the next
+// guardian election to close
+func (k Keeper) DeclareCandidacy(ctx sdk.Context, candidate sdk.AccAddress, statement string) error {
+	member, found := k.GetMemberAccount(ctx, candidate)
+	if !found {
+		return errors.Wrapf(types.ErrMemberNotFound, "member not found: %s", candidate.String())
+	}
+	if member.Status != types.MembershipStatus_MemberElectorate {
+		return errors.Wrap(types.ErrInvalidCandidacy, "only electorate members can stand for guardian")
+	}
+	if _, found := k.GetCandidacy(ctx, candidate); found {
+		return errors.Wrap(types.ErrInvalidCandidacy, "candidacy already declared")
+	}
+	if err := k.checkTermLimit(ctx, candidate); err != nil {
+		return err
+	}
+	if uint64(len(k.GetAllCandidacies(ctx))) >= k.MaxCandidacies(ctx) {
+		return errors.Wrap(types.ErrInvalidCandidacy, "too many candidacies declared")
+	}
+
+	candidacy := types.Candidacy{
+		Candidate:  candidate.String(),
+		Statement:  statement,
+		DeclaredAt: ctx.BlockHeight(),
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	store.Set(types.CandidacyKey(candidate), k.cdc.MustMarshal(&candidacy))
+
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventCandidacyDeclared{
+			Candidate: candidacy.Candidate,
+		},
+	)
+}
+
+// GetCurrentElection fetches the guardian election that is open for ballots
+func (k Keeper) GetCurrentElection(ctx sdk.Context) (types.Election, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	var election types.Election
+
+	bz := store.Get(types.CurrentElectionKey)
+	if bz == nil {
+		return election, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &election)
+	return election, true
+}
+
+// GetElectionCount returns the number of guardian elections opened so far
+func (k Keeper) GetElectionCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	bz := store.Get(types.ElectionCountKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// OpenElection opens a guardian election using the current params
+func (k Keeper) OpenElection(ctx sdk.Context) types.Election {
+	id := k.GetElectionCount(ctx) + 1
+	election := types.Election{
+		Id:          id,
+		StartHeight: ctx.BlockHeight(),
+		EndHeight:   ctx.BlockHeight() + int64(k.ElectionDuration(ctx)),
+		Method:      k.ElectionMethod(ctx),
+		Seats:       k.GuardianSeats(ctx),
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	store.Set(types.ElectionCountKey, sdk.Uint64ToBigEndian(id))
+	store.Set(types.CurrentElectionKey, k.cdc.MustMarshal(&election))
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventElectionOpened{
+			ElectionId: election.Id,
+			EndHeight:  election.EndHeight,
+		},
+	)
+
+	return election
+}
+
+// CastElectionBallot records, or replaces, an electorate member's ballot in
+// the open guardian election
+func (k Keeper) CastElectionBallot(ctx sdk.Context, voter sdk.AccAddress, electionID uint64, candidates []string) error {
+	election, found := k.GetCurrentElection(ctx)
+	if !found || election.Id != electionID {
+		return errors.Wrapf(types.ErrElectionNotFound, "election %d is not open", electionID)
+	}
+
+	member, found := k.GetMemberAccount(ctx, voter)
+	if !found {
+		return errors.Wrapf(types.ErrMemberNotFound, "member not found: %s", voter.String())
+	}
+	if member.Status != types.MembershipStatus_MemberElectorate {
+		return types.ErrMemberNotEligibleToVote
+	}
+
+	// Only declared candidates can be voted for
+	if uint64(len(candidates)) > k.MaxCandidacies(ctx) {
+		return errors.Wrap(types.ErrInvalidElectionBallot, "ballot lists more candidates than can be declared")
+	}
+	for _, candidate := range candidates {
+		if _, found := k.GetCandidacy(ctx, sdk.MustAccAddressFromBech32(candidate)); !found {
+			return errors.Wrapf(types.ErrInvalidElectionBallot, "not a candidate: %s", candidate)
+		}
+	}
+
+	ballot := types.ElectionBallot{
+		ElectionId: electionID,
+		Voter:      voter.String(),
+		Candidates: candidates,
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	store.Set(types.ElectionBallotKey(electionID, voter), k.cdc.MustMarshal(&ballot))
+
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventElectionBallotCast{
+			ElectionId: electionID,
+			Voter:      ballot.Voter,
+		},
+	)
+}
+
+// GetElectionBallots returns the ballots cast in the given election
+func (k Keeper) GetElectionBallots(ctx sdk.Context, electionID uint64) []types.ElectionBallot {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ElectionBallotsKey(electionID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var ballots []types.ElectionBallot
+	for ; iterator.Valid(); iterator.Next() {
+		var ballot types.ElectionBallot
+		k.cdc.MustUnmarshal(iterator.Value(), &ballot)
+		ballots = append(ballots, ballot)
+	}
+
+	return ballots
+}
+
+// GetElectionResult fetches the result of a closed guardian election
+func (k Keeper) GetElectionResult(ctx sdk.Context, electionID uint64) (types.ElectionResult, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	var result types.ElectionResult
+
+	bz := store.Get(types.ElectionResultKey(electionID))
+	if bz == nil {
+		return result, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &result)
+	return result, true
+}
+
+// CloseElection counts the ballots of the given election, stores the result
+// and installs the winners as the guardians. The current guardians are kept
+// if the turnout is below the minimum or nobody was elected.
+func (k Keeper) CloseElection(ctx sdk.Context, election types.Election) types.ElectionResult {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+
+	// Only electorate members can stand or vote
+	var candidates []string
+	for _, candidacy := range k.GetAllCandidacies(ctx) {
+		candidate := sdk.MustAccAddressFromBech32(candidacy.Candidate)
+		if k.isElectorate(ctx, candidate) {
+			candidates = append(candidates, candidacy.Candidate)
+		}
+		store.Delete(types.CandidacyKey(candidate))
+	}
+
+	var ballots [][]string
+	for _, ballot := range k.GetElectionBallots(ctx, election.Id) {
+		voter := sdk.MustAccAddressFromBech32(ballot.Voter)
+		if k.isElectorate(ctx, voter) {
+			ballots = append(ballots, ballot.Candidates)
+		}
+		store.Delete(types.ElectionBallotKey(election.Id, voter))
+	}
+
+	result := types.NewElectionResult(election)
+	result.Turnout = uint64(len(ballots))
+
+	electorate := sdk.NewDec(int64(k.GetMemberStatusCount(ctx, types.MembershipStatus_MemberElectorate)))
+	result.TurnoutReached = sdk.NewDec(int64(result.Turnout)).GTE(electorate.Mul(k.MinElectionTurnout(ctx)))
+	if result.TurnoutReached {
+		result.Count(candidates, ballots)
+	}
+
+	store.Set(types.ElectionResultKey(election.Id), k.cdc.MustMarshal(&result))
+	store.Delete(types.CurrentElectionKey)
+
+	if len(result.Winners) > 0 {
+		k.replaceGuardians(ctx, result.Winners)
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventElectionClosed{
+			ElectionId: election.Id,
+			Winners:    result.Winners,
+		},
+	)
+
+	return result
+}
+
+// ProcessElections closes the open guardian election once it reaches its end
+// height, and opens a new one every election period
+func (k Keeper) ProcessElections(ctx sdk.Context) {
+	if election, found := k.GetCurrentElection(ctx); found {
+		if election.IsClosing(ctx.BlockHeight()) {
+			result := k.CloseElection(ctx, election)
+			k.Logger(ctx).Info("guardian election closed", "election", election.Id, "winners", len(result.Winners))
+		}
+		return
+	}
+
+	period := k.ElectionPeriod(ctx)
+	if period == 0 || ctx.BlockHeight()%int64(period) != 0 {
+		return
+	}
+
+	election := k.OpenElection(ctx)
+	k.Logger(ctx).Info("guardian election opened", "election", election.Id, "end_height", election.EndHeight)
+}
+
+// replaceGuardians revokes the guardianship of every current guardian who
+// was not elected, and grants it to the winners. Re-elected guardians begin a
+// new term.
+func (k Keeper) replaceGuardians(ctx sdk.Context, winners []string) {
+	dd := k.GetDirectDemocracySettings(ctx)
+	if dd != nil {
+		for _, guardian := range dd.Guardians {
+			if containsString(winners, guardian) {
+				continue
+			}
+			if err := k.RevokeGuardianship(ctx, sdk.MustAccAddressFromBech32(guardian)); err != nil {
+				k.Logger(ctx).Error("failed to revoke guardianship", "guardian", guardian, "error", err)
+			}
+		}
+	}
+
+	var seated []string
+	for _, winner := range winners {
+		addr := sdk.MustAccAddressFromBech32(winner)
+
+		var err error
+		if k.IsGuardian(ctx, addr) {
+			err = k.startGuardianTerm(ctx, addr)
+		} else {
+			err = k.SetMemberGuardianStatus(ctx, addr, true)
+		}
+		if err != nil {
+			k.Logger(ctx).Error("failed to seat guardian", "guardian", winner, "error", err)
+			if err := k.RevokeGuardianship(ctx, addr); err != nil {
+				k.Logger(ctx).Error("failed to revoke guardianship", "guardian", winner, "error", err)
+			}
+			continue
+		}
+		seated = append(seated, winner)
+	}
+
+	dd = k.GetDirectDemocracySettings(ctx)
+	if dd == nil {
+		defaultDD := types.DefaultDirectDemocracy()
+		dd = &defaultDD
+	}
+	dd.Guardians = seated
+	k.SetDirectDemocracySettings(ctx, dd)
+}
+
+// isElectorate returns true if the address belongs to an electorate member
+func (k Keeper) isElectorate(ctx sdk.Context, addr sdk.AccAddress) bool {
+	member, found := k.GetMemberAccount(ctx, addr)
+	return found && member.Status == types.MembershipStatus_MemberElectorate
+}
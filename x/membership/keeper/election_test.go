@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	testkeeper "github.com/noria-net/module-membership/testutil/keeper"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGuardianElection(t *testing.T) {
+	k, ctx := testkeeper.MembershipKeeper(t)
+	params := types.DefaultParams()
+	params.ElectionPeriod = 100
+	params.ElectionDuration = 10
+	params.GuardianSeats = 1
+	k.SetParams(ctx, params)
+
+	// An incumbent guardian who does not stand again
+	incumbent := setupGuardian(t, k, ctx)
+	dd := types.DefaultDirectDemocracy()
+	dd.Guardians = []string{incumbent.String()}
+	k.SetDirectDemocracySettings(ctx, &dd)
+
+	var members []sdk.AccAddress
+	for i := 0; i < 3; i++ {
+		addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+		require.NoError(t, k.AppendMember(ctx, addr))
+		require.NoError(t, k.UpdateMemberStatus(ctx, addr, types.MembershipStatus_MemberElectorate))
+		members = append(members, addr)
+	}
+	candidate, rival := members[0], members[1]
+
+	// Only electorate members can stand, once
+	require.NoError(t, k.DeclareCandidacy(ctx, candidate, "vote for me"))
+	require.NoError(t, k.DeclareCandidacy(ctx, rival, ""))
+	require.ErrorIs(t, k.DeclareCandidacy(ctx, candidate, ""), types.ErrInvalidCandidacy)
+
+	// No election is open between periods
+	ctx = ctx.WithBlockHeight(99)
+	k.ProcessElections(ctx)
+	_, found := k.GetCurrentElection(ctx)
+	require.False(t, found)
+	require.ErrorIs(t, k.CastElectionBallot(ctx, members[2], 1, []string{candidate.String()}), types.ErrElectionNotFound)
+
+	ctx = ctx.WithBlockHeight(100)
+	k.ProcessElections(ctx)
+	election, found := k.GetCurrentElection(ctx)
+	require.True(t, found)
+	require.Equal(t, uint64(1), election.Id)
+	require.Equal(t, int64(110), election.EndHeight)
+
+	// Ballots may only list declared candidates
+	err := k.CastElectionBallot(ctx, members[2], 1, []string{incumbent.String()})
+	require.ErrorIs(t, err, types.ErrInvalidElectionBallot)
+
+	require.NoError(t, k.CastElectionBallot(ctx, members[2], 1, []string{rival.String()}))
+	require.NoError(t, k.CastElectionBallot(ctx, candidate, 1, []string{candidate.String()}))
+	require.NoError(t, k.CastElectionBallot(ctx, incumbent, 1, []string{candidate.String()}))
+	// A replaced ballot only counts once
+	require.NoError(t, k.CastElectionBallot(ctx, members[2], 1, []string{candidate.String(), rival.String()}))
+
+	// The election stays open until its end height
+	ctx = ctx.WithBlockHeight(109)
+	k.ProcessElections(ctx)
+	_, found = k.GetCurrentElection(ctx)
+	require.True(t, found)
+
+	ctx = ctx.WithBlockHeight(110)
+	k.ProcessElections(ctx)
+	_, found = k.GetCurrentElection(ctx)
+	require.False(t, found)
+
+	result, found := k.GetElectionResult(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, uint64(3), result.Turnout)
+	require.True(t, result.TurnoutReached)
+	require.Equal(t, uint64(3), result.Ballots)
+	require.Equal(t, []string{candidate.String()}, result.Winners)
+
+	// The winner replaces the incumbent
+	require.Equal(t, []string{candidate.String()}, k.GetDirectDemocracySettings(ctx).Guardians)
+	require.True(t, k.IsGuardian(ctx, candidate))
+	require.False(t, k.IsGuardian(ctx, incumbent))
+
+	// Candidacies and ballots are cleared for the next election
+	require.Empty(t, k.GetAllCandidacies(ctx))
+	require.Empty(t, k.GetElectionBallots(ctx, 1))
+}
+
+func TestGuardianElectionWithoutWinners(t *testing.T) {
+	k, ctx := testkeeper.MembershipKeeper(t)
+	params := types.DefaultParams()
+	params.ElectionPeriod = 10
+	params.ElectionDuration = 5
+	k.SetParams(ctx, params)
+
+	incumbent := setupGuardian(t, k, ctx)
+	dd := types.DefaultDirectDemocracy()
+	dd.Guardians = []string{incumbent.String()}
+	k.SetDirectDemocracySettings(ctx, &dd)
+
+	ctx = ctx.WithBlockHeight(10)
+	k.ProcessElections(ctx)
+	ctx = ctx.WithBlockHeight(15)
+	k.ProcessElections(ctx)
+
+	result, found := k.GetElectionResult(ctx, 1)
+	require.True(t, found)
+	require.Empty(t, result.Winners)
+
+	// The incumbent guardians are kept
+	require.Equal(t, []string{incumbent.String()}, k.GetDirectDemocracySettings(ctx).Guardians)
+	require.True(t, k.IsGuardian(ctx, incumbent))
+}
+
+func TestGuardianElectionBelowMinTurnout(t *testing.T) {
+	k, ctx := testkeeper.MembershipKeeper(t)
+	params := types.DefaultParams()
+	params.ElectionPeriod = 10
+	params.ElectionDuration = 5
+	params.MinElectionTurnout = sdk.NewDecWithPrec(50, 2)
+	k.SetParams(ctx, params)
+
+	incumbent := setupGuardian(t, k, ctx)
+	dd := types.DefaultDirectDemocracy()
+	dd.Guardians = []string{incumbent.String()}
+	k.SetDirectDemocracySettings(ctx, &dd)
+
+	var members []sdk.AccAddress
+	for i := 0; i < 3; i++ {
+		addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+		require.NoError(t, k.AppendMember(ctx, addr))
+		require.NoError(t, k.UpdateMemberStatus(ctx, addr, types.MembershipStatus_MemberElectorate))
+		members = append(members, addr)
+	}
+	candidate := members[0]
+	require.NoError(t, k.DeclareCandidacy(ctx, candidate, ""))
+
+	// One ballot out of an electorate of four falls short of half
+	ctx = ctx.WithBlockHeight(10)
+	k.ProcessElections(ctx)
+	require.NoError(t, k.CastElectionBallot(ctx, candidate, 1, []string{candidate.String()}))
+	ctx = ctx.WithBlockHeight(15)
+	k.ProcessElections(ctx)
+
+	result, found := k.GetElectionResult(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, uint64(1), result.Turnout)
+	require.False(t, result.TurnoutReached)
+	require.Empty(t, result.Winners)
+
+	// The incumbent guardians are kept
+	require.Equal(t, []string{incumbent.String()}, k.GetDirectDemocracySettings(ctx).Guardians)
+	require.True(t, k.IsGuardian(ctx, incumbent))
+	require.False(t, k.IsGuardian(ctx, candidate))
+
+	// Two ballots out of four reach it
+	require.NoError(t, k.DeclareCandidacy(ctx, candidate, ""))
+	ctx = ctx.WithBlockHeight(20)
+	k.ProcessElections(ctx)
+	require.NoError(t, k.CastElectionBallot(ctx, candidate, 2, []string{candidate.String()}))
+	require.NoError(t, k.CastElectionBallot(ctx, members[1], 2, []string{candidate.String()}))
+	ctx = ctx.WithBlockHeight(25)
+	k.ProcessElections(ctx)
+
+	result, found = k.GetElectionResult(ctx, 2)
+	require.True(t, found)
+	require.True(t, result.TurnoutReached)
+	require.Equal(t, []string{candidate.String()}, result.Winners)
+	require.True(t, k.IsGuardian(ctx, candidate))
+	require.False(t, k.IsGuardian(ctx, incumbent))
+}
+
+func TestCandidaciesAreCapped(t *testing.T) {
+	k, ctx := testkeeper.MembershipKeeper(t)
+	params := types.DefaultParams()
+	params.ElectionPeriod = 10
+	params.ElectionDuration = 5
+	params.MaxCandidacies = 2
+	k.SetParams(ctx, params)
+
+	var members []sdk.AccAddress
+	for i := 0; i < 3; i++ {
+		addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+		require.NoError(t, k.AppendMember(ctx, addr))
+		require.NoError(t, k.UpdateMemberStatus(ctx, addr, types.MembershipStatus_MemberElectorate))
+		members = append(members, addr)
+	}
+
+	require.NoError(t, k.DeclareCandidacy(ctx, members[0], ""))
+	require.NoError(t, k.DeclareCandidacy(ctx, members[1], ""))
+	require.ErrorIs(t, k.DeclareCandidacy(ctx, members[2], ""), types.ErrInvalidCandidacy)
+
+	// Ballots cannot list more candidates than can be declared
+	ctx = ctx.WithBlockHeight(10)
+	k.ProcessElections(ctx)
+	ballot := []string{members[0].String(), members[1].String(), members[2].String()}
+	require.ErrorIs(t, k.CastElectionBallot(ctx, members[2], 1, ballot), types.ErrInvalidElectionBallot)
+	require.NoError(t, k.CastElectionBallot(ctx, members[2], 1, ballot[:2]))
+}
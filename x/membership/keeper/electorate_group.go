@@ -0,0 +1,266 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+// GetElectorateGroup fetches the group that mirrors the electorate
+func (k Keeper) GetElectorateGroup(ctx sdk.Context) (types.ElectorateGroup, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	var electorateGroup types.ElectorateGroup
+
+	bz := store.Get(types.ElectorateGroupKey)
+	if bz == nil {
+		return electorateGroup, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &electorateGroup)
+	return electorateGroup, true
+}
+
+func (k Keeper) setElectorateGroup(ctx sdk.Context, electorateGroup types.ElectorateGroup) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	store.Set(types.ElectorateGroupKey, k.cdc.MustMarshal(&electorateGroup))
+}
+
+// GetElectorateGroupWeight returns the weight the member was last given in
+// the electorate group, which is zero if they are not in the group
+func (k Keeper) GetElectorateGroupWeight(ctx sdk.Context, addr sdk.AccAddress) sdk.Dec {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	bz := store.Get(types.ElectorateGroupWeightKey(addr))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	return sdk.MustNewDecFromStr(string(bz))
+}
+
+func (k Keeper) setElectorateGroupWeight(ctx sdk.Context, addr sdk.AccAddress, weight sdk.Dec) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	if weight.IsZero() {
+		store.Delete(types.ElectorateGroupWeightKey(addr))
+		return
+	}
+	store.Set(types.ElectorateGroupWeightKey(addr), []byte(weight.String()))
+}
+
+// queueElectorateGroupSync queues the member's weight in the electorate group
+// to be synced at the end of the block
+func (k Keeper) queueElectorateGroupSync(ctx sdk.Context, addr sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	if !store.Has(types.ElectorateGroupKey) {
+		return
+	}
+	store.Set(types.ElectorateGroupSyncKey(addr), []byte{})
+}
+
+// dequeueElectorateGroupSyncs empties the queue of members whose weight must
+// be synced, and returns them
+func (k Keeper) dequeueElectorateGroupSyncs(ctx sdk.Context) []sdk.AccAddress {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ElectorateGroupSyncKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+
+	var keys [][]byte
+	var addrs []sdk.AccAddress
+	for ; iterator.Valid(); iterator.Next() {
+		// Keys are the length prefixed addresses
+		keys = append(keys, iterator.Key())
+		addrs = append(addrs, sdk.AccAddress(iterator.Key()[1:]))
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return addrs
+}
+
+// electorateGroupWeights returns the weight of the electorate members who
+// are not guardians, and the weight of each guardian, in the electorate
+// group. Members have a weight of 1, and the guardians together hold the
+// total voting weight's fraction of the group's weight, divided by their
+// relative weights. When the guardians hold the whole vote, members have no
+// weight and guardians have their relative weights.
+func (k Keeper) electorateGroupWeights(ctx sdk.Context) (memberWeight sdk.Dec, guardians []string, guardianWeights map[string]sdk.Dec) {
+	dd := k.GetDirectDemocracySettings(ctx)
+	guardianWeights = make(map[string]sdk.Dec)
+	if dd == nil {
+		return sdk.OneDec(), nil, guardianWeights
+	}
+
+	var relativeTotal uint64
+	for _, guardian := range k.GetGuardians(ctx) {
+		relativeTotal += dd.GetGuardianWeight(guardian.Address)
+		guardians = append(guardians, guardian.Address)
+	}
+	if relativeTotal == 0 {
+		return sdk.OneDec(), nil, guardianWeights
+	}
+
+	var guardianTotal sdk.Dec
+	if dd.TotalVotingWeight.GTE(sdk.OneDec()) {
+		memberWeight = sdk.ZeroDec()
+		guardianTotal = sdk.NewDec(int64(relativeTotal))
+	} else {
+		memberWeight = sdk.OneDec()
+		members := k.GetMemberStatusCount(ctx, types.MembershipStatus_MemberElectorate) - uint64(len(guardians))
+		guardianTotal = sdk.NewDec(int64(members)).Mul(dd.TotalVotingWeight).Quo(sdk.OneDec().Sub(dd.TotalVotingWeight))
+	}
+
+	for _, guardian := range guardians {
+		guardianWeights[guardian] = guardianTotal.MulInt64(int64(dd.GetGuardianWeight(guardian))).QuoInt64(int64(relativeTotal))
+	}
+	return memberWeight, guardians, guardianWeights
+}
+
+// electorateGroupWeight returns the weight the member should have in the
+// electorate group
+func (k Keeper) electorateGroupWeight(ctx sdk.Context, addr sdk.AccAddress, memberWeight sdk.Dec, guardianWeights map[string]sdk.Dec) sdk.Dec {
+	if weight, ok := guardianWeights[addr.String()]; ok {
+		return weight
+	}
+	if k.isElectorate(ctx, addr) {
+		return memberWeight
+	}
+	return sdk.ZeroDec()
+}
+
+// CreateElectorateGroup creates the group that mirrors the electorate, and a
+// policy for it. The module is the group's admin, so that it alone keeps the
+// group's members in sync with the electorate.
+func (k Keeper) CreateElectorateGroup(ctx sdk.Context, groupMetadata string, groupPolicyMetadata string, policy types.ElectorateDecisionPolicy) (types.ElectorateGroup, error) {
+	if _, found := k.GetElectorateGroup(ctx); found {
+		return types.ElectorateGroup{}, errors.Wrap(types.ErrInvalidElectorateGroup, "electorate group already exists")
+	}
+
+	memberWeight, guardians, guardianWeights := k.electorateGroupWeights(ctx)
+
+	var members []group.MemberRequest
+	k.IterateMembers(ctx, func(member types.Member) (stop bool) {
+		weight := k.electorateGroupWeight(ctx, member.GetAddress(), memberWeight, guardianWeights)
+		if weight.IsPositive() {
+			members = append(members, group.MemberRequest{Address: member.Address, Weight: weight.String()})
+		}
+		return false
+	})
+
+	decisionPolicy, err := codectypes.NewAnyWithValue(&policy)
+	if err != nil {
+		return types.ElectorateGroup{}, err
+	}
+
+	res, err := k.groupKeeper.CreateGroupWithPolicy(sdk.WrapSDKContext(ctx), &group.MsgCreateGroupWithPolicy{
+		Admin:               authtypes.NewModuleAddress(types.ModuleName).String(),
+		Members:             members,
+		GroupMetadata:       groupMetadata,
+		GroupPolicyMetadata: groupPolicyMetadata,
+		DecisionPolicy:      decisionPolicy,
+	})
+	if err != nil {
+		return types.ElectorateGroup{}, err
+	}
+
+	for _, member := range members {
+		k.setElectorateGroupWeight(ctx, sdk.MustAccAddressFromBech32(member.Address), sdk.MustNewDecFromStr(member.Weight))
+	}
+
+	electorateGroup := types.ElectorateGroup{
+		GroupId:            res.GroupId,
+		GroupPolicyAddress: res.GroupPolicyAddress,
+		MemberWeight:       memberWeight,
+		Guardians:          guardians,
+	}
+	k.setElectorateGroup(ctx, electorateGroup)
+
+	err = ctx.EventManager().EmitTypedEvent(
+		&types.EventElectorateGroupCreated{
+			GroupId:            res.GroupId,
+			GroupPolicyAddress: res.GroupPolicyAddress,
+		},
+	)
+	return electorateGroup, err
+}
+
+// SyncElectorateGroup updates the weights of the members whose status or
+// guardianship changed during the block, and of the guardians, in the
+// electorate group. Open group proposals are tallied with the group's current
+// weights, so the group is only updated when the electorate itself changes: a
+// new total voting weight, whether from governance or its decay, is applied
+// along with the next change to the electorate. Failed updates are retried in
+// the next block.
+func (k Keeper) SyncElectorateGroup(ctx sdk.Context) {
+	electorateGroup, found := k.GetElectorateGroup(ctx)
+	if !found {
+		return
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	changed := k.dequeueElectorateGroupSyncs(cacheCtx)
+	if len(changed) == 0 {
+		return
+	}
+	memberWeight, guardians, guardianWeights := k.electorateGroupWeights(cacheCtx)
+
+	var addrs []sdk.AccAddress
+	seen := make(map[string]bool)
+	add := func(addr sdk.AccAddress) {
+		if !seen[addr.String()] {
+			seen[addr.String()] = true
+			addrs = append(addrs, addr)
+		}
+	}
+
+	for _, addr := range changed {
+		add(addr)
+	}
+	// A new member weight applies to every electorate member
+	if !memberWeight.Equal(electorateGroup.MemberWeight) {
+		k.IterateMembers(cacheCtx, func(member types.Member) (stop bool) {
+			if member.Status == types.MembershipStatus_MemberElectorate {
+				add(member.GetAddress())
+			}
+			return false
+		})
+	}
+	// Guardian weights follow the size of the electorate, and guardians may
+	// have been added or removed
+	for _, guardian := range append(electorateGroup.Guardians, guardians...) {
+		add(sdk.MustAccAddressFromBech32(guardian))
+	}
+
+	var updates []group.MemberRequest
+	for _, addr := range addrs {
+		weight := k.electorateGroupWeight(cacheCtx, addr, memberWeight, guardianWeights)
+		if weight.Equal(k.GetElectorateGroupWeight(cacheCtx, addr)) {
+			continue
+		}
+		updates = append(updates, group.MemberRequest{Address: addr.String(), Weight: weight.String()})
+		k.setElectorateGroupWeight(cacheCtx, addr, weight)
+	}
+
+	if len(updates) > 0 {
+		_, err := k.groupKeeper.UpdateGroupMembers(sdk.WrapSDKContext(cacheCtx), &group.MsgUpdateGroupMembers{
+			Admin:         authtypes.NewModuleAddress(types.ModuleName).String(),
+			GroupId:       electorateGroup.GroupId,
+			MemberUpdates: updates,
+		})
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Error syncing the electorate group: %s", err.Error()), "group", electorateGroup.GroupId)
+			return
+		}
+	}
+
+	electorateGroup.MemberWeight = memberWeight
+	electorateGroup.Guardians = guardians
+	k.setElectorateGroup(cacheCtx, electorateGroup)
+
+	writeCache()
+}
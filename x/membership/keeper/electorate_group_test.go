@@ -0,0 +1,205 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/noria-net/module-membership/app"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/noria-net/module-membership/x/membership/keeper"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/stretchr/testify/require"
+)
+
+// groupWeights returns the weight of each member of the group
+func groupWeights(t *testing.T, wasmApp *app.WasmApp, ctx sdk.Context, groupID uint64) map[string]string {
+	res, err := wasmApp.GroupKeeper.GroupMembers(sdk.WrapSDKContext(ctx), &group.QueryGroupMembersRequest{GroupId: groupID})
+	require.NoError(t, err)
+
+	weights := make(map[string]string)
+	for _, m := range res.Members {
+		weights[m.Member.Address] = sdk.MustNewDecFromStr(m.Member.Weight).String()
+	}
+	return weights
+}
+
+func TestElectorateGroup(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now()})
+	k := wasmApp.MembershipKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// Three electorate members, one of whom is a guardian holding half the vote
+	var addrs []sdk.AccAddress
+	for i := 0; i < 4; i++ {
+		addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+		require.NoError(t, k.AppendMember(ctx, addr))
+		addrs = append(addrs, addr)
+	}
+	alice, bob, guardian, pending := addrs[0], addrs[1], addrs[2], addrs[3]
+	for _, addr := range []sdk.AccAddress{alice, bob, guardian} {
+		require.NoError(t, k.UpdateMemberStatus(ctx, addr, types.MembershipStatus_MemberElectorate))
+	}
+	require.NoError(t, k.SetMemberGuardianStatus(ctx, guardian, true))
+	dd := types.DefaultDirectDemocracy()
+	dd.Guardians = []string{guardian.String()}
+	dd.TotalVotingWeight = sdk.NewDecWithPrec(5, 1)
+	k.SetDirectDemocracySettings(ctx, &dd)
+
+	policy := *types.NewElectorateDecisionPolicy(sdk.NewDecWithPrec(4, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(334, 3), time.Hour, 0)
+
+	// Only governance can create the group
+	_, err := msgServer.CreateElectorateGroup(ctx, types.NewMsgCreateElectorateGroup(alice.String(), "", "", policy))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	res, err := msgServer.CreateElectorateGroup(ctx, types.NewMsgCreateElectorateGroup(authority, "electorate", "", policy))
+	require.NoError(t, err)
+	electorateGroup, found := k.GetElectorateGroup(ctx)
+	require.True(t, found)
+	require.Equal(t, res.GroupId, electorateGroup.GroupId)
+	require.Equal(t, res.GroupPolicyAddress, electorateGroup.GroupPolicyAddress)
+
+	// There can only be one electorate group
+	_, err = msgServer.CreateElectorateGroup(ctx, types.NewMsgCreateElectorateGroup(authority, "", "", policy))
+	require.ErrorIs(t, err, types.ErrInvalidElectorateGroup)
+
+	// The guardian's weight matches that of the two other members
+	require.Equal(t, map[string]string{
+		alice.String():    sdk.NewDec(1).String(),
+		bob.String():      sdk.NewDec(1).String(),
+		guardian.String(): sdk.NewDec(2).String(),
+	}, groupWeights(t, wasmApp, ctx, res.GroupId))
+
+	// Status changes are synced at the end of the block
+	require.NoError(t, k.UpdateMemberStatus(ctx, pending, types.MembershipStatus_MemberElectorate))
+	require.NoError(t, k.UpdateMemberStatus(ctx, bob, types.MembershipStatus_MemberInactive))
+	require.Len(t, groupWeights(t, wasmApp, ctx, res.GroupId), 3)
+	k.SyncElectorateGroup(ctx)
+	require.Equal(t, map[string]string{
+		alice.String():    sdk.NewDec(1).String(),
+		pending.String():  sdk.NewDec(1).String(),
+		guardian.String(): sdk.NewDec(2).String(),
+	}, groupWeights(t, wasmApp, ctx, res.GroupId))
+
+	// Open group proposals are not disturbed by a new total voting weight
+	goCtx := sdk.WrapSDKContext(ctx)
+	prop, err := wasmApp.GroupKeeper.SubmitProposal(goCtx, &group.MsgSubmitProposal{
+		GroupPolicyAddress: electorateGroup.GroupPolicyAddress,
+		Proposers:          []string{alice.String()},
+	})
+	require.NoError(t, err)
+	_, err = wasmApp.GroupKeeper.Vote(goCtx, &group.MsgVote{ProposalId: prop.ProposalId, Voter: alice.String(), Option: group.VOTE_OPTION_YES})
+	require.NoError(t, err)
+
+	dd.TotalVotingWeight = sdk.NewDecWithPrec(75, 2)
+	k.SetDirectDemocracySettings(ctx, &dd)
+	k.SyncElectorateGroup(ctx)
+	require.Equal(t, map[string]string{
+		alice.String():    sdk.NewDec(1).String(),
+		pending.String():  sdk.NewDec(1).String(),
+		guardian.String(): sdk.NewDec(2).String(),
+	}, groupWeights(t, wasmApp, ctx, res.GroupId))
+
+	// Guardians follow the total voting weight once the electorate changes
+	require.NoError(t, k.UpdateMemberStatus(ctx, bob, types.MembershipStatus_MemberElectorate))
+	k.SyncElectorateGroup(ctx)
+	require.Equal(t, map[string]string{
+		alice.String():    sdk.NewDec(1).String(),
+		bob.String():      sdk.NewDec(1).String(),
+		pending.String():  sdk.NewDec(1).String(),
+		guardian.String(): sdk.NewDec(9).String(),
+	}, groupWeights(t, wasmApp, ctx, res.GroupId))
+
+	// The proposal stays open across syncs and is tallied with the new weights
+	propRes, err := wasmApp.GroupKeeper.Proposal(goCtx, &group.QueryProposalRequest{ProposalId: prop.ProposalId})
+	require.NoError(t, err)
+	require.Equal(t, group.PROPOSAL_STATUS_SUBMITTED, propRes.Proposal.Status)
+	for _, voter := range []sdk.AccAddress{bob, pending, guardian} {
+		_, err = wasmApp.GroupKeeper.Vote(goCtx, &group.MsgVote{ProposalId: prop.ProposalId, Voter: voter.String(), Option: group.VOTE_OPTION_YES})
+		require.NoError(t, err)
+	}
+	execRes, err := wasmApp.GroupKeeper.Exec(goCtx, &group.MsgExec{ProposalId: prop.ProposalId, Executor: alice.String()})
+	require.NoError(t, err)
+	require.Equal(t, group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, execRes.Result)
+
+	// Members have no weight once guardians hold the whole vote
+	dd.TotalVotingWeight = sdk.OneDec()
+	k.SetDirectDemocracySettings(ctx, &dd)
+	require.NoError(t, k.UpdateMemberStatus(ctx, bob, types.MembershipStatus_MemberInactive))
+	k.SyncElectorateGroup(ctx)
+	require.Equal(t, map[string]string{
+		guardian.String(): sdk.NewDec(1).String(),
+	}, groupWeights(t, wasmApp, ctx, res.GroupId))
+
+	// And get it back when guardians do not
+	dd.TotalVotingWeight = sdk.NewDecWithPrec(5, 1)
+	k.SetDirectDemocracySettings(ctx, &dd)
+	require.NoError(t, k.UpdateMemberStatus(ctx, bob, types.MembershipStatus_MemberElectorate))
+	k.SyncElectorateGroup(ctx)
+	require.Equal(t, map[string]string{
+		alice.String():    sdk.NewDec(1).String(),
+		bob.String():      sdk.NewDec(1).String(),
+		pending.String():  sdk.NewDec(1).String(),
+		guardian.String(): sdk.NewDec(3).String(),
+	}, groupWeights(t, wasmApp, ctx, res.GroupId))
+
+	// Revoked guardians become ordinary members
+	require.NoError(t, k.SetMemberGuardianStatus(ctx, guardian, false))
+	k.SyncElectorateGroup(ctx)
+	require.Equal(t, map[string]string{
+		alice.String():    sdk.NewDec(1).String(),
+		bob.String():      sdk.NewDec(1).String(),
+		pending.String():  sdk.NewDec(1).String(),
+		guardian.String(): sdk.NewDec(1).String(),
+	}, groupWeights(t, wasmApp, ctx, res.GroupId))
+}
+
+func TestElectorateDecisionPolicyInGroup(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now()})
+	k := wasmApp.MembershipKeeper
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	var addrs []sdk.AccAddress
+	for i := 0; i < 3; i++ {
+		addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+		require.NoError(t, k.AppendMember(ctx, addr))
+		require.NoError(t, k.UpdateMemberStatus(ctx, addr, types.MembershipStatus_MemberElectorate))
+		addrs = append(addrs, addr)
+	}
+
+	policy := *types.NewElectorateDecisionPolicy(sdk.NewDecWithPrec(4, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(334, 3), time.Hour, 0)
+	electorateGroup, err := k.CreateElectorateGroup(ctx, "", "", policy)
+	require.NoError(t, err)
+
+	propose := func(votes ...group.VoteOption) group.ProposalExecutorResult {
+		res, err := wasmApp.GroupKeeper.SubmitProposal(goCtx, &group.MsgSubmitProposal{
+			GroupPolicyAddress: electorateGroup.GroupPolicyAddress,
+			Proposers:          []string{addrs[0].String()},
+		})
+		require.NoError(t, err)
+
+		for i, option := range votes {
+			_, err = wasmApp.GroupKeeper.Vote(goCtx, &group.MsgVote{
+				ProposalId: res.ProposalId,
+				Voter:      addrs[i].String(),
+				Option:     option,
+			})
+			require.NoError(t, err)
+		}
+
+		execRes, err := wasmApp.GroupKeeper.Exec(goCtx, &group.MsgExec{ProposalId: res.ProposalId, Executor: addrs[0].String()})
+		require.NoError(t, err)
+		return execRes.Result
+	}
+
+	// Proposals pass by the electorate's rules once everyone has voted
+	require.Equal(t, group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, propose(group.VOTE_OPTION_YES, group.VOTE_OPTION_YES, group.VOTE_OPTION_NO))
+	require.Equal(t, group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, propose(group.VOTE_OPTION_YES, group.VOTE_OPTION_NO, group.VOTE_OPTION_NO_WITH_VETO))
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+// GetFeeWaiverUsage returns the fee-free transactions the member has sent
+// during the current epoch
+func (k Keeper) GetFeeWaiverUsage(ctx sdk.Context, member sdk.AccAddress) types.FeeWaiverUsage {
+	usage := types.FeeWaiverUsage{
+		Member: member.String(),
+		Epoch:  k.feeWaiverEpoch(ctx),
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	bz := store.Get(types.FeeWaiverUsageKey(member))
+	if bz == nil {
+		return usage
+	}
+
+	var stored types.FeeWaiverUsage
+	k.cdc.MustUnmarshal(bz, &stored)
+	// Usage from earlier epochs no longer counts
+	if stored.Epoch != usage.Epoch {
+		return usage
+	}
+	return stored
+}
+
+// HasFeeWaiver returns true if the member can send fee-free transactions,
+// which only electorate members can while fee waivers are enabled
+func (k Keeper) HasFeeWaiver(ctx sdk.Context, member sdk.AccAddress) bool {
+	return k.FeeWaiverLimit(ctx) > 0 && k.isElectorate(ctx, member)
+}
+
+// ConsumeFeeWaiver uses up one of the member's fee-free transactions for the
+// current epoch. Returns false if the member has none left.
+func (k Keeper) ConsumeFeeWaiver(ctx sdk.Context, member sdk.AccAddress) bool {
+	if !k.HasFeeWaiver(ctx, member) {
+		return false
+	}
+
+	usage := k.GetFeeWaiverUsage(ctx, member)
+	if usage.Used >= k.FeeWaiverLimit(ctx) {
+		return false
+	}
+
+	usage.Used++
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	store.Set(types.FeeWaiverUsageKey(member), k.cdc.MustMarshal(&usage))
+	return true
+}
+
+// feeWaiverEpoch returns the current fee waiver epoch
+func (k Keeper) feeWaiverEpoch(ctx sdk.Context) uint64 {
+	return uint64(ctx.BlockHeight()) / k.FeeWaiverEpoch(ctx)
+}
+
+// grantFeeWaiver gives a member who joins the electorate a full allowance of
+// fee-free transactions
+func (k Keeper) grantFeeWaiver(ctx sdk.Context, member sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	store.Delete(types.FeeWaiverUsageKey(member))
+
+	_ = ctx.EventManager().EmitTypedEvent(
+		&types.EventFeeWaiverGranted{
+			Member: member.String(),
+		},
+	)
+}
+
+// revokeFeeWaiver stops a member who leaves the electorate from sending
+// fee-free transactions
+func (k Keeper) revokeFeeWaiver(ctx sdk.Context, member sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	store.Delete(types.FeeWaiverUsageKey(member))
+
+	_ = ctx.EventManager().EmitTypedEvent(
+		&types.EventFeeWaiverRevoked{
+			Member: member.String(),
+		},
+	)
+}
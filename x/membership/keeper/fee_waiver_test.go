@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	testkeeper "github.com/noria-net/module-membership/testutil/keeper"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeWaiver(t *testing.T) {
+	k, ctx := testkeeper.MembershipKeeper(t)
+	params := types.DefaultParams()
+	params.FeeWaiverLimit = 2
+	params.FeeWaiverEpoch = 10
+	k.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(15)
+
+	member := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	require.NoError(t, k.AppendMember(ctx, member))
+
+	// Members only get fee-free transactions once they join the electorate
+	require.False(t, k.HasFeeWaiver(ctx, member))
+	require.False(t, k.ConsumeFeeWaiver(ctx, member))
+	require.NoError(t, k.UpdateMemberStatus(ctx, member, types.MembershipStatus_MemberElectorate))
+	require.True(t, k.HasFeeWaiver(ctx, member))
+
+	// The allowance is limited per epoch
+	require.True(t, k.ConsumeFeeWaiver(ctx, member))
+	require.True(t, k.ConsumeFeeWaiver(ctx, member))
+	require.False(t, k.ConsumeFeeWaiver(ctx, member))
+	require.Equal(t, types.FeeWaiverUsage{Member: member.String(), Epoch: 1, Used: 2}, k.GetFeeWaiverUsage(ctx, member))
+
+	// And renewed in the next epoch
+	ctx = ctx.WithBlockHeight(20)
+	require.Equal(t, uint64(0), k.GetFeeWaiverUsage(ctx, member).Used)
+	require.True(t, k.ConsumeFeeWaiver(ctx, member))
+
+	// Leaving the electorate revokes the allowance
+	require.NoError(t, k.UpdateMemberStatus(ctx, member, types.MembershipStatus_MemberSuspended))
+	require.False(t, k.HasFeeWaiver(ctx, member))
+	require.False(t, k.ConsumeFeeWaiver(ctx, member))
+	require.Equal(t, uint64(0), k.GetFeeWaiverUsage(ctx, member).Used)
+
+	// Fee waivers are disabled without a limit
+	require.NoError(t, k.UpdateMemberStatus(ctx, member, types.MembershipStatus_MemberElectorate))
+	params.FeeWaiverLimit = 0
+	k.SetParams(ctx, params)
+	require.False(t, k.ConsumeFeeWaiver(ctx, member))
+}
@@ -4,9 +4,11 @@ import (
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	govtypes_v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/noria-net/module-membership/x/membership/types"
 )
 
 // IterateInactiveProposalsQueue iterates over the proposals in the inactive proposal queue
@@ -31,14 +33,21 @@ func (k Keeper) RefundAndDeleteDeposits(ctx sdk.Context, proposalID uint64) {
 	k.govKeeper.RefundAndDeleteDeposits(ctx, proposalID)
 }
 
-// IsLegitimateProposal returns true if this proposal exists and was created by an electorate member
+// IsLegitimateProposal returns true if this proposal exists and was created by
+// a member who is not currently suspended
 func (k Keeper) IsLegitimateProposal(ctx sdk.Context, proposal govtypes_v1.Proposal) bool {
 	p, proposalExists := k.govKeeper.GetProposal(ctx, proposal.Id)
 	if !proposalExists {
 		return false
 	}
 
-	return k.IsMember(ctx, sdk.MustAccAddressFromBech32(p.Proposer))
+	proposer, found := k.GetMemberAccount(ctx, sdk.MustAccAddressFromBech32(p.Proposer))
+	return found && proposer.Status != types.MembershipStatus_MemberSuspended
+}
+
+// InsertActiveProposalQueue inserts a proposalID into the active proposal queue at endTime
+func (k Keeper) InsertActiveProposalQueue(ctx sdk.Context, proposalID uint64, endTime time.Time) {
+	k.govKeeper.InsertActiveProposalQueue(ctx, proposalID, endTime)
 }
 
 // SetProposal writes the updated proposal to the store
@@ -62,6 +71,43 @@ func (k Keeper) DeleteAndBurnDeposits(ctx sdk.Context, proposalID uint64) {
 	k.govKeeper.DeleteAndBurnDeposits(ctx, proposalID)
 }
 
+// SubmitProposal submits a governance proposal and immediately opens it for voting,
+// bypassing the deposit period
+func (k Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, title, summary string, proposer sdk.AccAddress) (govtypes_v1.Proposal, error) {
+	proposal, err := k.govKeeper.SubmitProposal(ctx, messages, "", title, summary, proposer)
+	if err != nil {
+		return proposal, err
+	}
+
+	k.govKeeper.ActivateVotingPeriod(ctx, proposal)
+
+	// Fetch the proposal again to include its voting period
+	proposal, _ = k.govKeeper.GetProposal(ctx, proposal.Id)
+	return proposal, nil
+}
+
+// OnProposalFinalized cleans up module state tied to a proposal once its
+// voting period has ended, regardless of the outcome
+func (k Keeper) OnProposalFinalized(ctx sdk.Context, proposalID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+
+	// Close the recall petition that submitted this proposal
+	if target := store.Get(types.RecallProposalKey(proposalID)); target != nil {
+		k.RemoveRecallPetition(ctx, target)
+	}
+
+	// Finalize the expulsion if the appeal was unsuccessful. A successful
+	// appeal reinstates the member, which removes the appeal entirely.
+	if member, found := k.appealingMember(ctx, proposalID); found {
+		if appeal, found := k.GetExpulsionAppeal(ctx, member); found {
+			k.FinalizeExpulsionAppeal(ctx, appeal)
+		}
+	}
+
+	// Discard the secret ballot and its commitments
+	k.deleteSecretBallot(ctx, proposalID)
+}
+
 // GetGovParams gets the governance parameters from the global param store
 func (k Keeper) GetGovParams(ctx sdk.Context) (params govtypes_v1.Params) {
 	return k.govKeeper.GetParams(ctx)
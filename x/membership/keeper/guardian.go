@@ -18,10 +18,22 @@ func (k Keeper) SetMemberGuardianStatus(ctx sdk.Context, addr sdk.AccAddress, is
 		return nil
 	}
 
+	// Guardians serve fixed terms when term limits are enabled
+	if isGuardian {
+		if err := k.startGuardianTerm(ctx, addr); err != nil {
+			return err
+		}
+	} else {
+		k.endGuardianTerm(ctx, addr)
+	}
+
 	// Set the guardianship status
 	member.IsGuardian = isGuardian
 	k.UpdateMember(ctx, member)
 
+	// Guardians have their own weight in the electorate group
+	k.queueElectorateGroupSync(ctx, addr)
+
 	// Publish an event for this change
 	if isGuardian {
 		ctx.EventManager().EmitTypedEvent(
@@ -41,11 +53,88 @@ func (k Keeper) SetMemberGuardianStatus(ctx sdk.Context, addr sdk.AccAddress, is
 	return nil
 }
 
+// RevokeGuardianship revokes a member's guardianship and removes them from
+// the DirectDemocracy settings, regardless of their membership status
+func (k Keeper) RevokeGuardianship(ctx sdk.Context, addr sdk.AccAddress) error {
+	member, found := k.getStoredMember(ctx, addr)
+	if !found {
+		return errors.Wrapf(types.ErrMemberNotFound, "member not found: %s", addr.String())
+	}
+
+	dd := k.GetDirectDemocracySettings(ctx)
+	isListed := dd != nil && containsString(dd.Guardians, addr.String())
+
+	// Nothing to revoke
+	if !member.IsGuardian && !isListed {
+		return nil
+	}
+
+	// Clear the stored flag directly, as it is hidden for non-electorate members
+	if member.IsGuardian {
+		member.IsGuardian = false
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+		store.Set(types.MemberKey(addr), k.cdc.MustMarshal(&member))
+	}
+
+	if isListed {
+		dd.Guardians = removeFromSlice(dd.Guardians, []string{addr.String()})
+		k.SetDirectDemocracySettings(ctx, dd)
+	}
+	k.endGuardianTerm(ctx, addr)
+
+	// Publish an event
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventMemberRevokedGuardianship{
+			MemberAddress: addr.String(),
+		},
+	)
+}
+
+// ReconcileGuardians revokes guardianship from any listed guardian who is no
+// longer an electorate member, or whose guardianship flag has been cleared
+func (k Keeper) ReconcileGuardians(ctx sdk.Context) {
+	dd := k.GetDirectDemocracySettings(ctx)
+	if dd == nil {
+		return
+	}
+
+	var orphaned []string
+	var invalid []sdk.AccAddress
+	for _, guardianAddress := range dd.Guardians {
+		acc := sdk.MustAccAddressFromBech32(guardianAddress)
+
+		member, found := k.getStoredMember(ctx, acc)
+		if !found {
+			orphaned = append(orphaned, guardianAddress)
+			continue
+		}
+		if !member.IsGuardian || member.Status != types.MembershipStatus_MemberElectorate {
+			invalid = append(invalid, acc)
+		}
+	}
+
+	// Addresses that are not members can simply be dropped
+	if len(orphaned) > 0 {
+		dd.Guardians = removeFromSlice(dd.Guardians, orphaned)
+		k.SetDirectDemocracySettings(ctx, dd)
+	}
+
+	for _, acc := range invalid {
+		k.Logger(ctx).Info("revoking invalid guardianship", "guardian", acc.String())
+		if err := k.RevokeGuardianship(ctx, acc); err != nil {
+			k.Logger(ctx).Error("failed to revoke invalid guardianship", "guardian", acc.String(), "error", err)
+		}
+	}
+}
+
 // GetGuardians returns all guardians of the electorate
 // NOTE: Only valid members with membership status of MemberElectorate are returned
 func (k Keeper) GetGuardians(ctx sdk.Context) (guardians []*types.Member) {
 
 	dd := k.GetDirectDemocracySettings(ctx)
+	if dd == nil {
+		return nil
+	}
 
 	for _, guardianAddress := range dd.Guardians {
 
@@ -57,10 +146,10 @@ func (k Keeper) GetGuardians(ctx sdk.Context) (guardians []*types.Member) {
 		// Get the member
 		member, found := k.GetMemberAccount(ctx, acc)
 		// Guardian must be a member with a status of MemberElectorate
+		// NOTE: Invalid guardians are repaired by ReconcileGuardians in the EndBlocker
 		if found && member.IsGuardian && member.Status == types.MembershipStatus_MemberElectorate {
 			guardians = append(guardians, &member)
 		}
-		// TODO: emit an event when encountering an invalid guardian
 	}
 
 	return guardians
@@ -106,7 +195,10 @@ func (k Keeper) GetDirectDemocracySettings(ctx sdk.Context) *types.DirectDemocra
 	return democracy
 }
 
+// SetDirectDemocracySettings saves the settings, dropping the weights of
+// addresses that are no longer guardians
 func (k Keeper) SetDirectDemocracySettings(ctx sdk.Context, democracy *types.DirectDemocracy) {
+	democracy.PruneGuardianWeights()
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
 	b, err := democracy.Marshal()
 	if err != nil {
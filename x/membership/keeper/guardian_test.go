@@ -0,0 +1,231 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	testkeeper "github.com/noria-net/module-membership/testutil/keeper"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/noria-net/module-membership/x/membership/keeper"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/stretchr/testify/require"
+)
+
+func setupGuardian(t *testing.T, k *keeper.Keeper, ctx sdk.Context) sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	require.NoError(t, k.AppendMember(ctx, addr))
+	require.NoError(t, k.UpdateMemberStatus(ctx, addr, types.MembershipStatus_MemberElectorate))
+	require.NoError(t, k.SetMemberGuardianStatus(ctx, addr, true))
+	return addr
+}
+
+func TestLeavingElectorateRevokesGuardianship(t *testing.T) {
+	k, ctx := testkeeper.MembershipKeeper(t)
+	dd := types.DefaultDirectDemocracy()
+	k.SetDirectDemocracySettings(ctx, &dd)
+
+	guardian := setupGuardian(t, k, ctx)
+	dd.Guardians = []string{guardian.String()}
+	k.SetDirectDemocracySettings(ctx, &dd)
+	require.True(t, k.IsGuardian(ctx, guardian))
+
+	require.NoError(t, k.UpdateMemberStatus(ctx, guardian, types.MembershipStatus_MemberInactive))
+	require.Empty(t, k.GetDirectDemocracySettings(ctx).Guardians)
+
+	// Returning to the electorate does not restore guardianship
+	require.NoError(t, k.UpdateMemberStatus(ctx, guardian, types.MembershipStatus_MemberElectorate))
+	require.False(t, k.IsGuardian(ctx, guardian))
+	m, _ := k.GetMemberAccount(ctx, guardian)
+	require.False(t, m.IsGuardian)
+}
+
+func TestReconcileGuardians(t *testing.T) {
+	k, ctx := testkeeper.MembershipKeeper(t)
+
+	valid := setupGuardian(t, k, ctx)
+	unflagged := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	require.NoError(t, k.AppendMember(ctx, unflagged))
+	require.NoError(t, k.UpdateMemberStatus(ctx, unflagged, types.MembershipStatus_MemberElectorate))
+	unknown := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	dd := types.DefaultDirectDemocracy()
+	dd.Guardians = []string{valid.String(), unflagged.String(), unknown.String()}
+	k.SetDirectDemocracySettings(ctx, &dd)
+
+	k.ReconcileGuardians(ctx)
+	require.Equal(t, []string{valid.String()}, k.GetDirectDemocracySettings(ctx).Guardians)
+	require.True(t, k.IsGuardian(ctx, valid))
+}
+
+func TestVotePowerWithoutGuardians(t *testing.T) {
+	k, ctx := testkeeper.MembershipKeeper(t)
+	dd := types.DefaultDirectDemocracy()
+	dd.TotalVotingWeight = sdk.NewDecWithPrec(5, 1)
+	k.SetDirectDemocracySettings(ctx, &dd)
+
+	// Start without guardians
+	var members []sdk.AccAddress
+	for i := 0; i < 4; i++ {
+		addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+		require.NoError(t, k.AppendMember(ctx, addr))
+		require.NoError(t, k.UpdateMemberStatus(ctx, addr, types.MembershipStatus_MemberElectorate))
+		members = append(members, addr)
+	}
+	memberPower, guardianPower := k.GetVotePower(ctx)
+	require.Equal(t, sdk.NewDecWithPrec(25, 2), memberPower)
+	require.Equal(t, sdk.ZeroDec(), guardianPower)
+
+	// Appointing a guardian applies the total voting weight
+	guardian := members[0]
+	require.NoError(t, k.SetMemberGuardianStatus(ctx, guardian, true))
+	settings := k.GetDirectDemocracySettings(ctx)
+	settings.Guardians = []string{guardian.String()}
+	k.SetDirectDemocracySettings(ctx, settings)
+
+	memberPower, guardianPower = k.GetVotePower(ctx)
+	require.Equal(t, sdk.OneDec().QuoInt64(6), memberPower)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), guardianPower)
+
+	// Removing the last guardian returns the voting power to the members
+	require.NoError(t, k.RevokeGuardianship(ctx, guardian))
+	memberPower, guardianPower = k.GetVotePower(ctx)
+	require.Equal(t, sdk.NewDecWithPrec(25, 2), memberPower)
+	require.Equal(t, sdk.ZeroDec(), guardianPower)
+
+	// The total voting weight is kept for when guardians return
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), k.GetDirectDemocracySettings(ctx).TotalVotingWeight)
+}
+
+func TestVotePowerAfterVotingWeightDecaysToZero(t *testing.T) {
+	k, ctx := testkeeper.MembershipKeeper(t)
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	guardian := setupGuardian(t, k, ctx)
+	for i := 0; i < 3; i++ {
+		addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+		require.NoError(t, k.AppendMember(ctx, addr))
+		require.NoError(t, k.UpdateMemberStatus(ctx, addr, types.MembershipStatus_MemberElectorate))
+	}
+
+	dd := types.DefaultDirectDemocracy()
+	dd.Guardians = []string{guardian.String()}
+	dd.TotalVotingWeight = sdk.NewDecWithPrec(5, 1)
+	dd.DecaySchedule = &types.VotingWeightDecaySchedule{
+		Mode:        types.DecayMode_DecayModeStep,
+		StartWeight: sdk.NewDecWithPrec(5, 1),
+		FloorWeight: sdk.ZeroDec(),
+		StartTime:   now,
+		Step:        sdk.NewDecWithPrec(25, 2),
+		Interval:    time.Hour,
+	}
+	k.SetDirectDemocracySettings(ctx, &dd)
+
+	memberPower, guardianPower := k.GetVotePower(ctx)
+	require.Equal(t, sdk.OneDec().QuoInt64(6), memberPower)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), guardianPower)
+
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	k.ApplyVotingWeightDecay(ctx)
+	memberPower, guardianPower = k.GetVotePower(ctx)
+	require.Equal(t, sdk.NewDecWithPrec(25, 2), memberPower)
+	require.Equal(t, sdk.NewDecWithPrec(25, 2), guardianPower)
+
+	// Once the weight reaches zero, the guardian counts as an ordinary member
+	ctx = ctx.WithBlockTime(now.Add(2 * time.Hour))
+	k.ApplyVotingWeightDecay(ctx)
+	require.True(t, k.GetDirectDemocracySettings(ctx).TotalVotingWeight.IsZero())
+	require.True(t, k.IsGuardian(ctx, guardian))
+	memberPower, guardianPower = k.GetVotePower(ctx)
+	require.Equal(t, sdk.NewDecWithPrec(25, 2), memberPower)
+	require.Equal(t, sdk.ZeroDec(), guardianPower)
+}
+
+func TestUpdateGuardianWeights(t *testing.T) {
+	k, ctx := testkeeper.MembershipKeeper(t)
+	dd := types.DefaultDirectDemocracy()
+	dd.TotalVotingWeight = sdk.NewDecWithPrec(5, 1)
+	k.SetDirectDemocracySettings(ctx, &dd)
+
+	founder := setupGuardian(t, k, ctx)
+	newcomer := setupGuardian(t, k, ctx)
+	member := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	require.NoError(t, k.AppendMember(ctx, member))
+	require.NoError(t, k.UpdateMemberStatus(ctx, member, types.MembershipStatus_MemberElectorate))
+
+	settings := k.GetDirectDemocracySettings(ctx)
+	settings.Guardians = []string{founder.String(), newcomer.String()}
+	k.SetDirectDemocracySettings(ctx, settings)
+
+	// Only guardians can be weighted
+	p := &types.UpdateGuardianWeightsProposal{
+		Creator:         founder.String(),
+		GuardianWeights: []types.GuardianWeight{{Guardian: member.String(), Weight: 3}},
+	}
+	require.Error(t, keeper.HandleUpdateGuardianWeightsProposal(ctx, *k, p))
+
+	p.GuardianWeights = []types.GuardianWeight{{Guardian: founder.String(), Weight: 3}}
+	require.NoError(t, keeper.HandleUpdateGuardianWeightsProposal(ctx, *k, p))
+	require.Equal(t, uint64(3), k.GetDirectDemocracySettings(ctx).GetGuardianWeight(founder.String()))
+
+	// The guardians share the total voting weight by their weights
+	memberPower, guardianPower := k.GetVotePower(ctx)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), memberPower)
+	require.Equal(t, sdk.NewDecWithPrec(125, 3), guardianPower)
+
+	// Revoking guardianship drops the weight
+	require.NoError(t, k.RevokeGuardianship(ctx, founder))
+	require.Empty(t, k.GetDirectDemocracySettings(ctx).GuardianWeights)
+	_, guardianPower = k.GetVotePower(ctx)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), guardianPower)
+}
+
+func TestUpdateStatusRequiresGuardian(t *testing.T) {
+	k, ctx := testkeeper.MembershipKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	guardian := setupGuardian(t, k, ctx)
+	other := setupGuardian(t, k, ctx)
+	dd := types.DefaultDirectDemocracy()
+	dd.Guardians = []string{guardian.String(), other.String()}
+	k.SetDirectDemocracySettings(ctx, &dd)
+	outsider := sample.AccAddress()
+
+	// Anyone else cannot change a member's status, nor remove a guardian
+	_, err := msgServer.UpdateStatus(ctx, types.NewMsgUpdateStatus(outsider, other.String(), types.MembershipStatus_MemberInactive))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.True(t, k.IsGuardian(ctx, other))
+
+	// Nor can a guardian
+	_, err = msgServer.UpdateStatus(ctx, types.NewMsgUpdateStatus(guardian.String(), other.String(), types.MembershipStatus_MemberInactive))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.True(t, k.IsGuardian(ctx, other))
+
+	// Governance can
+	_, err = msgServer.UpdateStatus(ctx, types.NewMsgUpdateStatus(authority, other.String(), types.MembershipStatus_MemberInactive))
+	require.NoError(t, err)
+	require.False(t, k.IsGuardian(ctx, other))
+
+	// Guardians can change the status of other members, but not expel them
+	member := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	require.NoError(t, k.AppendMember(ctx, member))
+	_, err = msgServer.UpdateStatus(ctx, types.NewMsgUpdateStatus(guardian.String(), member.String(), types.MembershipStatus_MemberElectorate))
+	require.NoError(t, err)
+	_, err = msgServer.UpdateStatus(ctx, types.NewMsgUpdateStatus(guardian.String(), member.String(), types.MembershipStatus_MemberExpulsed))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.UpdateStatus(ctx, types.NewMsgUpdateStatus(authority, member.String(), types.MembershipStatus_MemberExpulsed))
+	require.NoError(t, err)
+	m, _ := k.GetMemberAccount(ctx, member)
+	require.Equal(t, types.MembershipStatus_MemberExpulsed, m.Status)
+
+	_, err = msgServer.UpdateStatus(ctx, types.NewMsgUpdateStatus(authority, other.String(), types.MembershipStatus_MemberElectorate))
+	require.NoError(t, err)
+
+	// Invalid transitions are reported
+	_, err = msgServer.UpdateStatus(ctx, types.NewMsgUpdateStatus(authority, other.String(), types.MembershipStatus_MemberStatusPendingApproval))
+	require.ErrorIs(t, err, types.ErrMembershipStatusChangeNotAllowed)
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+// GetInvitation fetches the invitation with the given secret hash
+func (k Keeper) GetInvitation(ctx sdk.Context, hash []byte) (types.Invitation, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	var invitation types.Invitation
+
+	bz := store.Get(types.InvitationKey(hash))
+	if bz == nil {
+		return invitation, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &invitation)
+	return invitation, true
+}
+
+// SetInvitation saves an invitation to the store
+// NOTE: Assumes the invitation hash has already been validated
+func (k Keeper) SetInvitation(ctx sdk.Context, invitation types.Invitation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	hash, err := types.ParseInvitationHash(invitation.Hash)
+	if err != nil {
+		panic(err)
+	}
+
+	bz := k.cdc.MustMarshal(&invitation)
+	store.Set(types.InvitationKey(hash), bz)
+}
+
+// HasInvitation returns true if an invitation exists for the given secret hash
+func (k Keeper) HasInvitation(ctx sdk.Context, hash []byte) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	return store.Has(types.InvitationKey(hash))
+}
+
+// UseInvitation consumes one use of the invitation matching the given secret
+// and enrollee, returning the invitation's hash
+func (k Keeper) UseInvitation(ctx sdk.Context, secret string, enrollee sdk.AccAddress) (string, error) {
+	hashHex := types.InvitationHash(secret, enrollee)
+	hash, err := types.ParseInvitationHash(hashHex)
+	if err != nil {
+		return "", err
+	}
+
+	// Invitation must exist
+	invitation, found := k.GetInvitation(ctx, hash)
+	if !found {
+		return "", types.ErrInvitationNotFound
+	}
+
+	// Invitation must still be usable
+	if !invitation.IsUsable(ctx.BlockTime()) {
+		return "", types.ErrInvalidInvitation.Wrap("invitation is revoked, expired or fully used")
+	}
+
+	// Consume a use
+	invitation.Uses++
+	k.SetInvitation(ctx, invitation)
+
+	return hashHex, nil
+}
+
+// SetMemberInvitation records the hash of the invitation that admitted a member
+// NOTE: Assumes the member exists
+func (k Keeper) SetMemberInvitation(ctx sdk.Context, address sdk.AccAddress, hash string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	key := types.MemberMetadataKey(address, types.MemberMetadata_Invitation)
+	store.Set(key, []byte(hash))
+}
+
+// GetMemberInvitation gets the hash of the invitation that admitted a member
+func (k Keeper) GetMemberInvitation(ctx sdk.Context, address sdk.AccAddress) string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	key := types.MemberMetadataKey(address, types.MemberMetadata_Invitation)
+	bz := store.Get(key)
+	if bz == nil {
+		return ""
+	}
+
+	return string(bz)
+}
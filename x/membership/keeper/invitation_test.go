@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	testkeeper "github.com/noria-net/module-membership/testutil/keeper"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUseInvitation(t *testing.T) {
+	k, ctx := testkeeper.MembershipKeeper(t)
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+
+	secret := "open sesame"
+	invitee := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	k.SetInvitation(ctx, types.Invitation{
+		Hash:      types.InvitationHash(secret, invitee),
+		Creator:   sample.AccAddress(),
+		ExpiresAt: now.Add(time.Hour),
+		MaxUses:   2,
+	})
+
+	// Unknown secrets are rejected
+	_, err := k.UseInvitation(ctx, "wrong secret", invitee)
+	require.ErrorIs(t, err, types.ErrInvitationNotFound)
+
+	// The secret cannot be used by anyone but the invitee
+	_, err = k.UseInvitation(ctx, secret, sdk.MustAccAddressFromBech32(sample.AccAddress()))
+	require.ErrorIs(t, err, types.ErrInvitationNotFound)
+
+	// Each use is counted
+	for i := 0; i < 2; i++ {
+		hash, err := k.UseInvitation(ctx, secret, invitee)
+		require.NoError(t, err)
+		require.Equal(t, types.InvitationHash(secret, invitee), hash)
+	}
+
+	// Fully used invitations are rejected
+	_, err = k.UseInvitation(ctx, secret, invitee)
+	require.ErrorIs(t, err, types.ErrInvalidInvitation)
+}
+
+func TestUseInvitation_ExpiredOrRevoked(t *testing.T) {
+	k, ctx := testkeeper.MembershipKeeper(t)
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+
+	invitee := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	k.SetInvitation(ctx, types.Invitation{
+		Hash:      types.InvitationHash("expired", invitee),
+		ExpiresAt: now,
+		MaxUses:   1,
+	})
+	k.SetInvitation(ctx, types.Invitation{
+		Hash:      types.InvitationHash("revoked", invitee),
+		ExpiresAt: now.Add(time.Hour),
+		MaxUses:   1,
+		Revoked:   true,
+	})
+
+	_, err := k.UseInvitation(ctx, "expired", invitee)
+	require.ErrorIs(t, err, types.ErrInvalidInvitation)
+
+	_, err = k.UseInvitation(ctx, "revoked", invitee)
+	require.ErrorIs(t, err, types.ErrInvalidInvitation)
+}
@@ -19,7 +19,14 @@ type (
 		memKey        storetypes.StoreKey
 		paramstore    paramtypes.Subspace
 		accountKeeper types.AccountKeeper
+		bankKeeper    types.BankKeeper
 		govKeeper     types.GovKeeper
+		groupKeeper   types.GroupKeeper
+		stakingKeeper types.StakingKeeper
+
+		// the address capable of executing governance-only messages,
+		// typically the x/gov module account
+		authority string
 	}
 )
 
@@ -30,7 +37,11 @@ func NewKeeper(
 	ps paramtypes.Subspace,
 
 	ak types.AccountKeeper,
+	bk types.BankKeeper,
 	gk types.GovKeeper,
+	grk types.GroupKeeper,
+	sk types.StakingKeeper,
+	authority string,
 
 ) *Keeper {
 	// set KeyTable if it has not already been set
@@ -44,10 +55,19 @@ func NewKeeper(
 		memKey:        memKey,
 		paramstore:    ps,
 		accountKeeper: ak,
+		bankKeeper:    bk,
 		govKeeper:     gk,
+		groupKeeper:   grk,
+		stakingKeeper: sk,
+		authority:     authority,
 	}
 }
 
+// GetAuthority returns the address capable of executing governance-only messages
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -5,11 +5,25 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/noria-net/module-membership/x/membership/types"
 )
 
 func (k Keeper) GetMemberAccount(ctx sdk.Context, address sdk.AccAddress) (types.Member, bool) {
+	member, found := k.getStoredMember(ctx, address)
+	if !found {
+		return member, false
+	}
+
+	// Validate guardianship status
+	member.IsGuardian = member.IsGuardian &&
+		member.Status == types.MembershipStatus_MemberElectorate
+
+	return member, true
+}
+
+// getStoredMember returns the member exactly as stored, without validating
+// their guardianship status
+func (k Keeper) getStoredMember(ctx sdk.Context, address sdk.AccAddress) (types.Member, bool) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
 	key := types.MemberKey(address)
 	var member types.Member
@@ -23,10 +37,6 @@ func (k Keeper) GetMemberAccount(ctx sdk.Context, address sdk.AccAddress) (types
 		panic(err)
 	}
 
-	// Validate guardianship status
-	member.IsGuardian = member.IsGuardian &&
-		member.Status == types.MembershipStatus_MemberElectorate
-
 	return member, true
 }
 
@@ -39,23 +49,9 @@ func (k Keeper) AppendMember(ctx sdk.Context, address sdk.AccAddress) error {
 		return errors.Wrap(sdkerrors.ErrUnauthorized, "account has already been enrolled")
 	}
 
-	// Get or create a base account
-	var baseAccount = k.accountKeeper.GetAccount(ctx, address)
-	if baseAccount == nil {
-		// Create a base baseAccount
-		baseAccount = k.accountKeeper.NewAccountWithAddress(ctx, address)
-		// Ensure account type is correct
-		if _, ok := baseAccount.(*authtypes.BaseAccount); !ok {
-			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid account type; expected: BaseAccount, got: %T", baseAccount)
-		}
-		// Save the base account
-		k.accountKeeper.SetAccount(ctx, baseAccount)
-	}
-
-	// Create a member account
-	newMember := types.NewMemberAccountWithDefaultMemberStatus(
-		baseAccount.(*authtypes.BaseAccount),
-	)
+	// Create a member record, whatever the type of the account at the
+	// address, if there is one at all
+	newMember := types.NewMemberWithDefaultMemberStatus(address)
 
 	// Fetch member counts
 	memberCount := k.GetMemberCount(ctx)
@@ -120,6 +116,22 @@ func (k Keeper) GetMemberNickname(ctx sdk.Context, address sdk.AccAddress) strin
 	return string(bz)
 }
 
+// IterateMembers iterates over every member, in address order, and performs
+// a callback function
+func (k Keeper) IterateMembers(ctx sdk.Context, cb func(member types.Member) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MembersKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var member types.Member
+		k.cdc.MustUnmarshal(iterator.Value(), &member)
+		if cb(member) {
+			break
+		}
+	}
+}
+
 func (k Keeper) IsMember(ctx sdk.Context, address sdk.AccAddress) bool {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
 	key := types.MemberKey(address)
@@ -169,7 +181,35 @@ func (k Keeper) UpdateMemberStatus(ctx sdk.Context, target sdk.AccAddress, s typ
 
 	// Must be a valid status transition
 	if !member.Status.CanTransitionTo(s) {
-		return errors.Wrapf(types.ErrMembershipStatusChangeNotAllowed, "transition %s is not allowed", s.DescribeTransition(s))
+		return errors.Wrapf(types.ErrMembershipStatusChangeNotAllowed, "transition %s is not allowed", member.Status.DescribeTransition(s))
+	}
+
+	// Expelled members can only be reinstated through their appeal, until the
+	// expulsion is final
+	if member.Status == types.MembershipStatus_MemberExpulsed {
+		if appeal, found := k.GetExpulsionAppeal(ctx, target); found && appeal.Status != types.AppealStatus_AppealFinalized {
+			return errors.Wrap(types.ErrInvalidExpulsionAppeal, "expelled member can only be reinstated through their appeal")
+		}
+	}
+
+	return k.setMemberStatus(ctx, member, s)
+}
+
+// setMemberStatus moves the member to the given status, along with everything
+// that follows from it, without checking the transition
+func (k Keeper) setMemberStatus(ctx sdk.Context, member types.Member, s types.MembershipStatus) error {
+	target := member.GetAddress()
+
+	// Settle the member's dividend under the status they are leaving
+	k.settleMemberDividend(ctx, target, member.Status)
+
+	// Guardians must be electorate members, so leaving the electorate
+	// also revokes guardianship
+	if member.Status == types.MembershipStatus_MemberElectorate && s != types.MembershipStatus_MemberElectorate {
+		if err := k.RevokeGuardianship(ctx, target); err != nil {
+			return err
+		}
+		member, _ = k.GetMemberAccount(ctx, target)
 	}
 
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
@@ -192,6 +232,33 @@ func (k Keeper) UpdateMemberStatus(ctx sdk.Context, target sdk.AccAddress, s typ
 	k.SetMemberStatusCount(ctx, oldStatus, oldStatusCount-1)
 	k.SetMemberStatusCount(ctx, newStatus, newStatusCount+1)
 
+	// Expelled members may appeal, and reinstated members no longer need to
+	if newStatus == types.MembershipStatus_MemberExpulsed {
+		k.OpenExpulsionAppealWindow(ctx, target)
+	} else if oldStatus == types.MembershipStatus_MemberExpulsed {
+		k.RemoveExpulsionAppeal(ctx, target)
+	}
+
+	// Leaving suspension, for any reason, ends the suspension
+	if oldStatus == types.MembershipStatus_MemberSuspended {
+		k.closeSuspension(ctx, target)
+	}
+
+	// The electorate group follows the electorate
+	k.queueElectorateGroupSync(ctx, target)
+
+	// Only electorate members can send fee-free transactions
+	if newStatus == types.MembershipStatus_MemberElectorate {
+		k.grantFeeWaiver(ctx, target)
+	} else if oldStatus == types.MembershipStatus_MemberElectorate {
+		k.revokeFeeWaiver(ctx, target)
+	}
+
+	// Only electorate members can operate validators
+	if oldStatus == types.MembershipStatus_MemberElectorate && newStatus != types.MembershipStatus_MemberElectorate {
+		k.jailValidator(ctx, target)
+	}
+
 	// Publish an update event
 	ctx.EventManager().EmitTypedEvent(
 		// A member's citizenship status has changed
@@ -199,8 +266,8 @@ func (k Keeper) UpdateMemberStatus(ctx sdk.Context, target sdk.AccAddress, s typ
 			MemberAddress: target.String(),
 			// TODO: Change this
 			Operator:       "",
-			Status:         types.MembershipStatus_MemberElectorate,
-			PreviousStatus: types.MembershipStatus_MemberStatusEmpty,
+			Status:         newStatus,
+			PreviousStatus: oldStatus,
 		},
 	)
 
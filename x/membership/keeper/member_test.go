@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/noria-net/module-membership/app"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppendMemberWithAnyAccount(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now()})
+	k := wasmApp.MembershipKeeper
+	ak := wasmApp.AccountKeeper
+
+	// A vesting account
+	vestingAddr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	base := authtypes.NewBaseAccountWithAddress(vestingAddr)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	vesting := vestingtypes.NewContinuousVestingAccount(base, coins, ctx.BlockTime().Unix(), ctx.BlockTime().Unix()+3600)
+	ak.SetAccount(ctx, ak.NewAccount(ctx, vesting))
+
+	// A module account
+	moduleAddr := ak.GetModuleAddress(authtypes.FeeCollectorName)
+
+	// An address without an account
+	newAddr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	for _, addr := range []sdk.AccAddress{vestingAddr, moduleAddr, newAddr} {
+		before := ak.GetAccount(ctx, addr)
+
+		require.NoError(t, k.AppendMember(ctx, addr))
+		member, found := k.GetMemberAccount(ctx, addr)
+		require.True(t, found)
+		require.Equal(t, addr.String(), member.Address)
+		require.Equal(t, types.MembershipStatus_MemberStatusPendingApproval, member.Status)
+
+		// The account itself is left alone
+		require.Equal(t, before, ak.GetAccount(ctx, addr))
+	}
+	require.IsType(t, &vestingtypes.ContinuousVestingAccount{}, ak.GetAccount(ctx, vestingAddr))
+
+	// Members can only enroll once
+	require.Error(t, k.AppendMember(ctx, vestingAddr))
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+func (k msgServer) AppealExpulsion(goCtx context.Context, msg *types.MsgAppealExpulsion) (*types.MsgAppealExpulsionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	memberAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+
+	// Only expelled members can appeal
+	member, found := k.GetMemberAccount(ctx, memberAddr)
+	if !found {
+		return nil, errors.Wrap(types.ErrMemberNotFound, "member does not exist")
+	}
+	if member.Status != types.MembershipStatus_MemberExpulsed {
+		return nil, errors.Wrap(types.ErrInvalidExpulsionAppeal, "member has not been expelled")
+	}
+
+	// The appeal window must still be open
+	appeal, found := k.GetExpulsionAppeal(ctx, memberAddr)
+	if !found {
+		return nil, types.ErrExpulsionAppealNotFound
+	}
+	if !appeal.CanAppeal(ctx.BlockTime()) {
+		return nil, errors.Wrap(types.ErrInvalidExpulsionAppeal, "expulsion can no longer be appealed")
+	}
+
+	// Ask the electorate to reinstate the member
+	reinstate := types.NewMsgReinstateMember(k.authority, msg.Creator)
+	proposal, err := k.SubmitProposal(
+		ctx,
+		[]sdk.Msg{reinstate},
+		fmt.Sprintf("Reinstate %s", msg.Creator),
+		msg.Statement,
+		memberAddr,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	k.FileExpulsionAppeal(ctx, appeal, msg.Statement, proposal.Id)
+
+	// Publish events
+	err = ctx.EventManager().EmitTypedEvents(
+		// An expelled member appealed their expulsion
+		&types.EventExpulsionAppealed{
+			MemberAddress: msg.Creator,
+			ProposalId:    proposal.Id,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAppealExpulsionResponse{ProposalId: proposal.Id}, nil
+}
@@ -32,13 +32,13 @@ func (k msgServer) ApproveMember(goCtx context.Context, msg *types.MsgApproveMem
 	}
 
 	// Update the member's status
-	member.Status = types.MembershipStatus_MemberElectorate
-
-	// Save the member back to the store
-	k.UpdateMemberStatus(ctx, memberAddr, types.MembershipStatus_MemberElectorate)
+	err := k.UpdateMemberStatus(ctx, memberAddr, types.MembershipStatus_MemberElectorate)
+	if err != nil {
+		return nil, err
+	}
 
 	// Publish events
-	err := ctx.EventManager().EmitTypedEvents(
+	err = ctx.EventManager().EmitTypedEvents(
 		// A member was approved by a guardian
 		&types.EventMemberApproved{
 			MemberAddress:   msg.Member,
@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+func (k msgServer) CastElectionBallot(goCtx context.Context, msg *types.MsgCastElectionBallot) (*types.MsgCastElectionBallotResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.CastElectionBallot(ctx, sdk.MustAccAddressFromBech32(msg.Creator), msg.ElectionId, msg.Candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCastElectionBallotResponse{}, nil
+}
@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+func (k msgServer) ClaimMemberRewards(goCtx context.Context, msg *types.MsgClaimMemberRewards) (*types.MsgClaimMemberRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	member := sdk.MustAccAddressFromBech32(msg.Creator)
+	amount, err := k.Keeper.ClaimMemberRewards(ctx, member)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimMemberRewardsResponse{Amount: amount}, nil
+}
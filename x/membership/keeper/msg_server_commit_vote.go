@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+func (k msgServer) CommitVote(goCtx context.Context, msg *types.MsgCommitVote) (*types.MsgCommitVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.CommitVote(ctx, sdk.MustAccAddressFromBech32(msg.Creator), msg.ProposalId, msg.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCommitVoteResponse{}, nil
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+func (k msgServer) CreateElectorateGroup(goCtx context.Context, msg *types.MsgCreateElectorateGroup) (*types.MsgCreateElectorateGroupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Only governance can create the electorate group
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	electorateGroup, err := k.Keeper.CreateElectorateGroup(ctx, msg.GroupMetadata, msg.GroupPolicyMetadata, msg.DecisionPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateElectorateGroupResponse{
+		GroupId:            electorateGroup.GroupId,
+		GroupPolicyAddress: electorateGroup.GroupPolicyAddress,
+	}, nil
+}
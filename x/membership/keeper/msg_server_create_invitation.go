@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+func (k msgServer) CreateInvitation(goCtx context.Context, msg *types.MsgCreateInvitation) (*types.MsgCreateInvitationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creatorAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+
+	// Only guardians can create invitations
+	if !k.Keeper.IsGuardian(ctx, creatorAddr) {
+		return nil, errors.Wrap(sdkerrors.ErrUnauthorized, "only guardians can create invitations")
+	}
+
+	hash, err := types.ParseInvitationHash(msg.SecretHash)
+	if err != nil {
+		return nil, err
+	}
+
+	// Hashes must be unique
+	if k.HasInvitation(ctx, hash) {
+		return nil, errors.Wrap(types.ErrInvalidInvitation, "invitation already exists")
+	}
+
+	// Must not already be expired
+	if !msg.ExpiresAt.After(ctx.BlockTime()) {
+		return nil, errors.Wrap(types.ErrInvalidInvitation, "expiry time must be in the future")
+	}
+
+	// Save it to the store
+	hashHex := hex.EncodeToString(hash)
+	k.SetInvitation(ctx, types.Invitation{
+		Hash:      hashHex,
+		Creator:   msg.Creator,
+		ExpiresAt: msg.ExpiresAt,
+		MaxUses:   msg.MaxUses,
+	})
+
+	// Publish events
+	err = ctx.EventManager().EmitTypedEvents(
+		// A guardian created an invitation
+		&types.EventInvitationCreated{
+			InvitationHash: hashHex,
+			Creator:        msg.Creator,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateInvitationResponse{}, nil
+}
@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+func (k msgServer) DeclareCandidacy(goCtx context.Context, msg *types.MsgDeclareCandidacy) (*types.MsgDeclareCandidacyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.DeclareCandidacy(ctx, sdk.MustAccAddressFromBech32(msg.Creator), msg.Statement)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeclareCandidacyResponse{}, nil
+}
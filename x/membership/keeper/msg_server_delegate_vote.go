@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+func (k msgServer) DelegateVote(goCtx context.Context, msg *types.MsgDelegateVote) (*types.MsgDelegateVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.DelegateVote(ctx, sdk.MustAccAddressFromBech32(msg.Creator), sdk.MustAccAddressFromBech32(msg.Delegate), msg.MsgTypeUrls)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDelegateVoteResponse{}, nil
+}
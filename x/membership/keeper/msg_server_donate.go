@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+func (k msgServer) Donate(goCtx context.Context, msg *types.MsgDonate) (*types.MsgDonateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	donor := sdk.MustAccAddressFromBech32(msg.Creator)
+	if err := k.Keeper.Donate(ctx, donor, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDonateResponse{}, nil
+}
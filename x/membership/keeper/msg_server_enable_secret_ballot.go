@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+func (k msgServer) EnableSecretBallot(goCtx context.Context, msg *types.MsgEnableSecretBallot) (*types.MsgEnableSecretBallotResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.EnableSecretBallot(ctx, sdk.MustAccAddressFromBech32(msg.Creator), msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgEnableSecretBallotResponse{}, nil
+}
@@ -30,6 +30,11 @@ func (k msgServer) Enroll(goCtx context.Context, msg *types.MsgEnroll) (*types.M
 	// Set the user's nickname
 	k.SetMemberNickname(ctx, enrollee, msg.Nickname)
 
+	// Pay the enrollment fee into the treasury
+	if err := k.PayEnrollmentFee(ctx, enrollee); err != nil {
+		return nil, err
+	}
+
 	// Publish events
 	err = ctx.EventManager().EmitTypedEvents(
 		// A new member was enrolled
@@ -38,7 +43,7 @@ func (k msgServer) Enroll(goCtx context.Context, msg *types.MsgEnroll) (*types.M
 		&types.EventMemberStatusChanged{
 			MemberAddress:  enrollee.String(),
 			Operator:       enrollee.String(),
-			Status:         types.MembershipStatus_MemberElectorate,
+			Status:         types.MembershipStatus_MemberStatusPendingApproval,
 			PreviousStatus: types.MembershipStatus_MemberStatusEmpty,
 		},
 	)
@@ -46,5 +51,34 @@ func (k msgServer) Enroll(goCtx context.Context, msg *types.MsgEnroll) (*types.M
 		return nil, err
 	}
 
+	// A valid invitation skips guardian approval
+	if msg.InvitationSecret != "" {
+		hash, err := k.UseInvitation(ctx, msg.InvitationSecret, enrollee)
+		if err != nil {
+			return nil, err
+		}
+
+		// Record which invitation admitted the member
+		k.SetMemberInvitation(ctx, enrollee, hash)
+
+		// Admit the member to the electorate
+		err = k.UpdateMemberStatus(ctx, enrollee, types.MembershipStatus_MemberElectorate)
+		if err != nil {
+			return nil, err
+		}
+
+		// Publish events
+		err = ctx.EventManager().EmitTypedEvents(
+			// An invitation admitted a new member
+			&types.EventInvitationUsed{
+				InvitationHash: hash,
+				MemberAddress:  enrollee.String(),
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &types.MsgEnrollResponse{}, nil
 }
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+func (k msgServer) PetitionRecall(goCtx context.Context, msg *types.MsgPetitionRecall) (*types.MsgPetitionRecallResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	petitionerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+	targetAddr := sdk.MustAccAddressFromBech32(msg.Target)
+
+	// Only electorate members can open petitions
+	petitioner, found := k.GetMemberAccount(ctx, petitionerAddr)
+	if !found || petitioner.Status != types.MembershipStatus_MemberElectorate {
+		return nil, errors.Wrap(sdkerrors.ErrUnauthorized, "only electorate members can petition for a recall")
+	}
+
+	// Target must be a member who can be recalled
+	target, found := k.GetMemberAccount(ctx, targetAddr)
+	if !found {
+		return nil, errors.Wrap(types.ErrMemberNotFound, "member does not exist")
+	}
+	if !target.Status.CanBeRecalled() {
+		return nil, errors.Wrapf(types.ErrMembershipStatusChangeNotAllowed, "transition %s is not allowed", target.Status.DescribeTransition(types.MembershipStatus_MemberRecalled))
+	}
+
+	// Only one petition may be open against a member at a time
+	if _, found := k.GetRecallPetition(ctx, targetAddr); found {
+		return nil, errors.Wrap(types.ErrInvalidRecallPetition, "a recall petition is already open against this member")
+	}
+
+	petition := types.RecallPetition{
+		Target:     msg.Target,
+		Petitioner: msg.Creator,
+		Reason:     msg.Reason,
+	}
+
+	// Publish events
+	err := ctx.EventManager().EmitTypedEvents(
+		// A recall petition was opened
+		&types.EventRecallPetitionCreated{
+			Target:     msg.Target,
+			Petitioner: msg.Creator,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The petitioner is the first signer
+	err = k.Keeper.OpenRecallPetition(ctx, petition, petitionerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPetitionRecallResponse{}, nil
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+func (k msgServer) RecallMember(goCtx context.Context, msg *types.MsgRecallMember) (*types.MsgRecallMemberResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Only governance can recall members
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	memberAddr := sdk.MustAccAddressFromBech32(msg.Member)
+
+	// Update the member's status, which also revokes any guardianship
+	err := k.Keeper.RecallMember(ctx, memberAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Publish events
+	err = ctx.EventManager().EmitTypedEvents(
+		// A member was recalled by governance
+		&types.EventMemberRecalled{
+			MemberAddress: msg.Member,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRecallMemberResponse{}, nil
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+func (k msgServer) ReinstateMember(goCtx context.Context, msg *types.MsgReinstateMember) (*types.MsgReinstateMemberResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Only governance can reinstate members
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	memberAddr := sdk.MustAccAddressFromBech32(msg.Member)
+
+	// Return the member to the electorate, which also closes the appeal
+	err := k.Keeper.ReinstateMember(ctx, memberAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Publish events
+	err = ctx.EventManager().EmitTypedEvents(
+		// An expelled member was reinstated by governance
+		&types.EventMemberReinstated{
+			MemberAddress: msg.Member,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReinstateMemberResponse{}, nil
+}
@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+func (k msgServer) RevealVote(goCtx context.Context, msg *types.MsgRevealVote) (*types.MsgRevealVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.RevealVote(ctx, sdk.MustAccAddressFromBech32(msg.Creator), msg.ProposalId, msg.Options, msg.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevealVoteResponse{}, nil
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+func (k msgServer) RevokeInvitation(goCtx context.Context, msg *types.MsgRevokeInvitation) (*types.MsgRevokeInvitationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+
+	// Only guardians can revoke invitations
+	if !k.Keeper.IsGuardian(ctx, operatorAddr) {
+		return nil, errors.Wrap(sdkerrors.ErrUnauthorized, "only guardians can revoke invitations")
+	}
+
+	hash, err := types.ParseInvitationHash(msg.SecretHash)
+	if err != nil {
+		return nil, err
+	}
+
+	// Invitation must exist
+	invitation, found := k.GetInvitation(ctx, hash)
+	if !found {
+		return nil, types.ErrInvitationNotFound
+	}
+
+	// Must not already be revoked
+	if invitation.Revoked {
+		return nil, errors.Wrap(types.ErrInvalidInvitation, "invitation has already been revoked")
+	}
+
+	// Save it back to the store
+	invitation.Revoked = true
+	k.SetInvitation(ctx, invitation)
+
+	// Publish events
+	err = ctx.EventManager().EmitTypedEvents(
+		// A guardian revoked an invitation
+		&types.EventInvitationRevoked{
+			InvitationHash: invitation.Hash,
+			Operator:       msg.Creator,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeInvitationResponse{}, nil
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+func (k msgServer) SignRecallPetition(goCtx context.Context, msg *types.MsgSignRecallPetition) (*types.MsgSignRecallPetitionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	signerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+	targetAddr := sdk.MustAccAddressFromBech32(msg.Target)
+
+	// Only electorate members can sign petitions
+	signer, found := k.GetMemberAccount(ctx, signerAddr)
+	if !found || signer.Status != types.MembershipStatus_MemberElectorate {
+		return nil, errors.Wrap(sdkerrors.ErrUnauthorized, "only electorate members can sign recall petitions")
+	}
+
+	// Petition must exist
+	petition, found := k.GetRecallPetition(ctx, targetAddr)
+	if !found {
+		return nil, types.ErrRecallPetitionNotFound
+	}
+
+	// Petition must still be collecting signatures
+	if petition.IsSubmitted() {
+		return nil, errors.Wrapf(types.ErrInvalidRecallPetition, "recall proposal %d has already been submitted", petition.ProposalId)
+	}
+
+	// Members can only sign once
+	if petition.HasSigned(msg.Creator) {
+		return nil, errors.Wrap(types.ErrInvalidRecallPetition, "petition has already been signed by this member")
+	}
+
+	err := k.Keeper.SignRecallPetition(ctx, petition, signerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSignRecallPetitionResponse{}, nil
+}
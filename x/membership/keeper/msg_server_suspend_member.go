@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+func (k msgServer) SuspendMember(goCtx context.Context, msg *types.MsgSuspendMember) (*types.MsgSuspendMemberResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+	memberAddr := sdk.MustAccAddressFromBech32(msg.Member)
+
+	// Only guardians can suspend members
+	if !k.Keeper.IsGuardian(ctx, operatorAddr) {
+		return nil, errors.Wrap(sdkerrors.ErrUnauthorized, "only guardians can suspend members")
+	}
+
+	// Suspending a guardian would revoke their guardianship for good, which is
+	// left to governance
+	if k.Keeper.IsGuardian(ctx, memberAddr) {
+		return nil, errors.Wrap(sdkerrors.ErrUnauthorized, "guardians cannot suspend other guardians")
+	}
+
+	// Suspensions cannot be used in place of an expulsion
+	if maxDuration := k.Keeper.MaxSuspensionDuration(ctx); msg.Duration > maxDuration {
+		return nil, errors.Wrapf(types.ErrInvalidSuspension, "duration cannot be longer than %s", maxDuration)
+	}
+
+	err := k.Keeper.SuspendMember(ctx, memberAddr, operatorAddr, msg.Reason, msg.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSuspendMemberResponse{}, nil
+}
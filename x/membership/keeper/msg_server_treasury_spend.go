@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+func (k msgServer) TreasurySpend(goCtx context.Context, msg *types.MsgTreasurySpend) (*types.MsgTreasurySpendResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Only governance can spend the treasury, once the proposal passes the
+	// guardian-weighted tally
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	recipient := sdk.MustAccAddressFromBech32(msg.Recipient)
+	spend, err := k.SpendTreasury(ctx, recipient, msg.Amount, msg.StreamDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTreasurySpendResponse{SpendId: spend.Id}, nil
+}
@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+func (k msgServer) UndelegateVote(goCtx context.Context, msg *types.MsgUndelegateVote) (*types.MsgUndelegateVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.UndelegateVote(ctx, sdk.MustAccAddressFromBech32(msg.Creator))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUndelegateVoteResponse{}, nil
+}
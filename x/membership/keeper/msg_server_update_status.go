@@ -3,21 +3,53 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/noria-net/module-membership/x/membership/types"
 )
 
 func (k msgServer) UpdateStatus(goCtx context.Context, msg *types.MsgUpdateStatus) (*types.MsgUpdateStatusResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Only governance or guardians can change a member's status
+	operator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Creator != k.authority && !k.Keeper.IsGuardian(ctx, operator) {
+		return nil, errors.Wrap(sdkerrors.ErrUnauthorized, "only governance or guardians can update a member's status")
+	}
+
 	// Target member must have a valid address
 	target, err := sdk.AccAddressFromBech32(msg.Address)
 	if err != nil {
 		return nil, err
 	}
 
+	// Only governance can expel or reinstate a member, or change the status of
+	// a guardian
+	if msg.Creator != k.authority {
+		if msg.Status == types.MembershipStatus_MemberExpulsed {
+			return nil, errors.Wrap(sdkerrors.ErrUnauthorized, "only governance can expel a member")
+		}
+		if member, found := k.GetMemberAccount(ctx, target); found && member.Status == types.MembershipStatus_MemberExpulsed {
+			return nil, errors.Wrap(sdkerrors.ErrUnauthorized, "only governance can reinstate an expelled member")
+		}
+		if k.Keeper.IsGuardian(ctx, target) {
+			return nil, errors.Wrap(sdkerrors.ErrUnauthorized, "only governance can change a guardian's status")
+		}
+	}
+
+	// Suspensions must be applied by a guardian with an end time
+	if msg.Status == types.MembershipStatus_MemberSuspended {
+		return nil, errors.Wrap(types.ErrInvalidSuspension, "use suspend-member to suspend a member")
+	}
+
 	// Execute the status update
-	k.UpdateMemberStatus(ctx, target, msg.Status)
+	if err := k.UpdateMemberStatus(ctx, target, msg.Status); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgUpdateStatusResponse{}, nil
 }
@@ -1,13 +1,46 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/noria-net/module-membership/x/membership/types"
 )
 
 // GetParams get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams()
+	return types.NewParams(
+		k.RecallThreshold(ctx),
+		k.AppealPeriod(ctx),
+		k.ElectionPeriod(ctx),
+		k.ElectionDuration(ctx),
+		k.GuardianSeats(ctx),
+		k.ElectionMethod(ctx),
+		k.GuardianTermLength(ctx),
+		k.MaxConsecutiveTerms(ctx),
+		k.BicameralMsgTypes(ctx),
+		k.TallyRules(ctx),
+		k.AllowSplitVotes(ctx),
+		k.MaxDelegationDepth(ctx),
+		k.RevealPeriod(ctx),
+		k.CountUnrevealedVotes(ctx),
+		k.RestrictGovMessages(ctx),
+		k.FeeWaiverMsgTypes(ctx),
+		k.FeeWaiverLimit(ctx),
+		k.FeeWaiverEpoch(ctx),
+		k.WasmAccessRole(ctx),
+		k.RestrictValidators(ctx),
+		k.EnrollmentFee(ctx),
+		k.DividendEpoch(ctx),
+		k.DividendTreasuryShare(ctx),
+		k.RecallPetitionPeriod(ctx),
+		k.MaxSuspensionDuration(ctx),
+		k.MinElectionTurnout(ctx),
+		k.MaxCandidacies(ctx),
+		k.GuardianChamberRule(ctx),
+		k.MemberChamberRule(ctx),
+		k.FeeWaiverMaxGas(ctx),
+	)
 }
 
 // SetParams set the params
@@ -15,3 +48,185 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	// validate the list of guardians
 	k.paramstore.SetParamSet(ctx, &params)
 }
+
+// RecallThreshold returns the fraction of the electorate needed to sign a recall petition
+func (k Keeper) RecallThreshold(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyRecallThreshold, &res)
+	return
+}
+
+// AppealPeriod returns the length of time an expelled member has to appeal
+func (k Keeper) AppealPeriod(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyAppealPeriod, &res)
+	return
+}
+
+// ElectionPeriod returns the number of blocks between guardian elections
+func (k Keeper) ElectionPeriod(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyElectionPeriod, &res)
+	return
+}
+
+// ElectionDuration returns the number of blocks a guardian election stays open
+func (k Keeper) ElectionDuration(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyElectionDuration, &res)
+	return
+}
+
+// GuardianSeats returns the number of guardians chosen by each election
+func (k Keeper) GuardianSeats(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyGuardianSeats, &res)
+	return
+}
+
+// ElectionMethod returns the method used to choose guardian election winners
+func (k Keeper) ElectionMethod(ctx sdk.Context) (res types.ElectionMethod) {
+	k.paramstore.Get(ctx, types.KeyElectionMethod, &res)
+	return
+}
+
+// GuardianTermLength returns the length of a guardian's term
+func (k Keeper) GuardianTermLength(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyGuardianTermLength, &res)
+	return
+}
+
+// MaxConsecutiveTerms returns the maximum number of consecutive terms a guardian can serve
+func (k Keeper) MaxConsecutiveTerms(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxConsecutiveTerms, &res)
+	return
+}
+
+// BicameralMsgTypes returns the type URLs of the proposal messages that each chamber must approve
+func (k Keeper) BicameralMsgTypes(ctx sdk.Context) (res []string) {
+	k.paramstore.Get(ctx, types.KeyBicameralMsgTypes, &res)
+	return
+}
+
+// TallyRules returns the rules that replace the gov tally params for particular proposal messages
+func (k Keeper) TallyRules(ctx sdk.Context) (res []types.TallyRule) {
+	k.paramstore.Get(ctx, types.KeyTallyRules, &res)
+	return
+}
+
+// AllowSplitVotes returns true if votes spread across several options are counted
+func (k Keeper) AllowSplitVotes(ctx sdk.Context) (res bool) {
+	k.paramstore.Get(ctx, types.KeyAllowSplitVotes, &res)
+	return
+}
+
+// MaxDelegationDepth returns the maximum number of vote delegations followed to find a vote
+func (k Keeper) MaxDelegationDepth(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxDelegationDepth, &res)
+	return
+}
+
+// RevealPeriod returns the length of time members have to reveal their secret votes
+func (k Keeper) RevealPeriod(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyRevealPeriod, &res)
+	return
+}
+
+// CountUnrevealedVotes returns true if unrevealed secret votes count as abstaining
+func (k Keeper) CountUnrevealedVotes(ctx sdk.Context) (res bool) {
+	k.paramstore.Get(ctx, types.KeyCountUnrevealedVotes, &res)
+	return
+}
+
+// RestrictGovMessages returns true if governance messages from non-members are rejected
+func (k Keeper) RestrictGovMessages(ctx sdk.Context) (res bool) {
+	k.paramstore.Get(ctx, types.KeyRestrictGovMessages, &res)
+	return
+}
+
+// FeeWaiverMsgTypes returns the message types electorate members can send without fees
+func (k Keeper) FeeWaiverMsgTypes(ctx sdk.Context) (res []string) {
+	k.paramstore.Get(ctx, types.KeyFeeWaiverMsgTypes, &res)
+	return
+}
+
+// FeeWaiverLimit returns the number of fee-free transactions per member per epoch
+func (k Keeper) FeeWaiverLimit(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyFeeWaiverLimit, &res)
+	return
+}
+
+// FeeWaiverEpoch returns the length of a fee waiver epoch in blocks
+func (k Keeper) FeeWaiverEpoch(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyFeeWaiverEpoch, &res)
+	return
+}
+
+// WasmAccessRole returns the role a signer needs to upload or instantiate contracts
+func (k Keeper) WasmAccessRole(ctx sdk.Context) (res types.WasmAccessRole) {
+	k.paramstore.Get(ctx, types.KeyWasmAccessRole, &res)
+	return
+}
+
+// RestrictValidators returns true if only electorate members can operate
+// validators. The params are not yet set when the validators of genesis
+// transactions are created, so they are not restricted.
+func (k Keeper) RestrictValidators(ctx sdk.Context) (res bool) {
+	k.paramstore.GetIfExists(ctx, types.KeyRestrictValidators, &res)
+	return
+}
+
+// EnrollmentFee returns the fee paid into the treasury by each new enrollee
+func (k Keeper) EnrollmentFee(ctx sdk.Context) (res sdk.Coins) {
+	k.paramstore.Get(ctx, types.KeyEnrollmentFee, &res)
+	return
+}
+
+// DividendEpoch returns the number of blocks between member dividends
+func (k Keeper) DividendEpoch(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyDividendEpoch, &res)
+	return
+}
+
+// DividendTreasuryShare returns the share of the treasury distributed each dividend epoch
+func (k Keeper) DividendTreasuryShare(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyDividendTreasuryShare, &res)
+	return
+}
+
+// RecallPetitionPeriod returns the length of time a recall petition has to meet the recall threshold
+func (k Keeper) RecallPetitionPeriod(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyRecallPetitionPeriod, &res)
+	return
+}
+
+// MaxSuspensionDuration returns the longest suspension a guardian can impose
+func (k Keeper) MaxSuspensionDuration(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyMaxSuspensionDuration, &res)
+	return
+}
+
+// MinElectionTurnout returns the fraction of the electorate that must vote in a guardian election
+func (k Keeper) MinElectionTurnout(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMinElectionTurnout, &res)
+	return
+}
+
+// MaxCandidacies returns the maximum number of candidacies in a guardian election
+func (k Keeper) MaxCandidacies(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxCandidacies, &res)
+	return
+}
+
+// GuardianChamberRule returns the rule the guardian chamber applies to bicameral proposals
+func (k Keeper) GuardianChamberRule(ctx sdk.Context) (res types.ChamberRule) {
+	k.paramstore.Get(ctx, types.KeyGuardianChamberRule, &res)
+	return
+}
+
+// MemberChamberRule returns the rule the member chamber applies to bicameral proposals
+func (k Keeper) MemberChamberRule(ctx sdk.Context) (res types.ChamberRule) {
+	k.paramstore.Get(ctx, types.KeyMemberChamberRule, &res)
+	return
+}
+
+// FeeWaiverMaxGas returns the highest gas limit of a transaction whose fees can be waived
+func (k Keeper) FeeWaiverMaxGas(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyFeeWaiverMaxGas, &res)
+	return
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	errors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -141,24 +143,103 @@ func HandleUpdateTotalVotingWeightProposal(ctx sdk.Context, k Keeper, p *types.U
 		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "total voting weight must be > 0 and <= 1")
 	}
 
-	// Update the total voting weight
+	// Update the total voting weight, which also ends any decay schedule, as
+	// it would otherwise overwrite the new value
 	dd := k.GetDirectDemocracySettings(ctx)
-	// Save the old value and update to the new value
-	oldTotalVotingWeight := dd.TotalVotingWeight
-	dd.TotalVotingWeight = p.NewTotalVotingWeight
+	dd.DecaySchedule = nil
+	return k.setTotalVotingWeight(ctx, dd, p.NewTotalVotingWeight)
+}
+
+// HandleSetVotingWeightDecayScheduleProposal replaces the total voting weight's decay schedule when the proposal passes
+func HandleSetVotingWeightDecayScheduleProposal(ctx sdk.Context, k Keeper, p *types.SetVotingWeightDecayScheduleProposal) error {
+	// Must be a valid creator address
+	creator, err := sdk.AccAddressFromBech32(p.Creator)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address: %s", err)
+	}
+
+	// Only guardians can create this proposal
+	if !k.IsGuardian(ctx, creator) {
+		return errors.Wrapf(sdkerrors.ErrUnauthorized, "creator is not a guardian")
+	}
+
+	// An empty schedule removes the current schedule
+	schedule := p.Schedule
+	if schedule != nil {
+		if err := schedule.Validate(); err != nil {
+			return errors.Wrap(types.ErrInvalidDecaySchedule, err.Error())
+		}
+		// The new schedule starts running
+		schedule.Paused = false
+		schedule.PausedAt = time.Time{}
+	}
+
+	dd := k.GetDirectDemocracySettings(ctx)
+	dd.DecaySchedule = schedule
 	k.SetDirectDemocracySettings(ctx, dd)
 
-	// Emit an event saying the total voting weight has changed
-	err = ctx.EventManager().EmitTypedEvent(
-		&types.EventTotalVotingWeightChanged{
-			OldTotalVotingWeight: oldTotalVotingWeight,
-			NewTotalVotingWeight: p.NewTotalVotingWeight,
-		},
-	)
+	return nil
+}
+
+// HandlePauseVotingWeightDecayProposal pauses or resumes the total voting weight's decay schedule when the proposal passes
+func HandlePauseVotingWeightDecayProposal(ctx sdk.Context, k Keeper, p *types.PauseVotingWeightDecayProposal) error {
+	// Must be a valid creator address
+	creator, err := sdk.AccAddressFromBech32(p.Creator)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address: %s", err)
+	}
+
+	// Only guardians can create this proposal
+	if !k.IsGuardian(ctx, creator) {
+		return errors.Wrapf(sdkerrors.ErrUnauthorized, "creator is not a guardian")
+	}
+
+	if p.Paused {
+		return k.PauseVotingWeightDecay(ctx)
+	}
+	return k.ResumeVotingWeightDecay(ctx)
+}
+
+// HandleUpdateGuardianWeightsProposal sets the relative weights of guardians when the proposal passes
+func HandleUpdateGuardianWeightsProposal(ctx sdk.Context, k Keeper, p *types.UpdateGuardianWeightsProposal) error {
+	// Must be a valid creator address
+	creator, err := sdk.AccAddressFromBech32(p.Creator)
 	if err != nil {
-		return err
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address: %s", err)
+	}
+
+	// Only guardians can create this proposal
+	if !k.IsGuardian(ctx, creator) {
+		return errors.Wrapf(sdkerrors.ErrUnauthorized, "creator is not a guardian")
 	}
 
+	// The whole proposal fails if any of the addresses are not guardians
+	for _, gw := range p.GuardianWeights {
+		if gw.Weight == 0 {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "guardian weight must be positive: %s", gw.Guardian)
+		}
+		addr, err := sdk.AccAddressFromBech32(gw.Guardian)
+		if err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid guardian address: %s", err)
+		}
+		if !k.IsGuardian(ctx, addr) {
+			return errors.Wrapf(sdkerrors.ErrUnauthorized, "member is not a guardian: %s", gw.Guardian)
+		}
+	}
+
+	dd := k.GetDirectDemocracySettings(ctx)
+	for _, gw := range p.GuardianWeights {
+		dd.SetGuardianWeight(gw.Guardian, gw.Weight)
+
+		ctx.EventManager().EmitTypedEvent(
+			&types.EventGuardianWeightChanged{
+				Guardian: gw.Guardian,
+				Weight:   gw.Weight,
+			},
+		)
+	}
+	k.SetDirectDemocracySettings(ctx, dd)
+
 	return nil
 }
 
@@ -182,17 +263,28 @@ func validateAndFetchMember(ctx sdk.Context, k Keeper, addr string) (*types.Memb
 	return &member, nil
 }
 
+// containsString returns true if the slice contains the item
+func containsString(slice []string, item string) bool {
+	for _, s := range slice {
+		if s == item {
+			return true
+		}
+	}
+	return false
+}
+
 // removeFromSlice excludes itemsToRemove from slice
 func removeFromSlice(slice []string, itemsToRemove []string) []string {
+	toRemove := make(map[string]bool, len(itemsToRemove))
+	for _, item := range itemsToRemove {
+		toRemove[item] = true
+	}
+
 	var result []string
 	for _, s := range slice {
-
-		for _, item := range itemsToRemove {
-			if s == item {
-				continue
-			}
+		if !toRemove[s] {
+			result = append(result, s)
 		}
-		result = append(result, s)
 	}
 	return result
 }
@@ -0,0 +1,15 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoveFromSlice(t *testing.T) {
+	slice := []string{"a", "b", "c", "d"}
+
+	require.Equal(t, []string{"a", "c"}, removeFromSlice(slice, []string{"b", "d"}))
+	require.Equal(t, slice, removeFromSlice(slice, []string{"e"}))
+	require.Empty(t, removeFromSlice(slice, slice))
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) Candidates(goCtx context.Context, req *types.QueryCandidatesRequest) (*types.QueryCandidatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var candidates []types.Candidacy
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	candidacyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.CandidacyKeyPrefix)
+
+	pageRes, err := query.Paginate(candidacyStore, req.Pagination, func(key []byte, value []byte) error {
+		var candidacy types.Candidacy
+		if err := k.cdc.Unmarshal(value, &candidacy); err != nil {
+			return err
+		}
+
+		candidates = append(candidates, candidacy)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCandidatesResponse{Candidates: candidates, Pagination: pageRes}, nil
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) CurrentElection(goCtx context.Context, req *types.QueryCurrentElectionRequest) (*types.QueryCurrentElectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	res := &types.QueryCurrentElectionResponse{}
+	if election, found := k.GetCurrentElection(ctx); found {
+		res.Election = &election
+	}
+
+	return res, nil
+}
+
+func (k Keeper) ElectionResult(goCtx context.Context, req *types.QueryElectionResultRequest) (*types.QueryElectionResultResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	result, found := k.GetElectionResult(ctx, req.ElectionId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "election result not found")
+	}
+
+	return &types.QueryElectionResultResponse{Result: &result}, nil
+}
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ElectorateGroup(goCtx context.Context, req *types.QueryElectorateGroupRequest) (*types.QueryElectorateGroupResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	electorateGroup, found := k.GetElectorateGroup(ctx)
+	if !found {
+		return nil, status.Error(codes.NotFound, "electorate group not found")
+	}
+
+	return &types.QueryElectorateGroupResponse{ElectorateGroup: electorateGroup}, nil
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ExpulsionAppeal(goCtx context.Context, req *types.QueryExpulsionAppealRequest) (*types.QueryExpulsionAppealResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Member must have a valid address
+	member, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appeal, found := k.GetExpulsionAppeal(ctx, member)
+	if !found {
+		return nil, status.Error(codes.NotFound, "expulsion appeal not found")
+	}
+
+	return &types.QueryExpulsionAppealResponse{
+		Appeal: &appeal,
+	}, nil
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ExpulsionAppeals(goCtx context.Context, req *types.QueryExpulsionAppealsRequest) (*types.QueryExpulsionAppealsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var appeals []types.ExpulsionAppeal
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	appealsStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ExpulsionAppealKeyPrefix)
+
+	pageRes, err := query.Paginate(appealsStore, req.Pagination, func(key []byte, value []byte) error {
+		var appeal types.ExpulsionAppeal
+		if err := k.cdc.Unmarshal(value, &appeal); err != nil {
+			return err
+		}
+
+		appeals = append(appeals, appeal)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryExpulsionAppealsResponse{Appeals: appeals, Pagination: pageRes}, nil
+}
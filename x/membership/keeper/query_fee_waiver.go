@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) FeeWaiver(goCtx context.Context, req *types.QueryFeeWaiverRequest) (*types.QueryFeeWaiverResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	member, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res := &types.QueryFeeWaiverResponse{
+		Eligible: k.HasFeeWaiver(ctx, member),
+		Usage:    k.GetFeeWaiverUsage(ctx, member),
+	}
+	if limit := k.FeeWaiverLimit(ctx); res.Eligible && res.Usage.Used < limit {
+		res.Remaining = limit - res.Usage.Used
+	}
+
+	return res, nil
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) GuardianTermExpirations(goCtx context.Context, req *types.QueryGuardianTermExpirationsRequest) (*types.QueryGuardianTermExpirationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var terms []types.GuardianTerm
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// The queue is ordered by end time, so the soonest expirations come first
+	queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GuardianTermQueueKeyPrefix)
+
+	pageRes, err := query.Paginate(queueStore, req.Pagination, func(key []byte, value []byte) error {
+		if term, found := k.GetGuardianTerm(ctx, value); found {
+			terms = append(terms, term)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGuardianTermExpirationsResponse{Terms: terms, Pagination: pageRes}, nil
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) Invitation(goCtx context.Context, req *types.QueryInvitationRequest) (*types.QueryInvitationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Must be a valid invitation hash
+	hash, err := types.ParseInvitationHash(req.Hash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	invitation, found := k.GetInvitation(ctx, hash)
+	if !found {
+		return nil, status.Error(codes.NotFound, "invitation not found")
+	}
+
+	return &types.QueryInvitationResponse{
+		Invitation: &invitation,
+	}, nil
+}
@@ -33,12 +33,16 @@ func (k Keeper) Member(goCtx context.Context, req *types.QueryMemberRequest) (*t
 	// Get the member's nickname
 	nickname := k.GetMemberNickname(ctx, accAddress)
 
+	// Get the invitation that admitted the member, if any
+	invitation := k.GetMemberInvitation(ctx, accAddress)
+
 	// Return memberAccount inside the response
 	return &types.QueryMemberResponse{
 		Member: &types.Member{
-			BaseAccount: memberAccount.BaseAccount,
-			Status:      memberAccount.Status,
-			Nickname:    nickname,
+			Address:  memberAccount.Address,
+			Status:   memberAccount.Status,
+			Nickname: nickname,
 		},
+		Invitation: invitation,
 	}, nil
 }
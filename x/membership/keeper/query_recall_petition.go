@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) RecallPetition(goCtx context.Context, req *types.QueryRecallPetitionRequest) (*types.QueryRecallPetitionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Target must have a valid address
+	target, err := sdk.AccAddressFromBech32(req.Target)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	petition, found := k.GetRecallPetition(ctx, target)
+	if !found {
+		return nil, status.Error(codes.NotFound, "recall petition not found")
+	}
+
+	return &types.QueryRecallPetitionResponse{
+		Petition: &petition,
+	}, nil
+}
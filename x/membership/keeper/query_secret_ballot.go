@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) SecretBallot(goCtx context.Context, req *types.QuerySecretBallotRequest) (*types.QuerySecretBallotResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ballot, found := k.GetSecretBallot(ctx, req.ProposalId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "secret ballot not found")
+	}
+
+	res := &types.QuerySecretBallotResponse{Ballot: &ballot}
+	for _, commit := range k.GetVoteCommits(ctx, req.ProposalId) {
+		res.Commits++
+		if commit.Revealed {
+			res.Reveals++
+		}
+	}
+
+	return res, nil
+}
+
+func (k Keeper) VoteCommit(goCtx context.Context, req *types.QueryVoteCommitRequest) (*types.QueryVoteCommitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	voter, err := sdk.AccAddressFromBech32(req.Voter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	commit, found := k.GetVoteCommit(ctx, req.ProposalId, voter)
+	if !found {
+		return nil, status.Error(codes.NotFound, "vote commitment not found")
+	}
+
+	return &types.QueryVoteCommitResponse{Commit: &commit}, nil
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) Suspensions(goCtx context.Context, req *types.QuerySuspensionsRequest) (*types.QuerySuspensionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Member must have a valid address
+	member, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res := &types.QuerySuspensionsResponse{
+		History: k.GetSuspensionHistory(ctx, member),
+	}
+	if current, found := k.GetSuspension(ctx, member); found {
+		res.Current = &current
+	}
+
+	return res, nil
+}
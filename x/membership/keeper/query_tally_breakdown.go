@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) TallyBreakdown(goCtx context.Context, req *types.QueryTallyBreakdownRequest) (*types.QueryTallyBreakdownResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	breakdown, found := k.GetTallyBreakdown(ctx, req.ProposalId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "tally breakdown not found")
+	}
+
+	return &types.QueryTallyBreakdownResponse{Breakdown: &breakdown}, nil
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) TallyRule(goCtx context.Context, req *types.QueryTallyRuleRequest) (*types.QueryTallyRuleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	proposal, found := k.govKeeper.GetProposal(ctx, req.ProposalId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "proposal not found")
+	}
+
+	rule, matched := k.GetTallyRule(ctx, proposal)
+
+	return &types.QueryTallyRuleResponse{Rule: rule, MatchedMsgTypeUrls: matched}, nil
+}
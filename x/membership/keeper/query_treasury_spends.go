@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) TreasurySpends(goCtx context.Context, req *types.QueryTreasurySpendsRequest) (*types.QueryTreasurySpendsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var spends []types.TreasurySpend
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	spendStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.TreasurySpendKeyPrefix)
+
+	pageRes, err := query.Paginate(spendStore, req.Pagination, func(key []byte, value []byte) error {
+		var spend types.TreasurySpend
+		if err := k.cdc.Unmarshal(value, &spend); err != nil {
+			return err
+		}
+
+		spends = append(spends, spend)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTreasurySpendsResponse{Spends: spends, Pagination: pageRes}, nil
+}
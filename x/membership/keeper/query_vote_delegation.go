@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	govtypes_v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) VoteDelegation(goCtx context.Context, req *types.QueryVoteDelegationRequest) (*types.QueryVoteDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegator, err := sdk.AccAddressFromBech32(req.Delegator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	delegation, found := k.GetVoteDelegation(ctx, delegator)
+	if !found {
+		return nil, status.Error(codes.NotFound, "vote delegation not found")
+	}
+
+	return &types.QueryVoteDelegationResponse{Delegation: &delegation}, nil
+}
+
+func (k Keeper) VoteDelegations(goCtx context.Context, req *types.QueryVoteDelegationsRequest) (*types.QueryVoteDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Delegate); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var delegations []types.VoteDelegation
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	delegationStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoteDelegationKeyPrefix)
+
+	pageRes, err := query.FilteredPaginate(delegationStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var delegation types.VoteDelegation
+		if err := k.cdc.Unmarshal(value, &delegation); err != nil {
+			return false, err
+		}
+
+		if delegation.Delegate != req.Delegate {
+			return false, nil
+		}
+		if accumulate {
+			delegations = append(delegations, delegation)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVoteDelegationsResponse{Delegations: delegations, Pagination: pageRes}, nil
+}
+
+func (k Keeper) EffectiveVotePower(goCtx context.Context, req *types.QueryEffectiveVotePowerRequest) (*types.QueryEffectiveVotePowerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var proposal *govtypes_v1.Proposal
+	if req.ProposalId != 0 {
+		p, found := k.govKeeper.GetProposal(ctx, req.ProposalId)
+		if !found {
+			return nil, status.Error(codes.NotFound, "proposal not found")
+		}
+		proposal = &p
+	}
+
+	res := &types.QueryEffectiveVotePowerResponse{VotingPower: sdk.ZeroDec()}
+	member, found := k.GetMemberAccount(ctx, addr)
+	if !found || member.Status != types.MembershipStatus_MemberElectorate {
+		return res, nil
+	}
+
+	// The member's own vote, and those of the members delegating to them
+	memberPower, guardianPower := k.GetVotePower(ctx)
+	res.VotingPower = memberPower
+	if member.IsGuardian {
+		guardianWeight := types.DefaultGuardianWeight
+		if dd := k.GetDirectDemocracySettings(ctx); dd != nil {
+			guardianWeight = dd.GetGuardianWeight(req.Address)
+		}
+		res.VotingPower = guardianPower.MulInt64(int64(guardianWeight))
+	}
+
+	res.Delegators = k.GetEffectiveDelegators(ctx, addr, proposal)
+	res.Votes = uint64(len(res.Delegators)) + 1
+	res.VotingPower = res.VotingPower.Add(memberPower.MulInt64(int64(len(res.Delegators))))
+
+	return res, nil
+}
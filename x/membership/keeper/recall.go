@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+// GetRecallPetition fetches the open recall petition against the given address
+func (k Keeper) GetRecallPetition(ctx sdk.Context, target sdk.AccAddress) (types.RecallPetition, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	var petition types.RecallPetition
+
+	bz := store.Get(types.RecallPetitionKey(target))
+	if bz == nil {
+		return petition, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &petition)
+	return petition, true
+}
+
+// SetRecallPetition saves a recall petition to the store
+func (k Keeper) SetRecallPetition(ctx sdk.Context, petition types.RecallPetition) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	target := sdk.MustAccAddressFromBech32(petition.Target)
+
+	bz := k.cdc.MustMarshal(&petition)
+	store.Set(types.RecallPetitionKey(target), bz)
+}
+
+// RemoveRecallPetition deletes a recall petition along with its place in the
+// expiry queue or its proposal index
+func (k Keeper) RemoveRecallPetition(ctx sdk.Context, target sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+
+	petition, found := k.GetRecallPetition(ctx, target)
+	if !found {
+		return
+	}
+
+	if petition.IsSubmitted() {
+		store.Delete(types.RecallProposalKey(petition.ProposalId))
+	} else {
+		store.Delete(types.RecallPetitionQueueKey(petition.ExpiresAt, target))
+	}
+	store.Delete(types.RecallPetitionKey(target))
+}
+
+// OpenRecallPetition opens a petition, signed first by its petitioner, which
+// expires unless it meets the recall threshold within the recall petition
+// period
+func (k Keeper) OpenRecallPetition(ctx sdk.Context, petition types.RecallPetition, petitioner sdk.AccAddress) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	target := sdk.MustAccAddressFromBech32(petition.Target)
+
+	petition.ExpiresAt = ctx.BlockTime().Add(k.RecallPetitionPeriod(ctx))
+	store.Set(types.RecallPetitionQueueKey(petition.ExpiresAt, target), target)
+
+	return k.SignRecallPetition(ctx, petition, petitioner)
+}
+
+// PruneExpiredRecallPetitions closes every petition that failed to meet the
+// recall threshold before it expired, so that a new petition can be opened
+func (k Keeper) PruneExpiredRecallPetitions(ctx sdk.Context) {
+	var expired []sdk.AccAddress
+
+	k.IterateRecallPetitionQueue(ctx, ctx.BlockTime(), func(target sdk.AccAddress) (stop bool) {
+		expired = append(expired, target)
+		return false
+	})
+
+	// Remove outside of the iterator, as removing mutates the queue
+	for _, target := range expired {
+		k.RemoveRecallPetition(ctx, target)
+
+		ctx.EventManager().EmitTypedEvent(
+			&types.EventRecallPetitionExpired{
+				Target: target.String(),
+			},
+		)
+	}
+}
+
+// IterateRecallPetitionQueue iterates over the targets of the petitions that
+// expire at or before endTime and performs a callback function
+func (k Keeper) IterateRecallPetitionQueue(ctx sdk.Context, endTime time.Time, cb func(target sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.RecallPetitionQueueKeyPrefix, sdk.PrefixEndBytes(types.RecallPetitionQueueByTimeKey(endTime)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(iterator.Value()) {
+			break
+		}
+	}
+}
+
+// RecallMember sets the member's status to Recalled, which also revokes any
+// guardianship. Recalls bypass the status transition table, and must only
+// follow a successful recall proposal.
+func (k Keeper) RecallMember(ctx sdk.Context, target sdk.AccAddress) error {
+	member, found := k.GetMemberAccount(ctx, target)
+	if !found {
+		return errors.Wrapf(types.ErrMemberNotFound, "member not found: %s", target.String())
+	}
+	if !member.Status.CanBeRecalled() {
+		return errors.Wrapf(types.ErrMembershipStatusChangeNotAllowed, "transition %s is not allowed", member.Status.DescribeTransition(types.MembershipStatus_MemberRecalled))
+	}
+
+	return k.setMemberStatus(ctx, member, types.MembershipStatus_MemberRecalled)
+}
+
+// SignRecallPetition adds a signature to a petition, submitting a recall
+// proposal once the petition meets the recall threshold. Signatures from
+// members who have since left the electorate no longer count, and are dropped.
+// NOTE: Assumes the signer is eligible and has not already signed
+func (k Keeper) SignRecallPetition(ctx sdk.Context, petition types.RecallPetition, signer sdk.AccAddress) error {
+	petition.Signers = append(k.electorateSigners(ctx, petition), signer.String())
+
+	// Publish an event for this signature
+	err := ctx.EventManager().EmitTypedEvent(
+		&types.EventRecallPetitionSigned{
+			Target: petition.Target,
+			Signer: signer.String(),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	// Keep collecting signatures until the threshold is met
+	electorateCount := k.GetMemberStatusCount(ctx, types.MembershipStatus_MemberElectorate)
+	if !petition.MeetsThreshold(k.RecallThreshold(ctx), electorateCount) {
+		k.SetRecallPetition(ctx, petition)
+		return nil
+	}
+
+	// Submit the recall proposal on behalf of the petitioner
+	msg := types.NewMsgRecallMember(k.authority, petition.Target)
+	proposal, err := k.SubmitProposal(
+		ctx,
+		[]sdk.Msg{msg},
+		fmt.Sprintf("Recall %s", petition.Target),
+		petition.Reason,
+		sdk.MustAccAddressFromBech32(petition.Petitioner),
+	)
+	if err != nil {
+		return err
+	}
+
+	petition.ProposalId = proposal.Id
+	k.SetRecallPetition(ctx, petition)
+
+	// Index the petition by its proposal so it can be closed once voting ends,
+	// rather than when it expires
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	target := sdk.MustAccAddressFromBech32(petition.Target)
+	store.Delete(types.RecallPetitionQueueKey(petition.ExpiresAt, target))
+	store.Set(types.RecallProposalKey(proposal.Id), target)
+
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventRecallProposalSubmitted{
+			Target:     petition.Target,
+			ProposalId: proposal.Id,
+		},
+	)
+}
+
+// electorateSigners returns the petition's signers who are still electorate
+// members
+func (k Keeper) electorateSigners(ctx sdk.Context, petition types.RecallPetition) []string {
+	var signers []string
+	for _, signer := range petition.Signers {
+		member, found := k.GetMemberAccount(ctx, sdk.MustAccAddressFromBech32(signer))
+		if found && member.Status == types.MembershipStatus_MemberElectorate {
+			signers = append(signers, signer)
+		}
+	}
+	return signers
+}
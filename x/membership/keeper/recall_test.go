@@ -0,0 +1,167 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes_v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/noria-net/module-membership/app"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecallPetition(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now()})
+	k := wasmApp.MembershipKeeper
+
+	params := k.GetParams(ctx)
+	params.RecallThreshold = sdk.NewDecWithPrec(5, 1)
+	k.SetParams(ctx, params)
+
+	addrs := app.AddTestAddrsIncremental(wasmApp, ctx, 4, sdk.NewInt(10000))
+	for _, addr := range addrs {
+		require.NoError(t, k.AppendMember(ctx, addr))
+		require.NoError(t, k.UpdateMemberStatus(ctx, addr, types.MembershipStatus_MemberElectorate))
+	}
+	target := addrs[3]
+
+	// Members cannot be recalled through a plain status change
+	err := k.UpdateMemberStatus(ctx, target, types.MembershipStatus_MemberRecalled)
+	require.ErrorIs(t, err, types.ErrMembershipStatusChangeNotAllowed)
+
+	// Below the threshold, the petition only collects signatures
+	petition := types.RecallPetition{
+		Target:     target.String(),
+		Petitioner: addrs[0].String(),
+		Reason:     "reason",
+	}
+	require.NoError(t, k.OpenRecallPetition(ctx, petition, addrs[0]))
+	petition, found := k.GetRecallPetition(ctx, target)
+	require.True(t, found)
+	require.False(t, petition.IsSubmitted())
+
+	// Meeting the threshold submits a recall proposal straight into voting
+	require.NoError(t, k.SignRecallPetition(ctx, petition, addrs[1]))
+	petition, _ = k.GetRecallPetition(ctx, target)
+	require.True(t, petition.IsSubmitted())
+	require.Len(t, petition.Signers, 2)
+
+	proposal, found := wasmApp.GovKeeper.GetProposal(ctx, petition.ProposalId)
+	require.True(t, found)
+	require.Equal(t, govtypes_v1.StatusVotingPeriod, proposal.Status)
+	require.NotNil(t, proposal.VotingEndTime)
+	require.Equal(t, addrs[0].String(), proposal.Proposer)
+	msgs, err := proposal.GetMsgs()
+	require.NoError(t, err)
+	require.Equal(t, types.NewMsgRecallMember(wasmApp.GovKeeper.GetGovernanceAccount(ctx).GetAddress().String(), target.String()), msgs[0])
+
+	// A passed proposal recalls the member
+	require.NoError(t, k.RecallMember(ctx, target))
+	member, _ := k.GetMemberAccount(ctx, target)
+	require.Equal(t, types.MembershipStatus_MemberRecalled, member.Status)
+	require.ErrorIs(t, k.RecallMember(ctx, target), types.ErrMembershipStatusChangeNotAllowed)
+
+	// The petition is closed once voting ends
+	k.OnProposalFinalized(ctx, petition.ProposalId)
+	_, found = k.GetRecallPetition(ctx, target)
+	require.False(t, found)
+}
+
+func TestRecallPetitionExpires(t *testing.T) {
+	wasmApp := app.Setup(t)
+	now := time.Now()
+	ctx := wasmApp.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: now})
+	k := wasmApp.MembershipKeeper
+
+	params := k.GetParams(ctx)
+	params.RecallThreshold = sdk.NewDecWithPrec(5, 1)
+	params.RecallPetitionPeriod = time.Hour
+	k.SetParams(ctx, params)
+
+	addrs := app.AddTestAddrsIncremental(wasmApp, ctx, 4, sdk.NewInt(10000))
+	for _, addr := range addrs {
+		require.NoError(t, k.AppendMember(ctx, addr))
+		require.NoError(t, k.UpdateMemberStatus(ctx, addr, types.MembershipStatus_MemberElectorate))
+	}
+	target := addrs[3]
+
+	petition := types.RecallPetition{
+		Target:     target.String(),
+		Petitioner: addrs[0].String(),
+		Reason:     "reason",
+	}
+	require.NoError(t, k.OpenRecallPetition(ctx, petition, addrs[0]))
+	petition, found := k.GetRecallPetition(ctx, target)
+	require.True(t, found)
+	require.Equal(t, now.Add(time.Hour).UTC(), petition.ExpiresAt.UTC())
+
+	// The petition stays open until it expires
+	k.PruneExpiredRecallPetitions(ctx.WithBlockTime(now.Add(time.Hour - time.Second)))
+	_, found = k.GetRecallPetition(ctx, target)
+	require.True(t, found)
+
+	// An expired petition is closed, so a new one can be opened
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	k.PruneExpiredRecallPetitions(ctx)
+	_, found = k.GetRecallPetition(ctx, target)
+	require.False(t, found)
+
+	petition = types.RecallPetition{
+		Target:     target.String(),
+		Petitioner: addrs[1].String(),
+		Reason:     "reason",
+	}
+	require.NoError(t, k.OpenRecallPetition(ctx, petition, addrs[1]))
+	petition, found = k.GetRecallPetition(ctx, target)
+	require.True(t, found)
+	require.Equal(t, []string{addrs[1].String()}, petition.Signers)
+
+	// A submitted petition no longer expires, and is closed with its proposal
+	require.NoError(t, k.SignRecallPetition(ctx, petition, addrs[2]))
+	k.PruneExpiredRecallPetitions(ctx.WithBlockTime(now.Add(3 * time.Hour)))
+	petition, found = k.GetRecallPetition(ctx, target)
+	require.True(t, found)
+	require.True(t, petition.IsSubmitted())
+}
+
+func TestRecallPetitionOnlyCountsElectorateSigners(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now()})
+	k := wasmApp.MembershipKeeper
+
+	params := k.GetParams(ctx)
+	params.RecallThreshold = sdk.NewDecWithPrec(5, 1)
+	k.SetParams(ctx, params)
+
+	addrs := app.AddTestAddrsIncremental(wasmApp, ctx, 5, sdk.NewInt(10000))
+	for _, addr := range addrs {
+		require.NoError(t, k.AppendMember(ctx, addr))
+		require.NoError(t, k.UpdateMemberStatus(ctx, addr, types.MembershipStatus_MemberElectorate))
+	}
+	target := addrs[4]
+
+	petition := types.RecallPetition{
+		Target:     target.String(),
+		Petitioner: addrs[0].String(),
+		Reason:     "reason",
+	}
+	require.NoError(t, k.OpenRecallPetition(ctx, petition, addrs[0]))
+
+	// The petitioner leaves the electorate, so their signature no longer counts
+	require.NoError(t, k.UpdateMemberStatus(ctx, addrs[0], types.MembershipStatus_MemberInactive))
+
+	// Two of the four remaining electorate members are needed
+	petition, _ = k.GetRecallPetition(ctx, target)
+	require.NoError(t, k.SignRecallPetition(ctx, petition, addrs[1]))
+	petition, _ = k.GetRecallPetition(ctx, target)
+	require.False(t, petition.IsSubmitted())
+	require.Equal(t, []string{addrs[1].String()}, petition.Signers)
+
+	require.NoError(t, k.SignRecallPetition(ctx, petition, addrs[2]))
+	petition, _ = k.GetRecallPetition(ctx, target)
+	require.True(t, petition.IsSubmitted())
+	require.Equal(t, []string{addrs[1].String(), addrs[2].String()}, petition.Signers)
+}
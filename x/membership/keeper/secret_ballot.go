@@ -0,0 +1,250 @@
+package keeper
+
+import (
+	"strings"
+	"time"
+
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes_v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+// GetSecretBallot fetches the secret ballot of the given proposal
+func (k Keeper) GetSecretBallot(ctx sdk.Context, proposalID uint64) (types.SecretBallot, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	var ballot types.SecretBallot
+
+	bz := store.Get(types.SecretBallotKey(proposalID))
+	if bz == nil {
+		return ballot, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &ballot)
+	return ballot, true
+}
+
+// IsSecretBallot returns true if votes on the proposal are committed and
+// revealed in secret
+func (k Keeper) IsSecretBallot(ctx sdk.Context, proposalID uint64) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	return store.Has(types.SecretBallotKey(proposalID))
+}
+
+func (k Keeper) setSecretBallot(ctx sdk.Context, ballot types.SecretBallot) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	store.Set(types.SecretBallotKey(ballot.ProposalId), k.cdc.MustMarshal(&ballot))
+}
+
+// GetVoteCommit fetches the voter's commitment on the given proposal
+func (k Keeper) GetVoteCommit(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) (types.VoteCommit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	var commit types.VoteCommit
+
+	bz := store.Get(types.VoteCommitKey(proposalID, voter))
+	if bz == nil {
+		return commit, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &commit)
+	return commit, true
+}
+
+// GetVoteCommits returns every vote commitment on the given proposal
+func (k Keeper) GetVoteCommits(ctx sdk.Context, proposalID uint64) []types.VoteCommit {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoteCommitsKey(proposalID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var commits []types.VoteCommit
+	for ; iterator.Valid(); iterator.Next() {
+		var commit types.VoteCommit
+		k.cdc.MustUnmarshal(iterator.Value(), &commit)
+		commits = append(commits, commit)
+	}
+
+	return commits
+}
+
+func (k Keeper) setVoteCommit(ctx sdk.Context, commit types.VoteCommit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	voter := sdk.MustAccAddressFromBech32(commit.Voter)
+	store.Set(types.VoteCommitKey(commit.ProposalId, voter), k.cdc.MustMarshal(&commit))
+}
+
+// deleteSecretBallot removes the proposal's secret ballot and every
+// commitment made on it
+func (k Keeper) deleteSecretBallot(ctx sdk.Context, proposalID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	store.Delete(types.SecretBallotKey(proposalID))
+
+	commitStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoteCommitsKey(proposalID))
+	iterator := commitStore.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		commitStore.Delete(key)
+	}
+}
+
+// EnableSecretBallot lets the proposer switch their proposal to a secret
+// ballot, as long as nobody has voted on it yet. A proposal ID of zero refers
+// to the proposal submitted most recently, so that the ballot can be made
+// secret in the same transaction that submits the proposal.
+func (k Keeper) EnableSecretBallot(ctx sdk.Context, creator sdk.AccAddress, proposalID uint64) error {
+	if proposalID == 0 {
+		nextID, err := k.govKeeper.GetProposalID(ctx)
+		if err != nil {
+			return err
+		}
+		proposalID = nextID - 1
+	}
+
+	proposal, found := k.govKeeper.GetProposal(ctx, proposalID)
+	if !found {
+		return errors.Wrapf(types.ErrInvalidSecretBallot, "proposal not found: %d", proposalID)
+	}
+	if proposal.Status != govtypes_v1.StatusDepositPeriod && proposal.Status != govtypes_v1.StatusVotingPeriod {
+		return errors.Wrapf(types.ErrInvalidSecretBallot, "proposal is not open: %s", proposal.Status)
+	}
+	if proposal.Proposer != creator.String() {
+		return errors.Wrap(types.ErrInvalidSecretBallot, "only the proposer can make the ballot secret")
+	}
+	if k.IsSecretBallot(ctx, proposalID) {
+		return errors.Wrapf(types.ErrInvalidSecretBallot, "proposal already has a secret ballot: %d", proposalID)
+	}
+
+	voted := false
+	k.IterateVotes(ctx, proposalID, func(vote govtypes_v1.Vote) (stop bool) {
+		voted = true
+		return true
+	})
+	if voted {
+		return errors.Wrap(types.ErrInvalidSecretBallot, "proposal has already been voted on")
+	}
+
+	k.setSecretBallot(ctx, types.SecretBallot{ProposalId: proposalID})
+
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventSecretBallotEnabled{
+			ProposalId: proposalID,
+		},
+	)
+}
+
+// CommitVote records the hash of an electorate member's secret vote, replacing
+// any commitment they made before. Votes can only be committed during the
+// proposal's voting period.
+func (k Keeper) CommitVote(ctx sdk.Context, voter sdk.AccAddress, proposalID uint64, hash string) error {
+	ballot, found := k.GetSecretBallot(ctx, proposalID)
+	if !found {
+		return errors.Wrapf(types.ErrInvalidSecretBallot, "proposal does not have a secret ballot: %d", proposalID)
+	}
+	proposal, found := k.govKeeper.GetProposal(ctx, proposalID)
+	if !found || proposal.Status != govtypes_v1.StatusVotingPeriod {
+		return errors.Wrapf(types.ErrInvalidVoteCommit, "proposal is not in its voting period: %d", proposalID)
+	}
+	if !ballot.RevealEndTime.IsZero() || !ctx.BlockTime().Before(*proposal.VotingEndTime) {
+		return errors.Wrap(types.ErrInvalidVoteCommit, "votes can no longer be committed")
+	}
+	if !k.isElectorate(ctx, voter) {
+		return errors.Wrapf(types.ErrInvalidVoteCommit, "voter is not an electorate member: %s", voter.String())
+	}
+
+	k.setVoteCommit(ctx, types.VoteCommit{
+		ProposalId: proposalID,
+		Voter:      voter.String(),
+		Hash:       strings.ToLower(hash),
+	})
+
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventVoteCommitted{
+			ProposalId: proposalID,
+			Voter:      voter.String(),
+		},
+	)
+}
+
+// RevealVote reveals the options of a committed vote, which must hash to the
+// commitment. Votes can only be revealed once the voting period has ended,
+// and until the reveal period has passed.
+func (k Keeper) RevealVote(ctx sdk.Context, voter sdk.AccAddress, proposalID uint64, options govtypes_v1.WeightedVoteOptions, salt string) error {
+	ballot, found := k.GetSecretBallot(ctx, proposalID)
+	if !found {
+		return errors.Wrapf(types.ErrInvalidSecretBallot, "proposal does not have a secret ballot: %d", proposalID)
+	}
+	proposal, found := k.govKeeper.GetProposal(ctx, proposalID)
+	if !found || proposal.Status != govtypes_v1.StatusVotingPeriod {
+		return errors.Wrapf(types.ErrInvalidVoteCommit, "proposal is not in its voting period: %d", proposalID)
+	}
+
+	commitEnd, revealEnd := k.secretBallotWindows(ctx, ballot, proposal)
+	if ctx.BlockTime().Before(commitEnd) || !ctx.BlockTime().Before(revealEnd) {
+		return errors.Wrap(types.ErrInvalidVoteCommit, "the reveal window is not open")
+	}
+
+	commit, found := k.GetVoteCommit(ctx, proposalID, voter)
+	if !found {
+		return errors.Wrapf(types.ErrInvalidVoteCommit, "no vote committed by %s", voter.String())
+	}
+	if commit.Revealed {
+		return errors.Wrap(types.ErrInvalidVoteCommit, "vote has already been revealed")
+	}
+	if types.SecretVoteHash(proposalID, commit.Voter, options, salt) != commit.Hash {
+		return errors.Wrap(types.ErrInvalidVoteCommit, "vote does not match its commitment")
+	}
+
+	commit.Revealed = true
+	commit.Options = options
+	k.setVoteCommit(ctx, commit)
+
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventVoteRevealed{
+			ProposalId: proposalID,
+			Voter:      commit.Voter,
+		},
+	)
+}
+
+// secretBallotWindows returns the end of the ballot's commit window, and of
+// its reveal window. Until the reveal window opens, both follow the
+// proposal's voting period.
+func (k Keeper) secretBallotWindows(ctx sdk.Context, ballot types.SecretBallot, proposal govtypes_v1.Proposal) (commitEnd time.Time, revealEnd time.Time) {
+	if !ballot.RevealEndTime.IsZero() {
+		return ballot.CommitEndTime, ballot.RevealEndTime
+	}
+	return *proposal.VotingEndTime, proposal.VotingEndTime.Add(k.RevealPeriod(ctx))
+}
+
+// OpenRevealWindow extends the voting period of a secret ballot proposal,
+// whose votes have all been committed, by the reveal period. The proposal
+// moves back in the active proposal queue, so that neither this module nor
+// the gov module tallies it until the votes have been revealed. Returns true
+// if the window was opened.
+func (k Keeper) OpenRevealWindow(ctx sdk.Context, proposal govtypes_v1.Proposal) bool {
+	ballot, found := k.GetSecretBallot(ctx, proposal.Id)
+	if !found || !ballot.RevealEndTime.IsZero() {
+		return false
+	}
+
+	ballot.CommitEndTime, ballot.RevealEndTime = k.secretBallotWindows(ctx, ballot, proposal)
+	k.setSecretBallot(ctx, ballot)
+
+	k.RemoveFromActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+	proposal.VotingEndTime = &ballot.RevealEndTime
+	k.SetProposal(ctx, proposal)
+	k.InsertActiveProposalQueue(ctx, proposal.Id, ballot.RevealEndTime)
+
+	_ = ctx.EventManager().EmitTypedEvent(
+		&types.EventRevealWindowOpened{
+			ProposalId:    proposal.Id,
+			RevealEndTime: ballot.RevealEndTime,
+		},
+	)
+	return true
+}
@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+// GetSuspension fetches the active suspension of the given member
+func (k Keeper) GetSuspension(ctx sdk.Context, member sdk.AccAddress) (types.Suspension, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	var suspension types.Suspension
+
+	bz := store.Get(types.SuspensionKey(member))
+	if bz == nil {
+		return suspension, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &suspension)
+	return suspension, true
+}
+
+// GetSuspensionHistory returns the member's past suspensions, oldest first
+func (k Keeper) GetSuspensionHistory(ctx sdk.Context, member sdk.AccAddress) []types.Suspension {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SuspensionHistoryPrefix(member))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var history []types.Suspension
+	for ; iterator.Valid(); iterator.Next() {
+		var suspension types.Suspension
+		k.cdc.MustUnmarshal(iterator.Value(), &suspension)
+		history = append(history, suspension)
+	}
+
+	return history
+}
+
+// SuspendMember suspends a member until the suspension's duration has passed
+func (k Keeper) SuspendMember(ctx sdk.Context, member sdk.AccAddress, operator sdk.AccAddress, reason string, duration time.Duration) error {
+	target, found := k.GetMemberAccount(ctx, member)
+	if !found {
+		return errors.Wrapf(types.ErrMemberNotFound, "member not found: %s", member.String())
+	}
+
+	suspension := types.Suspension{
+		Member:         member.String(),
+		Operator:       operator.String(),
+		Reason:         reason,
+		PreviousStatus: target.Status,
+		StartTime:      ctx.BlockTime(),
+		EndTime:        ctx.BlockTime().Add(duration),
+	}
+
+	// Must be a valid status transition
+	err := k.UpdateMemberStatus(ctx, member, types.MembershipStatus_MemberSuspended)
+	if err != nil {
+		return err
+	}
+
+	// Save the suspension and queue it to be lifted
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	store.Set(types.SuspensionKey(member), k.cdc.MustMarshal(&suspension))
+	store.Set(types.SuspensionQueueKey(suspension.EndTime, member), member)
+
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventMemberSuspended{
+			MemberAddress: suspension.Member,
+			Operator:      suspension.Operator,
+			Reason:        suspension.Reason,
+			EndTime:       suspension.EndTime,
+		},
+	)
+}
+
+// closeSuspension moves a member's active suspension into their suspension history
+func (k Keeper) closeSuspension(ctx sdk.Context, member sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+
+	suspension, found := k.GetSuspension(ctx, member)
+	if !found {
+		return
+	}
+
+	store.Delete(types.SuspensionQueueKey(suspension.EndTime, member))
+	store.Delete(types.SuspensionKey(member))
+
+	// Record when the suspension actually ended
+	if ctx.BlockTime().Before(suspension.EndTime) {
+		suspension.EndTime = ctx.BlockTime()
+	}
+	store.Set(types.SuspensionHistoryKey(member, suspension.StartTime), k.cdc.MustMarshal(&suspension))
+}
+
+// RestoreExpiredSuspensions lifts every suspension that has reached its end
+// time, restoring each member's previous status
+func (k Keeper) RestoreExpiredSuspensions(ctx sdk.Context) {
+	var expired []types.Suspension
+
+	k.IterateSuspensionQueue(ctx, ctx.BlockTime(), func(member sdk.AccAddress) (stop bool) {
+		if suspension, found := k.GetSuspension(ctx, member); found {
+			expired = append(expired, suspension)
+		}
+		return false
+	})
+
+	// Restore outside of the iterator, as restoring mutates the queue
+	for _, suspension := range expired {
+		member := sdk.MustAccAddressFromBech32(suspension.Member)
+
+		// Restoring the status also closes the suspension. Suspensions that
+		// cannot be lifted are closed regardless, so they are not retried
+		// every block.
+		cacheCtx, write := ctx.CacheContext()
+		err := k.UpdateMemberStatus(cacheCtx, member, suspension.PreviousStatus)
+		if err != nil {
+			k.Logger(ctx).Error("failed to lift suspension", "member", suspension.Member, "error", err)
+			k.closeSuspension(ctx, member)
+			continue
+		}
+		write()
+
+		ctx.EventManager().EmitTypedEvent(
+			&types.EventMemberSuspensionEnded{
+				MemberAddress:  suspension.Member,
+				RestoredStatus: suspension.PreviousStatus,
+			},
+		)
+	}
+}
+
+// IterateSuspensionQueue iterates over the members whose suspension ends at
+// or before endTime and performs a callback function
+func (k Keeper) IterateSuspensionQueue(ctx sdk.Context, endTime time.Time, cb func(member sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.SuspensionQueueKeyPrefix, sdk.PrefixEndBytes(types.SuspensionQueueByTimeKey(endTime)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(iterator.Value()) {
+			break
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noria-net/module-membership/app"
+	testkeeper "github.com/noria-net/module-membership/testutil/keeper"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/noria-net/module-membership/x/membership/keeper"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuspendMember(t *testing.T) {
+	k, ctx := testkeeper.MembershipKeeper(t)
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+
+	member := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	operator := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	require.NoError(t, k.AppendMember(ctx, member))
+
+	// Pending members cannot be suspended
+	err := k.SuspendMember(ctx, member, operator, "reason", time.Hour)
+	require.ErrorIs(t, err, types.ErrMembershipStatusChangeNotAllowed)
+
+	require.NoError(t, k.UpdateMemberStatus(ctx, member, types.MembershipStatus_MemberElectorate))
+	require.NoError(t, k.SuspendMember(ctx, member, operator, "reason", time.Hour))
+
+	m, _ := k.GetMemberAccount(ctx, member)
+	require.Equal(t, types.MembershipStatus_MemberSuspended, m.Status)
+	require.Equal(t, uint64(1), k.GetMemberStatusCount(ctx, types.MembershipStatus_MemberSuspended))
+	require.Equal(t, uint64(0), k.GetMemberStatusCount(ctx, types.MembershipStatus_MemberElectorate))
+
+	suspension, found := k.GetSuspension(ctx, member)
+	require.True(t, found)
+	require.Equal(t, types.MembershipStatus_MemberElectorate, suspension.PreviousStatus)
+	require.Equal(t, now.Add(time.Hour), suspension.EndTime)
+
+	// The suspension is not lifted early
+	ctx = ctx.WithBlockTime(now.Add(time.Minute))
+	k.RestoreExpiredSuspensions(ctx)
+	m, _ = k.GetMemberAccount(ctx, member)
+	require.Equal(t, types.MembershipStatus_MemberSuspended, m.Status)
+
+	// The previous status is restored at the end time
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	k.RestoreExpiredSuspensions(ctx)
+	m, _ = k.GetMemberAccount(ctx, member)
+	require.Equal(t, types.MembershipStatus_MemberElectorate, m.Status)
+
+	_, found = k.GetSuspension(ctx, member)
+	require.False(t, found)
+
+	history := k.GetSuspensionHistory(ctx, member)
+	require.Len(t, history, 1)
+	require.Equal(t, "reason", history[0].Reason)
+	require.Equal(t, operator.String(), history[0].Operator)
+}
+
+func TestSuspensionEndsWhenMemberIsExpelled(t *testing.T) {
+	k, ctx := testkeeper.MembershipKeeper(t)
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+
+	member := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	operator := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	require.NoError(t, k.AppendMember(ctx, member))
+	require.NoError(t, k.UpdateMemberStatus(ctx, member, types.MembershipStatus_MemberElectorate))
+	require.NoError(t, k.SuspendMember(ctx, member, operator, "reason", time.Hour))
+
+	ctx = ctx.WithBlockTime(now.Add(time.Minute))
+	require.NoError(t, k.UpdateMemberStatus(ctx, member, types.MembershipStatus_MemberExpulsed))
+
+	// The suspension is closed early and no longer restores the member
+	_, found := k.GetSuspension(ctx, member)
+	require.False(t, found)
+	history := k.GetSuspensionHistory(ctx, member)
+	require.Len(t, history, 1)
+	require.Equal(t, now.Add(time.Minute), history[0].EndTime)
+
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	k.RestoreExpiredSuspensions(ctx)
+	m, _ := k.GetMemberAccount(ctx, member)
+	require.Equal(t, types.MembershipStatus_MemberExpulsed, m.Status)
+}
+
+func TestUnliftableSuspensionIsClosed(t *testing.T) {
+	wasmApp := app.Setup(t)
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := wasmApp.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: now})
+	k := wasmApp.MembershipKeeper
+
+	// A suspension of an account that is not a member cannot be lifted
+	member := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	suspension := types.Suspension{
+		Member:         member.String(),
+		PreviousStatus: types.MembershipStatus_MemberElectorate,
+		StartTime:      now,
+		EndTime:        now.Add(time.Hour),
+	}
+	store := ctx.KVStore(wasmApp.GetKey(types.StoreKey))
+	store.Set(types.SuspensionKey(member), wasmApp.AppCodec().MustMarshal(&suspension))
+	store.Set(types.SuspensionQueueKey(suspension.EndTime, member), member)
+
+	// It is closed instead of being retried every block
+	ctx = ctx.WithBlockTime(suspension.EndTime)
+	k.RestoreExpiredSuspensions(ctx)
+	_, found := k.GetSuspension(ctx, member)
+	require.False(t, found)
+	require.Len(t, k.GetSuspensionHistory(ctx, member), 1)
+	k.IterateSuspensionQueue(ctx, suspension.EndTime, func(sdk.AccAddress) bool {
+		t.Fatal("suspension queue should be empty")
+		return true
+	})
+}
+
+func TestSuspensionDurationIsCapped(t *testing.T) {
+	k, ctx := testkeeper.MembershipKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	ctx = ctx.WithBlockTime(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	params := k.GetParams(ctx)
+	params.MaxSuspensionDuration = 24 * time.Hour
+	k.SetParams(ctx, params)
+
+	guardian := setupGuardian(t, k, ctx)
+	dd := types.DefaultDirectDemocracy()
+	dd.Guardians = []string{guardian.String()}
+	k.SetDirectDemocracySettings(ctx, &dd)
+
+	member := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	require.NoError(t, k.AppendMember(ctx, member))
+	require.NoError(t, k.UpdateMemberStatus(ctx, member, types.MembershipStatus_MemberElectorate))
+
+	// Suspensions cannot be longer than the maximum
+	_, err := msgServer.SuspendMember(ctx, types.NewMsgSuspendMember(guardian.String(), member.String(), "reason", 24*time.Hour+time.Second))
+	require.ErrorIs(t, err, types.ErrInvalidSuspension)
+	m, _ := k.GetMemberAccount(ctx, member)
+	require.Equal(t, types.MembershipStatus_MemberElectorate, m.Status)
+
+	// Up to the maximum is allowed
+	_, err = msgServer.SuspendMember(ctx, types.NewMsgSuspendMember(guardian.String(), member.String(), "reason", 24*time.Hour))
+	require.NoError(t, err)
+	m, _ = k.GetMemberAccount(ctx, member)
+	require.Equal(t, types.MembershipStatus_MemberSuspended, m.Status)
+}
+
+func TestGuardiansCannotSuspendGuardians(t *testing.T) {
+	k, ctx := testkeeper.MembershipKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	ctx = ctx.WithBlockTime(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	guardian := setupGuardian(t, k, ctx)
+	other := setupGuardian(t, k, ctx)
+	dd := types.DefaultDirectDemocracy()
+	dd.Guardians = []string{guardian.String(), other.String()}
+	k.SetDirectDemocracySettings(ctx, &dd)
+
+	_, err := msgServer.SuspendMember(ctx, types.NewMsgSuspendMember(guardian.String(), other.String(), "reason", time.Second))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// The other guardian keeps their status and guardianship
+	m, _ := k.GetMemberAccount(ctx, other)
+	require.Equal(t, types.MembershipStatus_MemberElectorate, m.Status)
+	require.True(t, k.IsGuardian(ctx, other))
+}
@@ -11,8 +11,12 @@ import (
 	"github.com/noria-net/module-membership/x/membership/types"
 )
 
-// voteOptions is a map of vote options to the number of votes for that option
-type voteOptions map[govtypes_v1.VoteOption]math.Int
+// voteOptions is a map of vote options to the number of votes for that option.
+// Split votes count a fraction of a vote towards each of their options.
+type voteOptions map[govtypes_v1.VoteOption]math.LegacyDec
+
+// integerVoteOptions is a map of vote options to a whole number of votes
+type integerVoteOptions map[govtypes_v1.VoteOption]math.Int
 
 // weightedVoteOptions is a map of vote options to the weighted number of votes for that option
 type weightedVoteOptions map[govtypes_v1.VoteOption]math.LegacyDec
@@ -22,9 +26,9 @@ type weightedVoteOptions map[govtypes_v1.VoteOption]math.LegacyDec
 type combinedTallyResults struct {
 	results          weightedVoteOptions
 	votingPower      math.LegacyDec
-	numGuardianVotes math.Int
-	numMemberVotes   math.Int
-	totalVotes       math.Int
+	numGuardianVotes math.LegacyDec // in units of guardian weight
+	numMemberVotes   math.LegacyDec
+	totalVotes       math.LegacyDec
 }
 
 // Tally iterates over the votes and updates the tally of a proposal based on the voting power of the
@@ -38,26 +42,35 @@ func (k Keeper) Tally(ctx sdk.Context, proposal govtypes_v1.Proposal) (passes bo
 
 	memberResults := NewEmptyVoteOptions()
 	guardianResults := NewEmptyVoteOptions()
-
-	totalVotingWeight := k.GetDirectDemocracySettings(ctx).TotalVotingWeight
-	guardians := k.GetGuardians(ctx)
-
-	memberPower, guardianPower := calculateVotePower(
-		int64(k.GetMemberStatusCount(ctx, types.MembershipStatus_MemberElectorate)),
-		int64(len(guardians)),
-		totalVotingWeight,
-	)
-
-	k.IterateVotes(ctx, proposal.Id, func(vote govtypes_v1.Vote) (stop bool) {
+	// Guardian votes counted as ordinary member votes, for when the guardians
+	// hold no voting power of their own
+	guardianMemberResults := NewEmptyVoteOptions()
+
+	memberPower, guardianPower := k.GetVotePower(ctx)
+	numGuardians, totalGuardianWeight := k.getTotalGuardianWeight(ctx)
+	numMembers := int64(k.GetMemberStatusCount(ctx, types.MembershipStatus_MemberElectorate)) - numGuardians
+	dd := k.GetDirectDemocracySettings(ctx)
+	allowSplitVotes := k.AllowSplitVotes(ctx)
+	secretBallot := k.IsSecretBallot(ctx, proposal.Id)
+	votes := make(castVotes)
+
+	countVote := func(vote govtypes_v1.Vote) {
 		// Create a custom logger for this voter
 		voterLogger := ctx.WithLogger(ctx.Logger().With("voter", vote.Voter))
 
 		voterAddress := sdk.MustAccAddressFromBech32(vote.Voter)
 		member, found := k.GetMemberAccount(ctx, voterAddress)
 
+		guardianWeight := types.DefaultGuardianWeight
+		if dd != nil {
+			guardianWeight = dd.GetGuardianWeight(vote.Voter)
+		}
+
 		err := processSingleVote(vote,
 			&member,
 			found,
+			guardianWeight,
+			allowSplitVotes,
 			memberResults,
 			guardianResults,
 		)
@@ -65,6 +78,19 @@ func (k Keeper) Tally(ctx sdk.Context, proposal govtypes_v1.Proposal) (passes bo
 		// Getting an error doesn't stop us iterating through the votes
 		if err != nil {
 			voterLogger.Logger().Error(fmt.Sprintf("Error processing vote: %s", err.Error()))
+			votes[vote.Voter] = nil
+		} else {
+			votes[vote.Voter] = vote.Options
+			if member.IsGuardian {
+				addVoteOptions(guardianMemberResults, vote.Options)
+			}
+		}
+	}
+
+	k.IterateVotes(ctx, proposal.Id, func(vote govtypes_v1.Vote) (stop bool) {
+		// Public votes on a secret ballot are not counted
+		if !secretBallot {
+			countVote(vote)
 		}
 
 		// Delete this vote, now that its been processed
@@ -73,17 +99,102 @@ func (k Keeper) Tally(ctx sdk.Context, proposal govtypes_v1.Proposal) (passes bo
 		return false
 	})
 
-	govParams := k.GetGovParams(ctx)
+	// Only revealed votes choose an option on a secret ballot. Unrevealed
+	// votes may still count towards quorum, as abstentions, but delegators
+	// never follow them.
+	if secretBallot {
+		countUnrevealed := k.CountUnrevealedVotes(ctx)
+		for _, commit := range k.GetVoteCommits(ctx, proposal.Id) {
+			vote := govtypes_v1.Vote{
+				ProposalId: commit.ProposalId,
+				Voter:      commit.Voter,
+				Options:    commit.Options,
+			}
+			if commit.Revealed {
+				countVote(vote)
+				continue
+			}
+			if countUnrevealed {
+				vote.Options = govtypes_v1.NewNonSplitVoteOption(govtypes_v1.OptionAbstain)
+				countVote(vote)
+			}
+			votes[commit.Voter] = nil
+		}
+	}
+
+	// Members who did not vote follow their delegations
+	k.processDelegatedVotes(ctx, proposal, votes, allowSplitVotes, memberResults, guardianResults)
+
+	// Hold the proposal to the strictest tally rule among its messages
+	rule, _ := k.GetTallyRule(ctx, proposal)
+	govParams := rule.ApplyTo(k.GetGovParams(ctx))
+
+	// Without any guardian power, guardians vote as ordinary members
+	combinedMemberResults, combinedGuardianResults := memberResults, guardianResults
+	if guardianPower.IsZero() {
+		combinedMemberResults, combinedGuardianResults = NewEmptyVoteOptions(), NewEmptyVoteOptions()
+		for option := range combinedMemberResults {
+			combinedMemberResults[option] = memberResults[option].Add(guardianMemberResults[option])
+		}
+	}
+
 	passes, burnDeposits, tallyResults = calculateVoteResults(proposal,
 		govParams,
-		memberResults,
-		guardianResults,
+		combinedMemberResults,
+		combinedGuardianResults,
 		memberPower,
 		guardianPower)
 
+	// Keep each chamber's outcome, and require both to approve where needed
+	breakdown := types.TallyBreakdown{
+		ProposalId: proposal.Id,
+		Bicameral:  k.IsBicameralProposal(ctx, proposal),
+		Guardians:  calculateChamberTally(guardianResults, math.NewInt(totalGuardianWeight), k.getChamberGovParams(ctx, proposal, k.GuardianChamberRule(ctx))),
+		Members:    calculateChamberTally(memberResults, math.NewInt(numMembers), k.getChamberGovParams(ctx, proposal, k.MemberChamberRule(ctx))),
+	}
+	if breakdown.Bicameral {
+		passes, burnDeposits = calculateBicameralVoteResults(breakdown, govParams)
+	}
+	k.SetTallyBreakdown(ctx, breakdown)
+
 	return passes, burnDeposits, tallyResults
 }
 
+// GetVotePower returns the voting power of a single member, and of a single
+// unit of guardian weight. Without any guardians, the total voting weight is
+// ignored. Without any guardian power, every electorate member, guardians
+// included, has an equal share of the voting power.
+func (k Keeper) GetVotePower(ctx sdk.Context) (memberPower sdk.Dec, guardianPower sdk.Dec) {
+	numGuardians, totalGuardianWeight := k.getTotalGuardianWeight(ctx)
+
+	totalVotingWeight := sdk.ZeroDec()
+	if dd := k.GetDirectDemocracySettings(ctx); dd != nil && numGuardians > 0 {
+		totalVotingWeight = dd.TotalVotingWeight
+	}
+
+	return calculateVotePower(
+		int64(k.GetMemberStatusCount(ctx, types.MembershipStatus_MemberElectorate)),
+		numGuardians,
+		totalGuardianWeight,
+		totalVotingWeight,
+	)
+}
+
+// getTotalGuardianWeight returns the number of guardians, and the sum of
+// their relative weights
+func (k Keeper) getTotalGuardianWeight(ctx sdk.Context) (numGuardians int64, totalWeight int64) {
+	dd := k.GetDirectDemocracySettings(ctx)
+	if dd == nil {
+		return 0, 0
+	}
+
+	for _, guardian := range k.GetGuardians(ctx) {
+		numGuardians++
+		totalWeight += int64(dd.GetGuardianWeight(guardian.Address))
+	}
+	return numGuardians, totalWeight
+}
+
 // MarkVoteForDeletion marks a vote for deletion in the future
 func (k Keeper) markVoteForDeletion(ctx sdk.Context, vote govtypes_v1.Vote) {
 	store := ctx.KVStore(k.storeKey)
@@ -91,10 +202,14 @@ func (k Keeper) markVoteForDeletion(ctx sdk.Context, vote govtypes_v1.Vote) {
 	store.Set(types.VoteToDeleteKey(vote.ProposalId, sdk.AccAddress(vote.Voter)), bz)
 }
 
-// processSingleVote processes a single vote, updating the tally results
+// processSingleVote processes a single vote, updating the tally results.
+// Guardian votes are counted by the guardian's relative weight. When split
+// votes are allowed, the voter's weight is spread across their options.
 func processSingleVote(vote govtypes_v1.Vote,
 	member *types.Member,
 	found bool,
+	guardianWeight uint64,
+	allowSplitVotes bool,
 	memberResults voteOptions,
 	guardianResults voteOptions) error {
 
@@ -103,63 +218,62 @@ func processSingleVote(vote govtypes_v1.Vote,
 		return errors.New("voter is not a member of the electorate")
 	}
 
-	// member must be eligible to vote
+	// member must be eligible to vote, which excludes suspended members
 	if member.Status != types.MembershipStatus_MemberElectorate {
 		return errors.New("member is not eligible to vote")
 	}
 
 	// member's vote weight must be valid
-	ok, weightingError := isValidVoteWeighting(vote.Options)
+	ok, weightingError := isValidVoteWeighting(vote.Options, allowSplitVotes)
 	if !ok {
 		return fmt.Errorf("invalid voting weight: %s", weightingError.Error())
 	}
 
-	choice := getVoterChoice(vote.Options)
+	results, voterWeight := memberResults, sdk.OneDec()
 	if member.IsGuardian {
-		guardianResults[choice] = guardianResults[choice].Add(math.NewInt(1))
-	} else {
-		memberResults[choice] = memberResults[choice].Add(math.NewInt(1))
+		results, voterWeight = guardianResults, sdk.NewDecFromInt(math.NewIntFromUint64(guardianWeight))
+	}
+	for _, option := range vote.Options {
+		weight := sdk.MustNewDecFromStr(option.Weight)
+		results[option.Option] = results[option.Option].Add(weight.Mul(voterWeight))
 	}
 
 	return nil
 }
 
-// isValidVoteWeighting checks if the vote has been made on a single option, and not spread across more than one
-func isValidVoteWeighting(options []*govtypes_v1.WeightedVoteOption) (bool, error) {
+// isValidVoteWeighting checks that the vote's weights add up to 1. Unless split
+// votes are allowed, the vote must be made on a single option, and not spread
+// across more than one.
+func isValidVoteWeighting(options []*govtypes_v1.WeightedVoteOption, allowSplitVotes bool) (bool, error) {
 
 	totalWeight := sdk.NewDec(0)
 	for _, option := range options {
-		weight := sdk.MustNewDecFromStr(option.Weight)
-		// Cannot have any other weighting besides 0 or 1
-		if !weight.IsZero() && !weight.Equal(sdk.NewDec(1)) {
+		if !govtypes_v1.ValidVoteOption(option.Option) {
+			return false, fmt.Errorf("option %s is invalid", option.Option)
+		}
+		weight, err := sdk.NewDecFromStr(option.Weight)
+		if err != nil {
+			return false, fmt.Errorf("option %s's weight is invalid: %s", option.Option, option.Weight)
+		}
+		// Cannot have a negative weighting, or one above 1
+		if weight.IsNegative() || weight.GT(sdk.OneDec()) {
+			return false, fmt.Errorf("option %s's weight is invalid: %s", option.Option, option.Weight)
+		}
+		// Cannot have any other weighting besides 0 or 1, unless the vote can be split
+		if !allowSplitVotes && !weight.IsZero() && !weight.Equal(sdk.OneDec()) {
 			return false, fmt.Errorf("option %s's weight is invalid: %s", option.Option, option.Weight)
 		}
 		totalWeight = totalWeight.Add(weight)
+	}
 
-		// Cannot have a total weight of more than 1
-		if !totalWeight.Equal(sdk.NewDec(1)) {
-			return false, fmt.Errorf("vote is spoilt, total weighting of %s exceeds 1", option.Weight)
-		}
+	// The weights must add up to exactly 1
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return false, fmt.Errorf("vote is spoilt, total weighting of %s is not 1", totalWeight)
 	}
 
 	return true, nil
 }
 
-// getVoterChoice returns the vote option that the voter has chosen
-func getVoterChoice(options []*govtypes_v1.WeightedVoteOption) govtypes_v1.VoteOption {
-	for _, option := range options {
-		optionDec, err := sdk.NewDecFromStr(option.Weight)
-		if err != nil {
-			panic(err)
-		}
-
-		if optionDec.Equal(sdk.NewDec(1)) {
-			return option.Option
-		}
-	}
-	return govtypes_v1.OptionEmpty
-}
-
 func calculateVoteResults(proposal govtypes_v1.Proposal,
 	govParams govtypes_v1.Params,
 	memberResults voteOptions,
@@ -185,27 +299,22 @@ func calculateVoteResults(proposal govtypes_v1.Proposal,
 	}
 
 	// If no one votes (everyone abstains), proposal fails
-	if combined.votingPower.Sub(combined.results[govtypes_v1.OptionAbstain]).Equal(math.LegacyZeroDec()) {
+	nonAbstaining := combined.votingPower.Sub(combined.results[govtypes_v1.OptionAbstain])
+	if nonAbstaining.Equal(math.LegacyZeroDec()) {
 		return false, false, tallyResults
 	}
 
-	// If more than 1/3 of voters veto, proposal fails
-	// VetoPortion = (Guardian_NoWithVetoVotes / (Guardian_Votes - Guardian_AbstainVotes)) * Guardian_Power
-	// + (NormalMember_NoWithVetoVotes / (NormalMember_Votes - NormalMember_AbstainVotes)) * NormalMember_Power
-	guardianVeto := calculateVeto(guardianResults, combined.numGuardianVotes, guardianPower)
-	memberVeto := calculateVeto(memberResults, combined.numMemberVotes, memberPower)
-	combinedVeto := guardianVeto.Add(memberVeto)
-	if combinedVeto.GT(vetoThreshold) {
+	// If more than 1/3 of the non-abstaining voting power vetoes, proposal fails
+	// VetoPortion = (Guardian_NoWithVetoVotes * Guardian_Power + NormalMember_NoWithVetoVotes * NormalMember_Power)
+	// / (VotePortion - Guardian_AbstainVotes * Guardian_Power - NormalMember_AbstainVotes * NormalMember_Power)
+	if combined.results[govtypes_v1.OptionNoWithVeto].Quo(nonAbstaining).GT(vetoThreshold) {
 		return false, govParams.BurnVoteVeto, tallyResults
 	}
 
-	// If combined Yes votes exceeds threshold, proposal passes
-	// YesPortion = (Guardian_YesVotes / Guardian_Votes) * Guardian_Power + (NormalMember_YesVotes / NormalMember_Votes) * NormalMember_Power
-	guardianYes := calculateWeightedOptionVote(guardianResults[govtypes_v1.OptionYes], combined.numGuardianVotes, guardianPower)
-	memberYes := calculateWeightedOptionVote(memberResults[govtypes_v1.OptionYes], combined.numMemberVotes, memberPower)
-	combinedYes := guardianYes.Add(memberYes)
-	yesThreshold := sdk.MustNewDecFromStr(govParams.Threshold)
-	if combinedYes.GT(yesThreshold) {
+	// If the Yes share of the non-abstaining voting power exceeds the threshold, proposal passes
+	// YesPortion = (Guardian_YesVotes * Guardian_Power + NormalMember_YesVotes * NormalMember_Power)
+	// / (VotePortion - Guardian_AbstainVotes * Guardian_Power - NormalMember_AbstainVotes * NormalMember_Power)
+	if combined.results[govtypes_v1.OptionYes].Quo(nonAbstaining).GT(sdk.MustNewDecFromStr(govParams.Threshold)) {
 		return true, false, tallyResults
 	}
 
@@ -213,23 +322,39 @@ func calculateVoteResults(proposal govtypes_v1.Proposal,
 	return false, false, tallyResults
 }
 
-// calculateVotePower calculates the voting power of members and guardians
-func calculateVotePower(numTotalMembers int64, numGuardians int64, totalVotingWeight math.LegacyDec) (memberPower math.LegacyDec, guardianPower math.LegacyDec) {
+// calculateVotePower calculates the voting power of a member, and of a unit of
+// guardian weight. The guardians' power adds up to the total voting weight,
+// however it is split between them. Without any guardian power, guardians are
+// counted as members.
+func calculateVotePower(numTotalMembers int64, numGuardians int64, totalGuardianWeight int64, totalVotingWeight math.LegacyDec) (memberPower math.LegacyDec, guardianPower math.LegacyDec) {
 
 	// Ensure total voting weight is inclusively between 0 and 1
 	if totalVotingWeight.LT(math.LegacyZeroDec()) || totalVotingWeight.GT(math.LegacyOneDec()) {
 		panic(fmt.Errorf("invalid total voting weight - must be between 0 and 1, got %s", totalVotingWeight))
 	}
 
-	// Member count excludes guardians
+	// Without guardians, members share all of the voting power
+	if numGuardians == 0 || totalGuardianWeight <= 0 {
+		totalVotingWeight = sdk.NewDec(0)
+	}
+
+	// Member count excludes guardians, unless they have no power of their own
 	numMembers := numTotalMembers - numGuardians
+	if totalVotingWeight.IsZero() {
+		numMembers = numTotalMembers
+	}
 	// all members are guardians
-	if numMembers == 0 {
+	if numMembers <= 0 {
 		memberPower = sdk.NewDec(0)
 	} else {
 		memberPower = sdk.NewDec(1).Sub(totalVotingWeight).QuoInt64(numMembers)
 	}
-	guardianPower = totalVotingWeight.QuoInt64(numGuardians)
+	// no guardian power
+	if totalVotingWeight.IsZero() {
+		guardianPower = sdk.NewDec(0)
+	} else {
+		guardianPower = totalVotingWeight.QuoInt64(totalGuardianWeight)
+	}
 
 	return memberPower, guardianPower
 }
@@ -237,15 +362,23 @@ func calculateVotePower(numTotalMembers int64, numGuardians int64, totalVotingWe
 // makeResultMap returns a map with all the vote options set to 0
 func NewEmptyVoteOptions() voteOptions {
 	results := make(voteOptions)
-	results[govtypes_v1.OptionYes] = math.ZeroInt()
-	results[govtypes_v1.OptionAbstain] = math.ZeroInt()
-	results[govtypes_v1.OptionNo] = math.ZeroInt()
-	results[govtypes_v1.OptionNoWithVeto] = math.ZeroInt()
+	results[govtypes_v1.OptionYes] = math.LegacyZeroDec()
+	results[govtypes_v1.OptionAbstain] = math.LegacyZeroDec()
+	results[govtypes_v1.OptionNo] = math.LegacyZeroDec()
+	results[govtypes_v1.OptionNoWithVeto] = math.LegacyZeroDec()
 	return results
 }
 
+// addVoteOptions adds a single vote, spread across its options by their weights
+func addVoteOptions(results voteOptions, options []*govtypes_v1.WeightedVoteOption) {
+	for _, option := range options {
+		results[option.Option] = results[option.Option].Add(sdk.MustNewDecFromStr(option.Weight))
+	}
+}
+
 // calculateCombinedTallyResults combines the results of the member and guardian votes,
-// and uses the voting power of each group to calculate the total voting power of each option
+// and uses the voting power of each group to calculate the total voting power of each option.
+// Guardian results are counted in units of guardian weight.
 func calculateCombinedTallyResults(memberResults,
 	guardianResults voteOptions,
 	memberPower sdk.Dec,
@@ -254,14 +387,14 @@ func calculateCombinedTallyResults(memberResults,
 	combined := combinedTallyResults{
 		results:          make(weightedVoteOptions),
 		votingPower:      sdk.ZeroDec(),
-		numGuardianVotes: math.ZeroInt(),
-		numMemberVotes:   math.ZeroInt(),
-		totalVotes:       math.ZeroInt(),
+		numGuardianVotes: math.LegacyZeroDec(),
+		numMemberVotes:   math.LegacyZeroDec(),
+		totalVotes:       math.LegacyZeroDec(),
 	}
 
 	for option, guardianVoteCount := range guardianResults {
-		combined.results[option] = guardianPower.Mul(math.LegacyNewDecFromInt(guardianVoteCount)).Add(
-			memberPower.Mul(math.LegacyNewDecFromInt(memberResults[option])))
+		combined.results[option] = guardianPower.Mul(guardianVoteCount).Add(
+			memberPower.Mul(memberResults[option]))
 		combined.votingPower = combined.votingPower.Add(combined.results[option])
 		combined.numGuardianVotes = combined.numGuardianVotes.Add(guardianVoteCount)
 		combined.numMemberVotes = combined.numMemberVotes.Add(memberResults[option])
@@ -271,28 +404,7 @@ func calculateCombinedTallyResults(memberResults,
 	return combined
 }
 
-// calculateVeto calculates the weighted veto of a group of voters
-func calculateVeto(results voteOptions, numVotes math.Int, power math.LegacyDec) math.LegacyDec {
-	// Cannot calculate weighted veto if there are no votes
-	if numVotes.IsZero() {
-		return math.LegacyNewDec(0)
-	}
-	// (NoWithVetoVotes / (NumVotes - AbstainVotes)) * Power
-	veto := math.LegacyNewDecFromInt(results[govtypes_v1.OptionNoWithVeto]).Quo(
-		math.LegacyNewDecFromInt(numVotes).Sub(math.LegacyNewDecFromInt(results[govtypes_v1.OptionAbstain]))).Mul(power)
-	return veto
-}
-
-// calculateWeightedOptionVote calculates the weighted vote of a group of voters
-func calculateWeightedOptionVote(numOptionVotes math.Int, numVotes math.Int, power math.LegacyDec) math.LegacyDec {
-	// Cannot calculate the vote's weighted option if there are no votes
-	if numVotes.IsZero() {
-		return math.LegacyNewDec(0)
-	}
-	return math.LegacyNewDecFromInt(numOptionVotes).Quo(math.LegacyNewDecFromInt(numVotes)).Mul(power)
-}
-
-func scaleTallyResultsToIntegerMap(results weightedVoteOptions) voteOptions {
+func scaleTallyResultsToIntegerMap(results weightedVoteOptions) integerVoteOptions {
 	// Cycle through each weightedVoteOption and find the one with the most decimal places
 	maxDecimalPlaces := 0
 	for _, result := range results {
@@ -320,7 +432,7 @@ func scaleTallyResultsToIntegerMap(results weightedVoteOptions) voteOptions {
 		}
 	}
 
-	votingOptions := make(voteOptions)
+	votingOptions := make(integerVoteOptions)
 
 	// set every matching option to the decimal value multiplied by maxDecimalPlaces
 	for option, result := range results {
@@ -330,7 +442,7 @@ func scaleTallyResultsToIntegerMap(results weightedVoteOptions) voteOptions {
 	return votingOptions
 }
 
-func toGovTallyResult(results voteOptions) govtypes_v1.TallyResult {
+func toGovTallyResult(results integerVoteOptions) govtypes_v1.TallyResult {
 	return govtypes_v1.NewTallyResult(
 		results[govtypes_v1.OptionYes],
 		results[govtypes_v1.OptionAbstain],
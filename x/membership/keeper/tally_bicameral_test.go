@@ -0,0 +1,245 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes_v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type BicameralVoteResultsTestSuite struct {
+	suite.Suite
+	govParams govtypes_v1.Params
+}
+
+// Setup
+func (suite *BicameralVoteResultsTestSuite) SetupTest() {
+	period := time.Duration(30) * time.Second
+	suite.govParams = govtypes_v1.NewParams(
+		sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000000))),
+		period,
+		period,
+		// Quorum
+		"0.334",
+		// Threshold
+		"0.5",
+		// Veto
+		"0.334",
+		"0.334",
+		false,
+		// Burn vote quorum
+		true,
+		// Burn vote veto
+		true,
+	)
+}
+
+// breakdown tallies each chamber of two guardians and four members
+func (suite *BicameralVoteResultsTestSuite) breakdown(guardianResults, memberResults voteOptions) types.TallyBreakdown {
+	return types.TallyBreakdown{
+		Bicameral: true,
+		Guardians: calculateChamberTally(guardianResults, math.NewInt(2), suite.govParams),
+		Members:   calculateChamberTally(memberResults, math.NewInt(4), suite.govParams),
+	}
+}
+
+// Test Case: Both chambers approve and the proposal passes
+func (suite *BicameralVoteResultsTestSuite) Test_BothChambersApprove() {
+	guardianResults := NewEmptyVoteOptions()
+	memberResults := NewEmptyVoteOptions()
+	addVote(guardianResults, govtypes_v1.OptionYes)
+	addVote(memberResults, govtypes_v1.OptionYes)
+	addVote(memberResults, govtypes_v1.OptionYes)
+	addVote(memberResults, govtypes_v1.OptionNo)
+
+	breakdown := suite.breakdown(guardianResults, memberResults)
+	suite.Assert().True(breakdown.Guardians.Passed)
+	suite.Assert().True(breakdown.Members.Passed)
+
+	passes, burnDeposits := calculateBicameralVoteResults(breakdown, suite.govParams)
+	suite.Assert().True(passes)
+	suite.Assert().False(burnDeposits)
+}
+
+// Test Case: The member chamber rejects the proposal, even though the guardians are unanimous
+func (suite *BicameralVoteResultsTestSuite) Test_MemberChamberRejects() {
+	guardianResults := NewEmptyVoteOptions()
+	memberResults := NewEmptyVoteOptions()
+	addVote(guardianResults, govtypes_v1.OptionYes)
+	addVote(guardianResults, govtypes_v1.OptionYes)
+	addVote(memberResults, govtypes_v1.OptionYes)
+	addVote(memberResults, govtypes_v1.OptionNo)
+
+	breakdown := suite.breakdown(guardianResults, memberResults)
+	suite.Assert().True(breakdown.Guardians.Passed)
+	suite.Assert().True(breakdown.Members.QuorumReached)
+	suite.Assert().False(breakdown.Members.Passed)
+
+	passes, burnDeposits := calculateBicameralVoteResults(breakdown, suite.govParams)
+	suite.Assert().False(passes)
+	suite.Assert().False(burnDeposits)
+}
+
+// Test Case: The guardian chamber misses quorum
+func (suite *BicameralVoteResultsTestSuite) Test_GuardianChamberMissesQuorum() {
+	guardianResults := NewEmptyVoteOptions()
+	memberResults := NewEmptyVoteOptions()
+	addVote(memberResults, govtypes_v1.OptionYes)
+	addVote(memberResults, govtypes_v1.OptionYes)
+	addVote(memberResults, govtypes_v1.OptionYes)
+
+	breakdown := suite.breakdown(guardianResults, memberResults)
+	suite.Assert().False(breakdown.Guardians.QuorumReached)
+
+	passes, burnDeposits := calculateBicameralVoteResults(breakdown, suite.govParams)
+	suite.Assert().False(passes)
+	suite.Assert().Equal(suite.govParams.BurnVoteQuorum, burnDeposits)
+}
+
+// Test Case: The guardian chamber vetoes the proposal
+func (suite *BicameralVoteResultsTestSuite) Test_GuardianChamberVetoes() {
+	guardianResults := NewEmptyVoteOptions()
+	memberResults := NewEmptyVoteOptions()
+	addVote(guardianResults, govtypes_v1.OptionYes)
+	addVote(guardianResults, govtypes_v1.OptionNoWithVeto)
+	addVote(memberResults, govtypes_v1.OptionYes)
+	addVote(memberResults, govtypes_v1.OptionYes)
+
+	breakdown := suite.breakdown(guardianResults, memberResults)
+	suite.Assert().True(breakdown.Guardians.Vetoed)
+	suite.Assert().False(breakdown.Members.Vetoed)
+
+	passes, burnDeposits := calculateBicameralVoteResults(breakdown, suite.govParams)
+	suite.Assert().False(passes)
+	suite.Assert().Equal(suite.govParams.BurnVoteVeto, burnDeposits)
+}
+
+// Test Case: Without guardians, the member chamber decides alone
+func (suite *BicameralVoteResultsTestSuite) Test_EmptyGuardianChamber() {
+	memberResults := NewEmptyVoteOptions()
+	addVote(memberResults, govtypes_v1.OptionYes)
+	addVote(memberResults, govtypes_v1.OptionYes)
+
+	breakdown := types.TallyBreakdown{
+		Bicameral: true,
+		Guardians: calculateChamberTally(NewEmptyVoteOptions(), math.ZeroInt(), suite.govParams),
+		Members:   calculateChamberTally(memberResults, math.NewInt(4), suite.govParams),
+	}
+
+	passes, _ := calculateBicameralVoteResults(breakdown, suite.govParams)
+	suite.Assert().True(passes)
+}
+
+// Test Case: Each chamber holds the proposal to its own rule
+func (suite *BicameralVoteResultsTestSuite) Test_ChambersWithDifferentRules() {
+	guardianRule := types.ChamberRule{
+		Quorum:        math.LegacyMustNewDecFromStr("0.5"),
+		Threshold:     math.LegacyMustNewDecFromStr("0.75"),
+		VetoThreshold: math.LegacyMustNewDecFromStr("0.334"),
+	}
+	memberRule := types.ChamberRule{
+		Quorum:        math.LegacyMustNewDecFromStr("0.2"),
+		Threshold:     math.LegacyMustNewDecFromStr("0.5"),
+		VetoThreshold: math.LegacyMustNewDecFromStr("0.5"),
+	}
+	send, err := codectypes.NewAnyWithValue(&banktypes.MsgSend{})
+	suite.Require().NoError(err)
+	proposal := govtypes_v1.Proposal{Messages: []*codectypes.Any{send}}
+	guardianParams := resolveChamberGovParams(nil, proposal, suite.govParams, guardianRule)
+	memberParams := resolveChamberGovParams(nil, proposal, suite.govParams, memberRule)
+
+	// Two of three guardians, and the only member to vote, approve
+	guardianResults := NewEmptyVoteOptions()
+	memberResults := NewEmptyVoteOptions()
+	addVote(guardianResults, govtypes_v1.OptionYes)
+	addVote(guardianResults, govtypes_v1.OptionYes)
+	addVote(guardianResults, govtypes_v1.OptionNo)
+	addVote(memberResults, govtypes_v1.OptionYes)
+
+	// Under the gov params, the guardians approve and the members miss quorum
+	breakdown := types.TallyBreakdown{
+		Bicameral: true,
+		Guardians: calculateChamberTally(guardianResults, math.NewInt(3), suite.govParams),
+		Members:   calculateChamberTally(memberResults, math.NewInt(5), suite.govParams),
+	}
+	suite.Assert().True(breakdown.Guardians.Passed)
+	suite.Assert().False(breakdown.Members.QuorumReached)
+
+	// Under their own rules, the guardians fall short of their threshold and
+	// the members reach their quorum
+	breakdown = types.TallyBreakdown{
+		Bicameral: true,
+		Guardians: calculateChamberTally(guardianResults, math.NewInt(3), guardianParams),
+		Members:   calculateChamberTally(memberResults, math.NewInt(5), memberParams),
+	}
+	suite.Assert().True(breakdown.Guardians.QuorumReached)
+	suite.Assert().False(breakdown.Guardians.Passed)
+	suite.Assert().True(breakdown.Members.Passed)
+
+	passes, burnDeposits := calculateBicameralVoteResults(breakdown, suite.govParams)
+	suite.Assert().False(passes)
+	suite.Assert().False(burnDeposits)
+
+	// A third guardian approving passes the proposal
+	guardianResults[govtypes_v1.OptionNo] = math.LegacyZeroDec()
+	addVote(guardianResults, govtypes_v1.OptionYes)
+	breakdown.Guardians = calculateChamberTally(guardianResults, math.NewInt(3), guardianParams)
+	passes, _ = calculateBicameralVoteResults(breakdown, suite.govParams)
+	suite.Assert().True(passes)
+}
+
+// Test Case: Unset chamber rules leave the gov params, and tally rules still apply
+func (suite *BicameralVoteResultsTestSuite) Test_ChamberRulesAndTallyRules() {
+	send, err := codectypes.NewAnyWithValue(&banktypes.MsgSend{})
+	suite.Require().NoError(err)
+	proposal := govtypes_v1.Proposal{Messages: []*codectypes.Any{send}}
+
+	params := resolveChamberGovParams(nil, proposal, suite.govParams, types.NewUnsetChamberRule())
+	suite.Assert().Equal("0.334000000000000000", params.Quorum)
+	suite.Assert().Equal("0.500000000000000000", params.Threshold)
+	suite.Assert().Equal("0.334000000000000000", params.VetoThreshold)
+
+	memberRule := types.ChamberRule{
+		Quorum:        math.LegacyMustNewDecFromStr("0.2"),
+		Threshold:     math.LegacyMustNewDecFromStr("0.5"),
+		VetoThreshold: math.LegacyMustNewDecFromStr("0.5"),
+	}
+	rules := []types.TallyRule{{
+		MsgTypeUrl:    "/cosmos.bank.v1beta1.MsgSend",
+		Quorum:        math.LegacyMustNewDecFromStr("0.4"),
+		Threshold:     math.LegacyMustNewDecFromStr("0.667"),
+		VetoThreshold: math.LegacyMustNewDecFromStr("0.334"),
+	}}
+	params = resolveChamberGovParams(rules, proposal, suite.govParams, memberRule)
+	suite.Assert().Equal("0.400000000000000000", params.Quorum)
+	suite.Assert().Equal("0.667000000000000000", params.Threshold)
+	suite.Assert().Equal("0.334000000000000000", params.VetoThreshold)
+}
+
+// Test Case: Legacy proposals are matched by the type of their content
+func (suite *BicameralVoteResultsTestSuite) Test_ProposalMsgTypesIncludeLegacyContent() {
+	content, err := codectypes.NewAnyWithValue(&types.UpdateTotalVotingWeightProposal{Title: "title"})
+	suite.Require().NoError(err)
+	legacy, err := codectypes.NewAnyWithValue(&govtypes_v1.MsgExecLegacyContent{Content: content})
+	suite.Require().NoError(err)
+	send, err := codectypes.NewAnyWithValue(&banktypes.MsgSend{})
+	suite.Require().NoError(err)
+
+	proposal := govtypes_v1.Proposal{Messages: []*codectypes.Any{legacy, send}}
+	suite.Assert().Equal([]string{
+		"/membershipmodule.membership.UpdateTotalVotingWeightProposal",
+		"/cosmos.gov.v1.MsgExecLegacyContent",
+		"/cosmos.bank.v1beta1.MsgSend",
+	}, proposalMsgTypes(proposal))
+}
+
+// Run test suite
+func TestBicameralVoteResultsTestSuite(t *testing.T) {
+	suite.Run(t, new(BicameralVoteResultsTestSuite))
+}
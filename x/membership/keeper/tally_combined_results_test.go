@@ -22,7 +22,7 @@ func (suite *CalculateCombinedTallyResultsTestSuite) Test_NoVotingResultsEmptyCo
 	guardianResults := NewEmptyVoteOptions()
 	totalVotingPower := math.LegacyMustNewDecFromStr("0.51")
 	// Two members, one guardian, 51% voting power
-	memberPower, guardianPower := calculateVotePower(2, 1, totalVotingPower)
+	memberPower, guardianPower := calculateVotePower(2, 1, 1, totalVotingPower)
 
 	// Execute test
 	combined := calculateCombinedTallyResults(memberResults, guardianResults, memberPower, guardianPower)
@@ -37,7 +37,7 @@ func (suite *CalculateCombinedTallyResultsTestSuite) Test_OnlyMemberVotesCombine
 	guardianResults := NewEmptyVoteOptions()
 	totalVotingPower := math.LegacyMustNewDecFromStr("0.51")
 	// Two members, one guardian, 51% voting power
-	memberPower, guardianPower := calculateVotePower(2, 1, totalVotingPower)
+	memberPower, guardianPower := calculateVotePower(2, 1, 1, totalVotingPower)
 	// Member votes yes
 	addVote(memberResults, govtypes_v1.OptionYes)
 
@@ -61,7 +61,7 @@ func (suite *CalculateCombinedTallyResultsTestSuite) Test_OnlyGuardianVotesCombi
 	guardianResults := NewEmptyVoteOptions()
 	totalVotingPower := math.LegacyMustNewDecFromStr("0.51")
 	// Two members, one guardian, 51% voting power
-	memberPower, guardianPower := calculateVotePower(2, 1, totalVotingPower)
+	memberPower, guardianPower := calculateVotePower(2, 1, 1, totalVotingPower)
 	// Guardian votes yes
 	addVote(guardianResults, govtypes_v1.OptionYes)
 
@@ -84,7 +84,7 @@ func (suite *CalculateCombinedTallyResultsTestSuite) Test_MemberAndGuardianVoteY
 	guardianResults := NewEmptyVoteOptions()
 	totalVotingPower := math.LegacyMustNewDecFromStr("0.51")
 	// Two members, one guardian, 51% voting power
-	memberPower, guardianPower := calculateVotePower(2, 1, totalVotingPower)
+	memberPower, guardianPower := calculateVotePower(2, 1, 1, totalVotingPower)
 	// Guardian votes yes
 	addVote(guardianResults, govtypes_v1.OptionYes)
 	// Member votes yes
@@ -109,7 +109,7 @@ func (suite *CalculateCombinedTallyResultsTestSuite) Test_MemberVotesYesAndGuard
 	guardianResults := NewEmptyVoteOptions()
 	totalVotingPower := math.LegacyMustNewDecFromStr("0.51")
 	// Two members, one guardian, 51% voting power
-	memberPower, guardianPower := calculateVotePower(2, 1, totalVotingPower)
+	memberPower, guardianPower := calculateVotePower(2, 1, 1, totalVotingPower)
 	// Guardian votes yes
 	addVote(guardianResults, govtypes_v1.OptionYes)
 	// Member votes no
@@ -133,7 +133,7 @@ func (suite *CalculateCombinedTallyResultsTestSuite) Test_TwoGuardiansTwoMembers
 	guardianResults := NewEmptyVoteOptions()
 	totalVotingPower := math.LegacyMustNewDecFromStr("0.51")
 	// Two members, one guardian, 51% voting power
-	memberPower, guardianPower := calculateVotePower(4, 2, totalVotingPower)
+	memberPower, guardianPower := calculateVotePower(4, 2, 2, totalVotingPower)
 	// One guardian votes no
 	addVote(guardianResults, govtypes_v1.OptionNo)
 	// Everyone else votes yes
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"testing"
+
+	govtypes_v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type ResolveDelegateTestSuite struct {
+	suite.Suite
+}
+
+// Setup
+func (suite *ResolveDelegateTestSuite) SetupTest() {
+}
+
+// delegations builds the delegations from delegator and delegate pairs
+func delegations(pairs ...string) map[string]types.VoteDelegation {
+	res := make(map[string]types.VoteDelegation)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		res[pairs[i]] = types.VoteDelegation{Delegator: pairs[i], Delegate: pairs[i+1]}
+	}
+	return res
+}
+
+// yesVote returns the options of a vote for yes
+func yesVote() []*govtypes_v1.WeightedVoteOption {
+	return govtypes_v1.NewNonSplitVoteOption(govtypes_v1.OptionYes)
+}
+
+// The delegate's vote is found directly
+func (suite *ResolveDelegateTestSuite) Test_DirectDelegate() {
+	votes := castVotes{"b": yesVote()}
+	delegate, found := resolveDelegate("a", delegations("a", "b"), votes, 3)
+
+	suite.True(found)
+	suite.Equal("b", delegate)
+}
+
+// Delegates who did not vote pass the vote along their own delegation
+func (suite *ResolveDelegateTestSuite) Test_ChainOfDelegates() {
+	votes := castVotes{"d": yesVote()}
+	delegate, found := resolveDelegate("a", delegations("a", "b", "b", "c", "c", "d"), votes, 3)
+
+	suite.True(found)
+	suite.Equal("d", delegate)
+}
+
+// Chains longer than the depth limit are not followed
+func (suite *ResolveDelegateTestSuite) Test_ChainTooLong() {
+	votes := castVotes{"d": yesVote()}
+	_, found := resolveDelegate("a", delegations("a", "b", "b", "c", "c", "d"), votes, 2)
+
+	suite.False(found)
+}
+
+// Cycles never reach a vote
+func (suite *ResolveDelegateTestSuite) Test_Cycle() {
+	votes := castVotes{"d": yesVote()}
+	_, found := resolveDelegate("a", delegations("a", "b", "b", "c", "c", "a"), votes, 10)
+	suite.False(found)
+
+	_, found = resolveDelegate("a", delegations("a", "b", "b", "c", "c", "b"), votes, 10)
+	suite.False(found)
+}
+
+// Chains that end with a member who neither voted nor delegated find nobody
+func (suite *ResolveDelegateTestSuite) Test_NoVote() {
+	_, found := resolveDelegate("a", delegations("a", "b"), castVotes{}, 3)
+
+	suite.False(found)
+}
+
+// The first delegate to vote is found, even if their vote was invalid
+func (suite *ResolveDelegateTestSuite) Test_InvalidVoteStopsTheChain() {
+	votes := castVotes{"b": nil, "c": yesVote()}
+	delegate, found := resolveDelegate("a", delegations("a", "b", "b", "c"), votes, 3)
+
+	suite.True(found)
+	suite.Equal("b", delegate)
+	suite.Nil(votes[delegate])
+}
+
+// Run test suite
+func TestResolveDelegateTestSuite(t *testing.T) {
+	suite.Run(t, new(ResolveDelegateTestSuite))
+}
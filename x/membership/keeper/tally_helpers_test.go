@@ -5,7 +5,6 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes_v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/noria-net/module-membership/x/membership/types"
 )
@@ -41,15 +40,13 @@ func isOnlyOneOptionSelected(voteOptions voteOptions, option govtypes_v1.VoteOpt
 		}
 	}
 	// Now check the option we are interested in is equal to 1
-	return voteOptions[option].Equal(math.NewInt(1))
+	return voteOptions[option].Equal(math.LegacyOneDec())
 }
 
-// createMember creates a member with the given address
+// createMember creates an electorate member with the given address
 func createMember(address string) *types.Member {
-	baseAccount := authtypes.NewBaseAccountWithAddress(sdk.AccAddress(address))
-	member := types.NewMemberAccountWithDefaultMemberStatus(
-		baseAccount,
-	)
+	member := types.NewMemberWithDefaultMemberStatus(sdk.AccAddress(address))
+	member.Status = types.MembershipStatus_MemberElectorate
 	return member
 }
 
@@ -75,11 +72,11 @@ func mustCreateProposal(proposalId uint64, proposor string) *govtypes_v1.Proposa
 
 // addVote adds a vote to the vote options
 func addVote(voteOptions voteOptions, option govtypes_v1.VoteOption) {
-	voteOptions[option] = voteOptions[option].Add(sdk.NewInt(1))
+	voteOptions[option] = voteOptions[option].Add(sdk.OneDec())
 }
 
 // printVoteOptionsToConsole prints the vote options to the console
-func printVoteOptionsToConsole(results voteOptions) {
+func printVoteOptionsToConsole(results integerVoteOptions) {
 	for option, value := range results {
 		println(option.String(), value.String())
 	}
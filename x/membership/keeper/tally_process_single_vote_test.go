@@ -26,7 +26,7 @@ func (suite *ProcessSingleVoteTestSuite) Test_VoterIsNotDenomMember() {
 	guardianResults := NewEmptyVoteOptions()
 	option := govtypes_v1.NewWeightedVoteOption(govtypes_v1.OptionYes, sdk.NewDec(1))
 	vote := govtypes_v1.NewVote(1, member.GetAddress(), govtypes_v1.WeightedVoteOptions{option}, "")
-	err := processSingleVote(vote, member, false, memberResults, guardianResults)
+	err := processSingleVote(vote, member, false, types.DefaultGuardianWeight, false, memberResults, guardianResults)
 
 	// Verify that an error is returned and that the vote is not counted
 	suite.Assert().ErrorContains(err, "voter is not a member of the electorate")
@@ -57,7 +57,7 @@ func (suite *ProcessSingleVoteTestSuite) Test_VoterIsNotEligibleToVote() {
 		vote = govtypes_v1.NewVote(1, member.GetAddress(), govtypes_v1.WeightedVoteOptions{option}, "")
 		member.Status = types.MembershipStatus(status)
 
-		err := processSingleVote(vote, member, true, memberResults, guardianResults)
+		err := processSingleVote(vote, member, true, types.DefaultGuardianWeight, false, memberResults, guardianResults)
 
 		// Verify that an error is returned and that the vote is not counted
 		suite.Assert().ErrorContains(err, "member is not eligible to vote")
@@ -77,7 +77,7 @@ func (suite *ProcessSingleVoteTestSuite) Test_VotingWeightIsInvalid() {
 		member.GetAddress(),
 		govtypes_v1.WeightedVoteOptions{option_yes, option_no},
 		"")
-	err := processSingleVote(vote, member, true, memberResults, guardianResults)
+	err := processSingleVote(vote, member, true, types.DefaultGuardianWeight, false, memberResults, guardianResults)
 
 	// Verify that an error is returned and that the vote is not counted
 	suite.Assert().ErrorContains(err, "invalid voting weight")
@@ -92,7 +92,7 @@ func (suite *ProcessSingleVoteTestSuite) Test_ValidVoteIsProcessed() {
 	guardianResults := NewEmptyVoteOptions()
 	option := govtypes_v1.NewWeightedVoteOption(govtypes_v1.OptionYes, sdk.NewDec(1))
 	vote := govtypes_v1.NewVote(1, member.GetAddress(), govtypes_v1.WeightedVoteOptions{option}, "")
-	err := processSingleVote(vote, member, true, memberResults, guardianResults)
+	err := processSingleVote(vote, member, true, types.DefaultGuardianWeight, false, memberResults, guardianResults)
 
 	// Verify that an error is returned and that the vote is not counted
 	suite.Assert().NoError(err)
@@ -100,6 +100,92 @@ func (suite *ProcessSingleVoteTestSuite) Test_ValidVoteIsProcessed() {
 	suite.Assert().True(areAllOptionsZero(guardianResults))
 }
 
+// Guardian votes are counted by the guardian's weight
+func (suite *ProcessSingleVoteTestSuite) Test_GuardianVoteIsCountedByWeight() {
+	member := createMember(address_1)
+	member.IsGuardian = true
+	memberResults := NewEmptyVoteOptions()
+	guardianResults := NewEmptyVoteOptions()
+	option := govtypes_v1.NewWeightedVoteOption(govtypes_v1.OptionNo, sdk.NewDec(1))
+	vote := govtypes_v1.NewVote(1, member.GetAddress(), govtypes_v1.WeightedVoteOptions{option}, "")
+	err := processSingleVote(vote, member, true, 3, false, memberResults, guardianResults)
+
+	suite.Assert().NoError(err)
+	suite.Assert().True(areAllOptionsZero(memberResults))
+	suite.Assert().Equal(sdk.NewDec(3), guardianResults[govtypes_v1.OptionNo])
+}
+
+// Split votes are rejected unless they are allowed
+func (suite *ProcessSingleVoteTestSuite) Test_SplitVoteIsRejectedWhenNotAllowed() {
+	member := createMember(address_1)
+	memberResults := NewEmptyVoteOptions()
+	guardianResults := NewEmptyVoteOptions()
+	vote := govtypes_v1.NewVote(1, member.GetAddress(), govtypes_v1.WeightedVoteOptions{
+		govtypes_v1.NewWeightedVoteOption(govtypes_v1.OptionYes, sdk.MustNewDecFromStr("0.6")),
+		govtypes_v1.NewWeightedVoteOption(govtypes_v1.OptionNo, sdk.MustNewDecFromStr("0.4")),
+	}, "")
+	err := processSingleVote(vote, member, true, types.DefaultGuardianWeight, false, memberResults, guardianResults)
+
+	suite.Assert().ErrorContains(err, "invalid voting weight")
+	suite.Assert().True(areAllOptionsZero(memberResults))
+	suite.Assert().True(areAllOptionsZero(guardianResults))
+}
+
+// Split votes count a fraction of the voter's weight towards each option
+func (suite *ProcessSingleVoteTestSuite) Test_SplitVoteIsCountedFractionally() {
+	member := createMember(address_1)
+	memberResults := NewEmptyVoteOptions()
+	guardianResults := NewEmptyVoteOptions()
+	vote := govtypes_v1.NewVote(1, member.GetAddress(), govtypes_v1.WeightedVoteOptions{
+		govtypes_v1.NewWeightedVoteOption(govtypes_v1.OptionYes, sdk.MustNewDecFromStr("0.6")),
+		govtypes_v1.NewWeightedVoteOption(govtypes_v1.OptionNo, sdk.MustNewDecFromStr("0.4")),
+	}, "")
+	err := processSingleVote(vote, member, true, types.DefaultGuardianWeight, true, memberResults, guardianResults)
+
+	suite.Assert().NoError(err)
+	suite.Assert().Equal(sdk.MustNewDecFromStr("0.6"), memberResults[govtypes_v1.OptionYes])
+	suite.Assert().Equal(sdk.MustNewDecFromStr("0.4"), memberResults[govtypes_v1.OptionNo])
+	suite.Assert().True(areAllOptionsZero(guardianResults))
+
+	// Guardians split their weight
+	member.IsGuardian = true
+	err = processSingleVote(vote, member, true, 3, true, memberResults, guardianResults)
+
+	suite.Assert().NoError(err)
+	suite.Assert().Equal(sdk.MustNewDecFromStr("1.8"), guardianResults[govtypes_v1.OptionYes])
+	suite.Assert().Equal(sdk.MustNewDecFromStr("1.2"), guardianResults[govtypes_v1.OptionNo])
+}
+
+// Split votes must still add up to a single vote
+func (suite *ProcessSingleVoteTestSuite) Test_SplitVoteMustAddUpToOne() {
+	member := createMember(address_1)
+	memberResults := NewEmptyVoteOptions()
+	guardianResults := NewEmptyVoteOptions()
+	vote := govtypes_v1.NewVote(1, member.GetAddress(), govtypes_v1.WeightedVoteOptions{
+		govtypes_v1.NewWeightedVoteOption(govtypes_v1.OptionYes, sdk.MustNewDecFromStr("0.6")),
+		govtypes_v1.NewWeightedVoteOption(govtypes_v1.OptionNo, sdk.MustNewDecFromStr("0.6")),
+	}, "")
+	err := processSingleVote(vote, member, true, types.DefaultGuardianWeight, true, memberResults, guardianResults)
+
+	suite.Assert().ErrorContains(err, "invalid voting weight")
+	suite.Assert().True(areAllOptionsZero(memberResults))
+}
+
+// A zero weighted option before the chosen one does not spoil the vote
+func (suite *ProcessSingleVoteTestSuite) Test_ZeroWeightedOptionIsIgnored() {
+	member := createMember(address_1)
+	memberResults := NewEmptyVoteOptions()
+	guardianResults := NewEmptyVoteOptions()
+	vote := govtypes_v1.NewVote(1, member.GetAddress(), govtypes_v1.WeightedVoteOptions{
+		govtypes_v1.NewWeightedVoteOption(govtypes_v1.OptionNo, sdk.ZeroDec()),
+		govtypes_v1.NewWeightedVoteOption(govtypes_v1.OptionYes, sdk.OneDec()),
+	}, "")
+	err := processSingleVote(vote, member, true, types.DefaultGuardianWeight, false, memberResults, guardianResults)
+
+	suite.Assert().NoError(err)
+	suite.Assert().True(isOnlyOneOptionSelected(memberResults, govtypes_v1.OptionYes))
+}
+
 // Run test suite
 func TestProcessSingleVoteTestSuite(t *testing.T) {
 	suite.Run(t, new(ProcessSingleVoteTestSuite))
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes_v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+// GetTallyRule resolves the quorum, threshold and veto threshold that apply to
+// the proposal, along with the message types that have a rule of their own
+func (k Keeper) GetTallyRule(ctx sdk.Context, proposal govtypes_v1.Proposal) (rule types.TallyRule, matched []string) {
+	return resolveTallyRule(k.TallyRules(ctx), proposal, k.GetGovParams(ctx))
+}
+
+// resolveTallyRule holds each of the proposal's messages to the rule for its
+// type, or otherwise to the gov params, and combines them into the strictest
+// rule. Proposals without messages are held to the gov params.
+func resolveTallyRule(rules []types.TallyRule, proposal govtypes_v1.Proposal, govParams govtypes_v1.Params) (rule types.TallyRule, matched []string) {
+	byType := make(map[string]types.TallyRule, len(rules))
+	for _, r := range rules {
+		byType[r.MsgTypeUrl] = r
+	}
+
+	defaultRule := types.NewTallyRuleFromGovParams(govParams)
+	for i, msg := range proposal.Messages {
+		msgRule := defaultRule
+		// The content of a legacy proposal takes precedence over its wrapper
+		for _, msgType := range msgTypeURLs(msg) {
+			if r, found := byType[msgType]; found {
+				msgRule = r
+				if !containsString(matched, msgType) {
+					matched = append(matched, msgType)
+				}
+				break
+			}
+		}
+
+		if i == 0 {
+			rule = msgRule
+		} else {
+			rule = rule.Strictest(msgRule)
+		}
+	}
+
+	if len(proposal.Messages) == 0 {
+		rule = defaultRule
+	}
+	rule.MsgTypeUrl = ""
+
+	return rule, matched
+}
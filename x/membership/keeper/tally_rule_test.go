@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes_v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveTallyRule(t *testing.T) {
+	period := 30 * time.Second
+	govParams := govtypes_v1.NewParams(sdk.NewCoins(), period, period, "0.334", "0.5", "0.334", "0", false, false, false)
+
+	supermajority := types.TallyRule{
+		MsgTypeUrl:    "/membershipmodule.membership.AddGuardiansProposal",
+		Quorum:        sdk.MustNewDecFromStr("0.2"),
+		Threshold:     sdk.MustNewDecFromStr("0.667"),
+		VetoThreshold: sdk.MustNewDecFromStr("0.334"),
+	}
+	rules := []types.TallyRule{supermajority}
+
+	content, err := codectypes.NewAnyWithValue(&types.AddGuardiansProposal{Title: "title"})
+	require.NoError(t, err)
+	addGuardians, err := codectypes.NewAnyWithValue(&govtypes_v1.MsgExecLegacyContent{Content: content})
+	require.NoError(t, err)
+	send, err := codectypes.NewAnyWithValue(&banktypes.MsgSend{})
+	require.NoError(t, err)
+
+	// Proposals without messages are held to the gov params
+	rule, matched := resolveTallyRule(rules, govtypes_v1.Proposal{}, govParams)
+	require.Equal(t, types.NewTallyRuleFromGovParams(govParams), rule)
+	require.Empty(t, matched)
+
+	// A legacy proposal is held to the rule for its content
+	rule, matched = resolveTallyRule(rules, govtypes_v1.Proposal{Messages: []*codectypes.Any{addGuardians}}, govParams)
+	require.Equal(t, sdk.MustNewDecFromStr("0.2"), rule.Quorum)
+	require.Equal(t, sdk.MustNewDecFromStr("0.667"), rule.Threshold)
+	require.Empty(t, rule.MsgTypeUrl)
+	require.Equal(t, []string{supermajority.MsgTypeUrl}, matched)
+
+	// Mixing in a message without a rule raises the quorum to the gov params
+	rule, _ = resolveTallyRule(rules, govtypes_v1.Proposal{Messages: []*codectypes.Any{addGuardians, send}}, govParams)
+	require.Equal(t, sdk.MustNewDecFromStr("0.334"), rule.Quorum)
+	require.Equal(t, sdk.MustNewDecFromStr("0.667"), rule.Threshold)
+	require.Equal(t, sdk.MustNewDecFromStr("0.334"), rule.VetoThreshold)
+
+	// The rule is applied to the gov params used for tallying
+	applied := rule.ApplyTo(govParams)
+	require.Equal(t, "0.667000000000000000", applied.Threshold)
+	require.Equal(t, govParams.MinDeposit, applied.MinDeposit)
+}
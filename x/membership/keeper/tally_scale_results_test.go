@@ -20,7 +20,7 @@ func (suite *ScaleTallyResultsToIntegerMapTestSuite) Test_EveryoneVotesYes() {
 	guardianResults := NewEmptyVoteOptions()
 	totalVotingPower := math.LegacyMustNewDecFromStr("0.51")
 	// Two members, one guardian, 51% voting power
-	memberPower, guardianPower := calculateVotePower(2, 1, totalVotingPower)
+	memberPower, guardianPower := calculateVotePower(2, 1, 1, totalVotingPower)
 	// Guardian votes yes
 	addVote(guardianResults, govtypes_v1.OptionYes)
 	// Member votes yes
@@ -43,7 +43,7 @@ func (suite *ScaleTallyResultsToIntegerMapTestSuite) Test_GuardianVotesYesMember
 	guardianResults := NewEmptyVoteOptions()
 	totalVotingPower := math.LegacyMustNewDecFromStr("0.51")
 	// Two members, one guardian, 51% voting power
-	memberPower, guardianPower := calculateVotePower(2, 1, totalVotingPower)
+	memberPower, guardianPower := calculateVotePower(2, 1, 1, totalVotingPower)
 	// Guardian votes yes
 	addVote(guardianResults, govtypes_v1.OptionYes)
 	// Member votes yes
@@ -60,6 +60,28 @@ func (suite *ScaleTallyResultsToIntegerMapTestSuite) Test_GuardianVotesYesMember
 	suite.Equal(int64(0), scaled[govtypes_v1.OptionNoWithVeto].Int64())
 }
 
+func (suite *ScaleTallyResultsToIntegerMapTestSuite) Test_FractionalVoteCounts() {
+	memberResults := NewEmptyVoteOptions()
+	guardianResults := NewEmptyVoteOptions()
+	totalVotingPower := math.LegacyMustNewDecFromStr("0.5")
+	// Two members, one guardian, 50% voting power
+	memberPower, guardianPower := calculateVotePower(2, 1, 1, totalVotingPower)
+	// Guardian splits their vote between yes and no
+	guardianResults[govtypes_v1.OptionYes] = math.LegacyMustNewDecFromStr("0.25")
+	guardianResults[govtypes_v1.OptionNo] = math.LegacyMustNewDecFromStr("0.75")
+	// Member votes yes
+	addVote(memberResults, govtypes_v1.OptionYes)
+
+	// Execute test
+	combined := calculateCombinedTallyResults(memberResults, guardianResults, memberPower, guardianPower)
+	scaled := scaleTallyResultsToIntegerMap(combined.results)
+
+	suite.Equal(int64(625), scaled[govtypes_v1.OptionYes].Int64())
+	suite.Equal(int64(375), scaled[govtypes_v1.OptionNo].Int64())
+	suite.Equal(int64(0), scaled[govtypes_v1.OptionAbstain].Int64())
+	suite.Equal(int64(0), scaled[govtypes_v1.OptionNoWithVeto].Int64())
+}
+
 func TestScaleTallyResultsToIntegerMapTestSuite(t *testing.T) {
 	suite.Run(t, new(ScaleTallyResultsToIntegerMapTestSuite))
 }
@@ -54,7 +54,7 @@ func (suite *CalculateVoteResultsTestSuite) Test_MajorityVotesYesAndProposalPass
 	memberResults := NewEmptyVoteOptions()
 	guardianResults := NewEmptyVoteOptions()
 	totalVotingWeight := math.LegacyMustNewDecFromStr("0.51")
-	memberPower, guardianPower := calculateVotePower(2, 1, totalVotingWeight)
+	memberPower, guardianPower := calculateVotePower(2, 1, 1, totalVotingWeight)
 
 	// Everyone votes yes
 	addVote(memberResults, govtypes_v1.OptionYes)
@@ -77,7 +77,7 @@ func (suite *CalculateVoteResultsTestSuite) Test_GuardiansVoteYesMembersVoteNoAn
 	memberResults := NewEmptyVoteOptions()
 	guardianResults := NewEmptyVoteOptions()
 	totalVotingWeight := math.LegacyMustNewDecFromStr("0.51")
-	memberPower, guardianPower := calculateVotePower(2, 1, totalVotingWeight)
+	memberPower, guardianPower := calculateVotePower(2, 1, 1, totalVotingWeight)
 
 	addVote(memberResults, govtypes_v1.OptionNo)
 	addVote(guardianResults, govtypes_v1.OptionYes)
@@ -99,7 +99,7 @@ func (suite *CalculateVoteResultsTestSuite) Test_GuardiansVoteNoMembersVoteYesAn
 	memberResults := NewEmptyVoteOptions()
 	guardianResults := NewEmptyVoteOptions()
 	totalVotingWeight := math.LegacyMustNewDecFromStr("0.51")
-	memberPower, guardianPower := calculateVotePower(2, 1, totalVotingWeight)
+	memberPower, guardianPower := calculateVotePower(2, 1, 1, totalVotingWeight)
 
 	addVote(memberResults, govtypes_v1.OptionYes)
 	addVote(guardianResults, govtypes_v1.OptionNo)
@@ -120,7 +120,7 @@ func (suite *CalculateVoteResultsTestSuite) Test_AllMembersAreGuardiansAndAllVot
 	memberResults := NewEmptyVoteOptions()
 	guardianResults := NewEmptyVoteOptions()
 	totalVotingWeight := math.LegacyMustNewDecFromStr("0.51")
-	memberPower, guardianPower := calculateVotePower(2, 2, totalVotingWeight)
+	memberPower, guardianPower := calculateVotePower(2, 2, 2, totalVotingWeight)
 
 	// Both guardians vote yes
 	addVote(guardianResults, govtypes_v1.OptionYes)
@@ -133,7 +133,7 @@ func (suite *CalculateVoteResultsTestSuite) Test_AllMembersAreGuardiansAndAllVot
 		memberPower,
 		guardianPower)
 
-	suite.Assert().False(passes)
+	suite.Assert().True(passes)
 	suite.Assert().False(burnDeposits)
 	suite.Assert().Equal("51", tallyResults.GetYesCount())
 }
@@ -143,7 +143,7 @@ func (suite *CalculateVoteResultsTestSuite) Test_NoVotesAreCastAndProposalFails(
 	memberResults := NewEmptyVoteOptions()
 	guardianResults := NewEmptyVoteOptions()
 	totalVotingWeight := math.LegacyMustNewDecFromStr("0.51")
-	memberPower, guardianPower := calculateVotePower(2, 1, totalVotingWeight)
+	memberPower, guardianPower := calculateVotePower(2, 1, 1, totalVotingWeight)
 
 	passes, burnDeposits, tallyResults := calculateVoteResults(*suite.proposal,
 		suite.govParams,
@@ -162,7 +162,7 @@ func (suite *CalculateVoteResultsTestSuite) Test_ThresholdNotMetAndProposalFails
 	memberResults := NewEmptyVoteOptions()
 	guardianResults := NewEmptyVoteOptions()
 	totalVotingWeight := math.LegacyMustNewDecFromStr("0.51")
-	memberPower, guardianPower := calculateVotePower(4, 2, totalVotingWeight)
+	memberPower, guardianPower := calculateVotePower(4, 2, 2, totalVotingWeight)
 
 	// One Guardian votes Yes
 	addVote(guardianResults, govtypes_v1.OptionYes)
@@ -188,7 +188,7 @@ func (suite *CalculateVoteResultsTestSuite) Test_VetoThresholdExceededAndProposa
 	memberResults := NewEmptyVoteOptions()
 	guardianResults := NewEmptyVoteOptions()
 	totalVotingWeight := math.LegacyMustNewDecFromStr("0.51")
-	memberPower, guardianPower := calculateVotePower(4, 2, totalVotingWeight)
+	memberPower, guardianPower := calculateVotePower(4, 2, 2, totalVotingWeight)
 
 	// A guardian and a member Vetos
 	addVote(guardianResults, govtypes_v1.OptionNoWithVeto)
@@ -216,7 +216,7 @@ func (suite *CalculateVoteResultsTestSuite) Test_AllVotersAbstain() {
 	memberResults := NewEmptyVoteOptions()
 	guardianResults := NewEmptyVoteOptions()
 	totalVotingWeight := math.LegacyMustNewDecFromStr("0.51")
-	memberPower, guardianPower := calculateVotePower(4, 2, totalVotingWeight)
+	memberPower, guardianPower := calculateVotePower(4, 2, 2, totalVotingWeight)
 
 	// Everyone votes to abstain
 	addVote(guardianResults, govtypes_v1.OptionAbstain)
@@ -241,10 +241,9 @@ func (suite *CalculateVoteResultsTestSuite) Test_OnlyMembersVoteAndQuorumIsNotRe
 	memberResults := NewEmptyVoteOptions()
 	guardianResults := NewEmptyVoteOptions()
 	totalVotingWeight := math.LegacyMustNewDecFromStr("0.51")
-	memberPower, guardianPower := calculateVotePower(4, 2, totalVotingWeight)
+	memberPower, guardianPower := calculateVotePower(4, 2, 2, totalVotingWeight)
 
-	// All members vote Yes
-	addVote(memberResults, govtypes_v1.OptionYes)
+	// One member votes Yes
 	addVote(memberResults, govtypes_v1.OptionYes)
 
 	passes, burnDeposits, tallyResults := calculateVoteResults(*suite.proposal,
@@ -256,7 +255,126 @@ func (suite *CalculateVoteResultsTestSuite) Test_OnlyMembersVoteAndQuorumIsNotRe
 
 	suite.Assert().False(passes)
 	suite.Assert().Equal(burnDeposits, suite.govParams.BurnVoteQuorum)
-	suite.Assert().Equal("49", tallyResults.GetYesCount())
+	suite.Assert().Equal("245", tallyResults.GetYesCount())
+}
+
+// Test Case: No guardians, members share all of the voting power
+func (suite *CalculateVoteResultsTestSuite) Test_NoGuardiansMembersShareAllVotingPower() {
+	memberResults := NewEmptyVoteOptions()
+	guardianResults := NewEmptyVoteOptions()
+	totalVotingWeight := math.LegacyMustNewDecFromStr("0.51")
+	memberPower, guardianPower := calculateVotePower(4, 0, 0, totalVotingWeight)
+
+	suite.Assert().Equal(math.LegacyMustNewDecFromStr("0.25"), memberPower)
+	suite.Assert().Equal(math.LegacyZeroDec(), guardianPower)
+
+	// Three of the four members vote Yes
+	addVote(memberResults, govtypes_v1.OptionYes)
+	addVote(memberResults, govtypes_v1.OptionYes)
+	addVote(memberResults, govtypes_v1.OptionYes)
+	addVote(memberResults, govtypes_v1.OptionNo)
+
+	passes, burnDeposits, tallyResults := calculateVoteResults(*suite.proposal,
+		suite.govParams,
+		memberResults,
+		guardianResults,
+		memberPower,
+		guardianPower)
+
+	suite.Assert().True(passes)
+	suite.Assert().False(burnDeposits)
+	suite.Assert().Equal("75", tallyResults.GetYesCount())
+	suite.Assert().Equal("25", tallyResults.GetNoCount())
+}
+
+// Test Case: No guardians, members veto and proposal fails
+func (suite *CalculateVoteResultsTestSuite) Test_NoGuardiansMembersVetoAndProposalFails() {
+	memberResults := NewEmptyVoteOptions()
+	guardianResults := NewEmptyVoteOptions()
+	memberPower, guardianPower := calculateVotePower(3, 0, 0, math.LegacyZeroDec())
+
+	addVote(memberResults, govtypes_v1.OptionYes)
+	addVote(memberResults, govtypes_v1.OptionNoWithVeto)
+	addVote(memberResults, govtypes_v1.OptionAbstain)
+
+	passes, burnDeposits, _ := calculateVoteResults(*suite.proposal,
+		suite.govParams,
+		memberResults,
+		guardianResults,
+		memberPower,
+		guardianPower)
+
+	suite.Assert().False(passes)
+	suite.Assert().Equal(burnDeposits, suite.govParams.BurnVoteVeto)
+}
+
+// Test Case: A heavier guardian outvotes a lighter one
+func (suite *CalculateVoteResultsTestSuite) Test_WeightedGuardiansVote() {
+	memberResults := NewEmptyVoteOptions()
+	guardianResults := NewEmptyVoteOptions()
+	totalVotingWeight := math.LegacyMustNewDecFromStr("0.5")
+	// Two members, and two guardians with weights of 3 and 1
+	memberPower, guardianPower := calculateVotePower(4, 2, 4, totalVotingWeight)
+
+	suite.Assert().Equal(math.LegacyMustNewDecFromStr("0.125"), guardianPower)
+	suite.Assert().Equal(math.LegacyMustNewDecFromStr("0.25"), memberPower)
+
+	// The founding guardian votes Yes, the newer guardian votes No
+	guardianResults[govtypes_v1.OptionYes] = math.LegacyNewDec(3)
+	guardianResults[govtypes_v1.OptionNo] = math.LegacyNewDec(1)
+
+	passes, burnDeposits, tallyResults := calculateVoteResults(*suite.proposal,
+		suite.govParams,
+		memberResults,
+		guardianResults,
+		memberPower,
+		guardianPower)
+
+	suite.Assert().True(passes)
+	suite.Assert().False(burnDeposits)
+	// The guardians hold no more than the total voting weight between them
+	suite.Assert().Equal("375", tallyResults.GetYesCount())
+	suite.Assert().Equal("125", tallyResults.GetNoCount())
+}
+
+// Test Case: The outcome does not change as the total voting weight decays to
+// zero, when guardians start voting as members
+func (suite *CalculateVoteResultsTestSuite) Test_VotingWeightDecaysToZero() {
+	for _, weight := range []string{"0.51", "0.1", "0"} {
+		memberResults := NewEmptyVoteOptions()
+		guardianResults := NewEmptyVoteOptions()
+		totalVotingWeight := math.LegacyMustNewDecFromStr(weight)
+		memberPower, guardianPower := calculateVotePower(4, 2, 2, totalVotingWeight)
+
+		// Both guardians and a member vote Yes, the other member votes No
+		guardianVotes := guardianResults
+		if totalVotingWeight.IsZero() {
+			suite.Assert().Equal(math.LegacyMustNewDecFromStr("0.25"), memberPower)
+			suite.Assert().Equal(math.LegacyZeroDec(), guardianPower)
+			guardianVotes = memberResults
+		}
+		addVote(guardianVotes, govtypes_v1.OptionYes)
+		addVote(guardianVotes, govtypes_v1.OptionYes)
+		addVote(memberResults, govtypes_v1.OptionYes)
+		addVote(memberResults, govtypes_v1.OptionNo)
+
+		passes, _, _ := calculateVoteResults(*suite.proposal,
+			suite.govParams,
+			memberResults,
+			guardianResults,
+			memberPower,
+			guardianPower)
+
+		suite.Assert().True(passes, "total voting weight %s", weight)
+	}
+}
+
+// Test Case: No guardians and no members, nobody has any voting power
+func (suite *CalculateVoteResultsTestSuite) Test_NoGuardiansAndNoMembers() {
+	memberPower, guardianPower := calculateVotePower(0, 0, 0, math.LegacyMustNewDecFromStr("0.51"))
+
+	suite.Assert().Equal(math.LegacyZeroDec(), memberPower)
+	suite.Assert().Equal(math.LegacyZeroDec(), guardianPower)
 }
 
 // Run test suite
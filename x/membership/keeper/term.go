@@ -0,0 +1,197 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+// GetGuardianTerm fetches the current or most recent term of the given guardian
+func (k Keeper) GetGuardianTerm(ctx sdk.Context, guardian sdk.AccAddress) (types.GuardianTerm, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	var term types.GuardianTerm
+
+	bz := store.Get(types.GuardianTermKey(guardian))
+	if bz == nil {
+		return term, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &term)
+	return term, true
+}
+
+// nextConsecutiveTerms returns the number of consecutive terms the member
+// would have served if a new term began now. A term is consecutive to the
+// previous one unless the member has sat out for at least one term length.
+func (k Keeper) nextConsecutiveTerms(ctx sdk.Context, member sdk.AccAddress) uint64 {
+	length := k.GuardianTermLength(ctx)
+	prev, found := k.GetGuardianTerm(ctx, member)
+	if !found || length == 0 || prev.EndTime.IsZero() {
+		return 1
+	}
+	if ctx.BlockTime().Before(prev.EndTime.Add(length)) {
+		return prev.ConsecutiveTerms + 1
+	}
+	return 1
+}
+
+// checkTermLimit returns an error if a new term would exceed the maximum
+// number of consecutive terms
+func (k Keeper) checkTermLimit(ctx sdk.Context, member sdk.AccAddress) error {
+	limit := k.MaxConsecutiveTerms(ctx)
+	if limit > 0 && k.nextConsecutiveTerms(ctx, member) > limit {
+		return errors.Wrapf(types.ErrTermLimitReached, "%s has served %d consecutive terms", member.String(), limit)
+	}
+	return nil
+}
+
+// startGuardianTerm begins a new term for the guardian, replacing any term
+// they are currently serving
+func (k Keeper) startGuardianTerm(ctx sdk.Context, guardian sdk.AccAddress) error {
+	if err := k.checkTermLimit(ctx, guardian); err != nil {
+		return err
+	}
+
+	term := types.GuardianTerm{
+		Guardian:         guardian.String(),
+		StartTime:        ctx.BlockTime(),
+		ConsecutiveTerms: k.nextConsecutiveTerms(ctx, guardian),
+	}
+	if length := k.GuardianTermLength(ctx); length > 0 {
+		term.EndTime = ctx.BlockTime().Add(length)
+	}
+
+	k.setActiveGuardianTerm(ctx, term)
+	return nil
+}
+
+// setActiveGuardianTerm saves a term and queues it to expire, replacing the
+// guardian's previous term
+func (k Keeper) setActiveGuardianTerm(ctx sdk.Context, term types.GuardianTerm) {
+	guardian := sdk.MustAccAddressFromBech32(term.Guardian)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+
+	if prev, found := k.GetGuardianTerm(ctx, guardian); found && !prev.EndTime.IsZero() {
+		store.Delete(types.GuardianTermQueueKey(prev.EndTime, guardian))
+	}
+
+	store.Set(types.GuardianTermKey(guardian), k.cdc.MustMarshal(&term))
+	if !term.EndTime.IsZero() {
+		store.Set(types.GuardianTermQueueKey(term.EndTime, guardian), guardian)
+	}
+
+	ctx.EventManager().EmitTypedEvent(
+		&types.EventGuardianTermStarted{
+			Guardian:         term.Guardian,
+			EndTime:          term.EndTime,
+			ConsecutiveTerms: term.ConsecutiveTerms,
+		},
+	)
+}
+
+// endGuardianTerm ends the guardian's current term, and keeps it as their
+// most recent term
+func (k Keeper) endGuardianTerm(ctx sdk.Context, guardian sdk.AccAddress) {
+	term, found := k.GetGuardianTerm(ctx, guardian)
+	if !found {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	if !term.EndTime.IsZero() {
+		store.Delete(types.GuardianTermQueueKey(term.EndTime, guardian))
+	}
+
+	// The term was cut short
+	if term.EndTime.IsZero() || term.EndTime.After(ctx.BlockTime()) {
+		term.EndTime = ctx.BlockTime()
+	}
+	store.Set(types.GuardianTermKey(guardian), k.cdc.MustMarshal(&term))
+}
+
+// StaggerGuardianTerms begins new terms for the given guardians that expire
+// at evenly spaced intervals over one term length, so that they are not all
+// replaced at once
+func (k Keeper) StaggerGuardianTerms(ctx sdk.Context, guardians []sdk.AccAddress) {
+	length := k.GuardianTermLength(ctx)
+	if length == 0 || len(guardians) == 0 {
+		return
+	}
+
+	interval := int64(length) / int64(len(guardians))
+	for i, guardian := range guardians {
+		term := types.GuardianTerm{
+			Guardian:         guardian.String(),
+			StartTime:        ctx.BlockTime(),
+			EndTime:          ctx.BlockTime().Add(time.Duration(interval * int64(i+1))),
+			ConsecutiveTerms: 1,
+		}
+		if prev, found := k.GetGuardianTerm(ctx, guardian); found && prev.ConsecutiveTerms > 0 {
+			term.ConsecutiveTerms = prev.ConsecutiveTerms
+		}
+		k.setActiveGuardianTerm(ctx, term)
+	}
+}
+
+// ExpireGuardianTerms revokes the guardianship of every guardian whose term
+// has ended. When term limits are enabled, guardians serving without an end
+// date are given staggered terms.
+func (k Keeper) ExpireGuardianTerms(ctx sdk.Context) {
+	var expired []sdk.AccAddress
+	k.IterateGuardianTermQueue(ctx, ctx.BlockTime(), func(guardian sdk.AccAddress) (stop bool) {
+		expired = append(expired, guardian)
+		return false
+	})
+
+	// Revoke outside of the iterator, as revoking mutates the queue
+	for _, guardian := range expired {
+		if err := k.RevokeGuardianship(ctx, guardian); err != nil {
+			k.Logger(ctx).Error("failed to revoke guardianship", "guardian", guardian.String(), "error", err)
+		}
+		k.endGuardianTerm(ctx, guardian)
+
+		ctx.EventManager().EmitTypedEvent(
+			&types.EventGuardianTermExpired{
+				Guardian: guardian.String(),
+			},
+		)
+	}
+
+	if k.GuardianTermLength(ctx) == 0 {
+		return
+	}
+
+	dd := k.GetDirectDemocracySettings(ctx)
+	if dd == nil {
+		return
+	}
+
+	var unscheduled []sdk.AccAddress
+	for _, address := range dd.Guardians {
+		guardian := sdk.MustAccAddressFromBech32(address)
+		if !k.IsGuardian(ctx, guardian) {
+			continue
+		}
+		if term, found := k.GetGuardianTerm(ctx, guardian); !found || term.EndTime.IsZero() {
+			unscheduled = append(unscheduled, guardian)
+		}
+	}
+	k.StaggerGuardianTerms(ctx, unscheduled)
+}
+
+// IterateGuardianTermQueue iterates over the guardians whose terms end at or
+// before endTime and performs a callback function
+func (k Keeper) IterateGuardianTermQueue(ctx sdk.Context, endTime time.Time, cb func(guardian sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.GuardianTermQueueKeyPrefix, sdk.PrefixEndBytes(types.GuardianTermQueueByTimeKey(endTime)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(iterator.Value()) {
+			break
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	testkeeper "github.com/noria-net/module-membership/testutil/keeper"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGuardianTermLimits(t *testing.T) {
+	k, ctx := testkeeper.MembershipKeeper(t)
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+
+	params := types.DefaultParams()
+	params.GuardianTermLength = time.Hour
+	params.MaxConsecutiveTerms = 2
+	k.SetParams(ctx, params)
+
+	guardian := setupGuardian(t, k, ctx)
+	dd := types.DefaultDirectDemocracy()
+	dd.Guardians = []string{guardian.String()}
+	k.SetDirectDemocracySettings(ctx, &dd)
+
+	term, found := k.GetGuardianTerm(ctx, guardian)
+	require.True(t, found)
+	require.Equal(t, now.Add(time.Hour), term.EndTime)
+	require.Equal(t, uint64(1), term.ConsecutiveTerms)
+
+	// The term has not expired yet
+	ctx = ctx.WithBlockTime(now.Add(59 * time.Minute))
+	k.ExpireGuardianTerms(ctx)
+	require.True(t, k.IsGuardian(ctx, guardian))
+
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	k.ExpireGuardianTerms(ctx)
+	require.False(t, k.IsGuardian(ctx, guardian))
+	require.Empty(t, k.GetDirectDemocracySettings(ctx).Guardians)
+
+	// Returning within one term length counts as a consecutive term
+	ctx = ctx.WithBlockTime(now.Add(90 * time.Minute))
+	require.NoError(t, k.SetMemberGuardianStatus(ctx, guardian, true))
+	term, _ = k.GetGuardianTerm(ctx, guardian)
+	require.Equal(t, uint64(2), term.ConsecutiveTerms)
+
+	// Removing the guardian cuts the term short
+	ctx = ctx.WithBlockTime(now.Add(100 * time.Minute))
+	require.NoError(t, k.SetMemberGuardianStatus(ctx, guardian, false))
+	term, _ = k.GetGuardianTerm(ctx, guardian)
+	require.Equal(t, now.Add(100*time.Minute), term.EndTime)
+
+	// A third consecutive term exceeds the limit
+	ctx = ctx.WithBlockTime(now.Add(150 * time.Minute))
+	err := k.SetMemberGuardianStatus(ctx, guardian, true)
+	require.ErrorIs(t, err, types.ErrTermLimitReached)
+	require.ErrorIs(t, k.DeclareCandidacy(ctx, guardian, ""), types.ErrTermLimitReached)
+
+	// Sitting out a full term resets the count
+	ctx = ctx.WithBlockTime(now.Add(160 * time.Minute))
+	require.NoError(t, k.SetMemberGuardianStatus(ctx, guardian, true))
+	term, _ = k.GetGuardianTerm(ctx, guardian)
+	require.Equal(t, uint64(1), term.ConsecutiveTerms)
+}
+
+func TestStaggerGuardianTerms(t *testing.T) {
+	k, ctx := testkeeper.MembershipKeeper(t)
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+
+	// Guardians appointed while term limits are disabled serve without an end date
+	var guardians []sdk.AccAddress
+	dd := types.DefaultDirectDemocracy()
+	for i := 0; i < 4; i++ {
+		guardian := setupGuardian(t, k, ctx)
+		guardians = append(guardians, guardian)
+		dd.Guardians = append(dd.Guardians, guardian.String())
+	}
+	k.SetDirectDemocracySettings(ctx, &dd)
+
+	k.ExpireGuardianTerms(ctx)
+	res, err := k.GuardianTermExpirations(ctx, &types.QueryGuardianTermExpirationsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Terms)
+
+	// Enabling term limits staggers the guardians' terms
+	params := types.DefaultParams()
+	params.GuardianTermLength = time.Hour
+	k.SetParams(ctx, params)
+	k.ExpireGuardianTerms(ctx)
+
+	res, err = k.GuardianTermExpirations(ctx, &types.QueryGuardianTermExpirationsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Terms, 4)
+	for i, term := range res.Terms {
+		require.Equal(t, now.Add(time.Duration(i+1)*15*time.Minute), term.EndTime)
+	}
+
+	// Only the first guardian is replaced after a quarter of a term
+	ctx = ctx.WithBlockTime(now.Add(15 * time.Minute))
+	k.ExpireGuardianTerms(ctx)
+	require.Len(t, k.GetDirectDemocracySettings(ctx).Guardians, 3)
+}
@@ -0,0 +1,211 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+// GetTreasuryAddress returns the address of the module account that holds
+// the treasury
+func (k Keeper) GetTreasuryAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(types.ModuleName)
+}
+
+// GetTreasuryBalance returns the funds held by the treasury
+func (k Keeper) GetTreasuryBalance(ctx sdk.Context) sdk.Coins {
+	return k.bankKeeper.GetAllBalances(ctx, k.GetTreasuryAddress())
+}
+
+// GetTreasuryCommitted returns the part of the treasury's funds still owed to
+// the recipients of streamed spends, and to members' unclaimed dividends
+func (k Keeper) GetTreasuryCommitted(ctx sdk.Context) sdk.Coins {
+	committed := k.GetDividendPool(ctx).Reserve
+	for _, spend := range k.getTreasuryStreams(ctx) {
+		committed = committed.Add(spend.Remaining()...)
+	}
+	return committed
+}
+
+// getTreasuryAvailable returns the part of the treasury's funds that is not
+// owed to anyone, and false if the treasury owes more than it holds
+func (k Keeper) getTreasuryAvailable(ctx sdk.Context) (sdk.Coins, bool) {
+	available, negative := k.GetTreasuryBalance(ctx).SafeSub(k.GetTreasuryCommitted(ctx)...)
+	return available, !negative
+}
+
+// GetTreasurySpend fetches the treasury spend with the given ID
+func (k Keeper) GetTreasurySpend(ctx sdk.Context, spendID uint64) (types.TreasurySpend, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	var spend types.TreasurySpend
+
+	bz := store.Get(types.TreasurySpendKey(spendID))
+	if bz == nil {
+		return spend, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &spend)
+	return spend, true
+}
+
+// GetTreasurySpendCount returns the number of treasury spends approved so far
+func (k Keeper) GetTreasurySpendCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	bz := store.Get(types.TreasurySpendCountKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setTreasurySpend(ctx sdk.Context, spend types.TreasurySpend) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	store.Set(types.TreasurySpendKey(spend.Id), k.cdc.MustMarshal(&spend))
+}
+
+// getTreasuryStreams returns the streamed spends still being paid out
+func (k Keeper) getTreasuryStreams(ctx sdk.Context) []types.TreasurySpend {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TreasuryStreamKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var spends []types.TreasurySpend
+	for ; iterator.Valid(); iterator.Next() {
+		spend, found := k.GetTreasurySpend(ctx, sdk.BigEndianToUint64(iterator.Key()))
+		if found {
+			spends = append(spends, spend)
+		}
+	}
+	return spends
+}
+
+// PayEnrollmentFee moves the enrollment fee, if there is one, from the new
+// enrollee to the treasury
+func (k Keeper) PayEnrollmentFee(ctx sdk.Context, enrollee sdk.AccAddress) error {
+	fee := k.EnrollmentFee(ctx)
+	if fee.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, enrollee, types.ModuleName, fee); err != nil {
+		return errors.Wrap(err, "failed to pay the enrollment fee")
+	}
+
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventEnrollmentFeePaid{
+			Member: enrollee.String(),
+			Amount: fee,
+		},
+	)
+}
+
+// Donate moves funds from the donor to the treasury
+func (k Keeper) Donate(ctx sdk.Context, donor sdk.AccAddress, amount sdk.Coins) error {
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, donor, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventTreasuryDonation{
+			Donor:  donor.String(),
+			Amount: amount,
+		},
+	)
+}
+
+// SpendTreasury pays the amount out of the treasury to the recipient, at once
+// or streamed linearly over the given duration. The treasury must hold the
+// amount on top of what it still owes to the recipients of other streams and
+// to members.
+func (k Keeper) SpendTreasury(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coins, streamDuration time.Duration) (types.TreasurySpend, error) {
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return types.TreasurySpend{}, errors.Wrapf(types.ErrInvalidTreasurySpend, "recipient is not allowed to receive funds: %s", recipient.String())
+	}
+
+	available, ok := k.getTreasuryAvailable(ctx)
+	if !ok || !amount.IsAllLTE(available) {
+		return types.TreasurySpend{}, errors.Wrapf(types.ErrInsufficientTreasuryFunds, "%s is more than the available %s", amount, available)
+	}
+
+	spend := types.TreasurySpend{
+		Id:        k.GetTreasurySpendCount(ctx) + 1,
+		Recipient: recipient.String(),
+		Amount:    amount,
+		Paid:      sdk.NewCoins(),
+		StartTime: ctx.BlockTime(),
+		EndTime:   ctx.BlockTime().Add(streamDuration),
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	store.Set(types.TreasurySpendCountKey, sdk.Uint64ToBigEndian(spend.Id))
+	k.setTreasurySpend(ctx, spend)
+
+	err := ctx.EventManager().EmitTypedEvent(
+		&types.EventTreasurySpend{
+			SpendId:   spend.Id,
+			Recipient: spend.Recipient,
+			Amount:    spend.Amount,
+			EndTime:   spend.EndTime,
+		},
+	)
+	if err != nil {
+		return spend, err
+	}
+
+	// Streams are paid out at the end of each block
+	if streamDuration > 0 {
+		store.Set(types.TreasuryStreamKey(spend.Id), []byte{})
+		return spend, nil
+	}
+
+	err = k.payOutTreasurySpend(ctx, &spend)
+	return spend, err
+}
+
+// PayOutTreasuryStreams pays the recipients of streamed spends what has
+// vested since the last payout, and ends the streams that are fully paid out
+func (k Keeper) PayOutTreasuryStreams(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	for _, spend := range k.getTreasuryStreams(ctx) {
+		// A failed payout must not leave a partial one behind
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.payOutTreasurySpend(cacheCtx, &spend); err != nil {
+			k.Logger(ctx).Error("failed to pay out treasury spend", "spend", spend.Id, "error", err)
+			continue
+		}
+		writeCache()
+
+		if !ctx.BlockTime().Before(spend.EndTime) {
+			store.Delete(types.TreasuryStreamKey(spend.Id))
+		}
+	}
+}
+
+// payOutTreasurySpend pays the recipient the part of the spend that has
+// vested but not yet been paid
+func (k Keeper) payOutTreasurySpend(ctx sdk.Context, spend *types.TreasurySpend) error {
+	due := spend.VestedAmount(ctx.BlockTime()).Sub(spend.Paid...)
+	if due.IsZero() {
+		return nil
+	}
+
+	recipient := sdk.MustAccAddressFromBech32(spend.Recipient)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, due); err != nil {
+		return err
+	}
+	spend.Paid = spend.Paid.Add(due...)
+	k.setTreasurySpend(ctx, *spend)
+
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventTreasuryPayout{
+			SpendId:   spend.Id,
+			Recipient: spend.Recipient,
+			Amount:    due,
+		},
+	)
+}
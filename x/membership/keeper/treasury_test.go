@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/noria-net/module-membership/app"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/noria-net/module-membership/x/membership/keeper"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTreasury(t *testing.T) {
+	wasmApp := app.Setup(t)
+	start := time.Now()
+	ctx := wasmApp.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: start})
+	k := wasmApp.MembershipKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+
+	params := k.GetParams(ctx)
+	params.EnrollmentFee = coins(100)
+	k.SetParams(ctx, params)
+
+	// New enrollees pay the enrollment fee into the treasury
+	addrs := app.AddTestAddrsIncremental(wasmApp, ctx, 2, sdk.NewInt(10000))
+	enrollee, donor := addrs[0], addrs[1]
+	_, err := msgServer.Enroll(ctx, types.NewMsgEnroll(enrollee.String(), "enrollee", ""))
+	require.NoError(t, err)
+	require.Equal(t, coins(100), k.GetTreasuryBalance(ctx))
+	require.Equal(t, coins(9900), wasmApp.BankKeeper.GetAllBalances(ctx, enrollee))
+
+	// Enrollees who cannot pay the fee cannot enroll
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = msgServer.Enroll(cacheCtx, types.NewMsgEnroll(sample.AccAddress(), "", ""))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	_, err = msgServer.Donate(ctx, types.NewMsgDonate(donor.String(), coins(900)))
+	require.NoError(t, err)
+	require.Equal(t, coins(1000), k.GetTreasuryBalance(ctx))
+
+	// Only governance can spend the treasury, and only what it holds
+	recipient := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	_, err = msgServer.TreasurySpend(ctx, types.NewMsgTreasurySpend(donor.String(), recipient.String(), coins(100), 0))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	_, err = msgServer.TreasurySpend(ctx, types.NewMsgTreasurySpend(authority, recipient.String(), coins(1001), 0))
+	require.ErrorIs(t, err, types.ErrInsufficientTreasuryFunds)
+	distrAddr := authtypes.NewModuleAddress(distrtypes.ModuleName).String()
+	_, err = msgServer.TreasurySpend(ctx, types.NewMsgTreasurySpend(authority, distrAddr, coins(100), 0))
+	require.ErrorIs(t, err, types.ErrInvalidTreasurySpend)
+
+	// Spends that are not streamed are paid out at once
+	res, err := msgServer.TreasurySpend(ctx, types.NewMsgTreasurySpend(authority, recipient.String(), coins(200), 0))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.SpendId)
+	require.Equal(t, coins(200), wasmApp.BankKeeper.GetAllBalances(ctx, recipient))
+	require.Equal(t, coins(800), k.GetTreasuryBalance(ctx))
+
+	// Streamed spends hold back their amount from later spends
+	streamRecipient := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	res, err = msgServer.TreasurySpend(ctx, types.NewMsgTreasurySpend(authority, streamRecipient.String(), coins(600), 10*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.SpendId)
+	require.True(t, wasmApp.BankKeeper.GetAllBalances(ctx, streamRecipient).IsZero())
+	require.Equal(t, coins(600), k.GetTreasuryCommitted(ctx))
+	_, err = msgServer.TreasurySpend(ctx, types.NewMsgTreasurySpend(authority, recipient.String(), coins(201), 0))
+	require.ErrorIs(t, err, types.ErrInsufficientTreasuryFunds)
+
+	// Streams are paid out as they vest
+	ctx = ctx.WithBlockTime(start.Add(5 * time.Hour))
+	k.PayOutTreasuryStreams(ctx)
+	require.Equal(t, coins(300), wasmApp.BankKeeper.GetAllBalances(ctx, streamRecipient))
+	require.Equal(t, coins(300), k.GetTreasuryCommitted(ctx))
+
+	ctx = ctx.WithBlockTime(start.Add(11 * time.Hour))
+	k.PayOutTreasuryStreams(ctx)
+	require.Equal(t, coins(600), wasmApp.BankKeeper.GetAllBalances(ctx, streamRecipient))
+	require.True(t, k.GetTreasuryCommitted(ctx).IsZero())
+	require.Equal(t, coins(200), k.GetTreasuryBalance(ctx))
+
+	// Ended streams are no longer paid out
+	ctx = ctx.WithBlockTime(start.Add(12 * time.Hour))
+	k.PayOutTreasuryStreams(ctx)
+	require.Equal(t, coins(600), wasmApp.BankKeeper.GetAllBalances(ctx, streamRecipient))
+
+	// Both spends are in the treasury's history
+	spends, err := k.TreasurySpends(ctx, &types.QueryTreasurySpendsRequest{})
+	require.NoError(t, err)
+	require.Len(t, spends.Spends, 2)
+	require.Equal(t, coins(200), spends.Spends[0].Paid)
+	require.Equal(t, coins(600), spends.Spends[1].Paid)
+	require.Equal(t, start.Add(10*time.Hour).UTC(), spends.Spends[1].EndTime.UTC())
+
+	treasury, err := k.Treasury(ctx, &types.QueryTreasuryRequest{})
+	require.NoError(t, err)
+	require.Equal(t, authtypes.NewModuleAddress(types.ModuleName).String(), treasury.Address)
+	require.Equal(t, coins(200), treasury.Balance)
+}
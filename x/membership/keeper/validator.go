@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+// jailValidator jails the validator operated by the member, if they operate
+// one and validators are restricted to the electorate. The validator leaves
+// the active set at the end of the block, and cannot be unjailed until its
+// operator rejoins the electorate.
+func (k Keeper) jailValidator(ctx sdk.Context, operator sdk.AccAddress) {
+	if !k.RestrictValidators(ctx) {
+		return
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, sdk.ValAddress(operator))
+	if !found || validator.IsJailed() {
+		return
+	}
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		k.Logger(ctx).Error("failed to jail validator", "validator", validator.OperatorAddress, "error", err)
+		return
+	}
+
+	k.stakingKeeper.Jail(ctx, consAddr)
+
+	_ = ctx.EventManager().EmitTypedEvent(
+		&types.EventValidatorJailed{
+			Operator:  operator.String(),
+			Validator: validator.OperatorAddress,
+		},
+	)
+}
+
+// StakingHooks wraps the keeper to implement the staking hooks
+type StakingHooks struct {
+	k Keeper
+}
+
+var _ stakingtypes.StakingHooks = StakingHooks{}
+
+// StakingHooks returns the hooks that restrict validators to the electorate
+func (k Keeper) StakingHooks() StakingHooks {
+	return StakingHooks{k}
+}
+
+// AfterValidatorCreated rejects validators whose operator is not an
+// electorate member, when validators are restricted to the electorate
+func (h StakingHooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	if !h.k.RestrictValidators(ctx) {
+		return nil
+	}
+
+	operator := sdk.AccAddress(valAddr)
+	if !h.k.isElectorate(ctx, operator) {
+		return errors.Wrapf(types.ErrSignerNotElectorate, "validators cannot be operated by %s", operator.String())
+	}
+	return nil
+}
+
+func (h StakingHooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorRemoved(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterDelegationModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec) error {
+	return nil
+}
+
+func (h StakingHooks) AfterUnbondingInitiated(_ sdk.Context, _ uint64) error {
+	return nil
+}
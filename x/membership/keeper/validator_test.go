@@ -0,0 +1,155 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/noria-net/module-membership/app"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/noria-net/module-membership/x/membership/keeper"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/stretchr/testify/require"
+)
+
+// setupValidators starts a chain with the given number of genesis validators
+func setupValidators(t *testing.T, count int) (*app.WasmApp, []*tmtypes.Validator) {
+	var validators []*tmtypes.Validator
+	for i := 0; i < count; i++ {
+		pubKey, err := mock.NewPV().GetPubKey()
+		require.NoError(t, err)
+		validators = append(validators, tmtypes.NewValidator(pubKey, 1))
+	}
+
+	senderPrivKey := secp256k1.GenPrivKey()
+	acc := authtypes.NewBaseAccount(senderPrivKey.PubKey().Address().Bytes(), senderPrivKey.PubKey(), 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000000000000))),
+	}
+
+	wasmApp := app.SetupWithGenesisValSet(t, tmtypes.NewValidatorSet(validators), []authtypes.GenesisAccount{acc}, "testing", nil, balance)
+	return wasmApp, validators
+}
+
+func TestRestrictValidators(t *testing.T) {
+	wasmApp, validators := setupValidators(t, 2)
+	ctx := wasmApp.BaseApp.NewContext(false, tmproto.Header{Height: wasmApp.LastBlockHeight() + 1, Time: time.Now()})
+	k := wasmApp.MembershipKeeper
+
+	// Both genesis validators are operated by electorate members
+	var operators []sdk.AccAddress
+	for _, validator := range validators {
+		operator := sdk.AccAddress(validator.Address)
+		require.NoError(t, k.AppendMember(ctx, operator))
+		require.NoError(t, k.UpdateMemberStatus(ctx, operator, types.MembershipStatus_MemberElectorate))
+		operators = append(operators, operator)
+	}
+
+	// Nothing happens to validators while they are not restricted
+	require.NoError(t, k.UpdateMemberStatus(ctx, operators[1], types.MembershipStatus_MemberSuspended))
+	validator, found := wasmApp.StakingKeeper.GetValidator(ctx, sdk.ValAddress(operators[1]))
+	require.True(t, found)
+	require.False(t, validator.IsJailed())
+
+	params := k.GetParams(ctx)
+	params.RestrictValidators = true
+	k.SetParams(ctx, params)
+
+	// Leaving the electorate jails the member's validator, which leaves the
+	// active set at the end of the block
+	require.NoError(t, k.UpdateMemberStatus(ctx, operators[0], types.MembershipStatus_MemberSuspended))
+	validator, found = wasmApp.StakingKeeper.GetValidator(ctx, sdk.ValAddress(operators[0]))
+	require.True(t, found)
+	require.True(t, validator.IsJailed())
+
+	updates := staking.EndBlocker(ctx, wasmApp.StakingKeeper)
+	require.Len(t, updates, 1)
+	require.Equal(t, validators[0].PubKey.Bytes(), updates[0].PubKey.GetEd25519())
+	require.Zero(t, updates[0].Power)
+
+	// Members who leave the electorate again do not jail their validator twice
+	require.NoError(t, k.UpdateMemberStatus(ctx, operators[0], types.MembershipStatus_MemberElectorate))
+	require.NoError(t, k.UpdateMemberStatus(ctx, operators[0], types.MembershipStatus_MemberSuspended))
+}
+
+func TestRestrictValidatorCreation(t *testing.T) {
+	wasmApp, _ := setupValidators(t, 1)
+	ctx := wasmApp.BaseApp.NewContext(false, tmproto.Header{Height: wasmApp.LastBlockHeight() + 1, Time: time.Now()})
+	k := wasmApp.MembershipKeeper
+	msgServer := stakingkeeper.NewMsgServerImpl(wasmApp.StakingKeeper)
+
+	params := k.GetParams(ctx)
+	params.RestrictValidators = true
+	k.SetParams(ctx, params)
+
+	addrs := app.AddTestAddrsIncremental(wasmApp, ctx, 2, sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction))
+	member, outsider := addrs[0], addrs[1]
+	require.NoError(t, k.AppendMember(ctx, member))
+	require.NoError(t, k.UpdateMemberStatus(ctx, member, types.MembershipStatus_MemberElectorate))
+
+	createValidator := func(ctx sdk.Context, operator sdk.AccAddress) error {
+		msg, err := stakingtypes.NewMsgCreateValidator(
+			sdk.ValAddress(operator),
+			ed25519.GenPrivKey().PubKey(),
+			sdk.NewCoin(wasmApp.StakingKeeper.BondDenom(ctx), sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction)),
+			stakingtypes.Description{Moniker: "validator"},
+			stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2)),
+			math.OneInt(),
+		)
+		require.NoError(t, err)
+		_, err = msgServer.CreateValidator(sdk.WrapSDKContext(ctx), msg)
+		return err
+	}
+
+	// Failed transactions are not committed
+	cacheCtx, _ := ctx.CacheContext()
+	require.ErrorIs(t, createValidator(cacheCtx, outsider), types.ErrSignerNotElectorate)
+
+	require.NoError(t, createValidator(ctx, member))
+	_, found := wasmApp.StakingKeeper.GetValidator(ctx, sdk.ValAddress(member))
+	require.True(t, found)
+}
+
+func TestUnauthorizedStatusUpdateCannotJailValidator(t *testing.T) {
+	wasmApp, validators := setupValidators(t, 1)
+	ctx := wasmApp.BaseApp.NewContext(false, tmproto.Header{Height: wasmApp.LastBlockHeight() + 1, Time: time.Now()})
+	k := wasmApp.MembershipKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	operator := sdk.AccAddress(validators[0].Address)
+	require.NoError(t, k.AppendMember(ctx, operator))
+	require.NoError(t, k.UpdateMemberStatus(ctx, operator, types.MembershipStatus_MemberElectorate))
+
+	params := k.GetParams(ctx)
+	params.RestrictValidators = true
+	k.SetParams(ctx, params)
+
+	// Neither outsiders nor other members can move the operator out of the
+	// electorate
+	member := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	require.NoError(t, k.AppendMember(ctx, member))
+	require.NoError(t, k.UpdateMemberStatus(ctx, member, types.MembershipStatus_MemberElectorate))
+	for _, sender := range []string{sample.AccAddress(), member.String()} {
+		_, err := msgServer.UpdateStatus(ctx, types.NewMsgUpdateStatus(sender, operator.String(), types.MembershipStatus_MemberInactive))
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	}
+
+	m, _ := k.GetMemberAccount(ctx, operator)
+	require.Equal(t, types.MembershipStatus_MemberElectorate, m.Status)
+	validator, found := wasmApp.StakingKeeper.GetValidator(ctx, sdk.ValAddress(operator))
+	require.True(t, found)
+	require.False(t, validator.IsJailed())
+}
@@ -0,0 +1,106 @@
+package v2
+
+import (
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+)
+
+// MigrateStore migrates the members from v1 to v2. Members used to embed
+// their BaseAccount, which v2 no longer decodes, so each member now takes its
+// address from its key instead. v1 had no params, so each param added since
+// is set to its default.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, paramSpace paramtypes.Subspace) error {
+	migrateParams(ctx, paramSpace)
+
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.MembersKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+
+	var keys [][]byte
+	var members []types.Member
+	for ; iterator.Valid(); iterator.Next() {
+		var member types.Member
+		if err := cdc.Unmarshal(iterator.Value(), &member); err != nil {
+			iterator.Close()
+			return err
+		}
+
+		addr, err := memberAddressFromKey(iterator.Key())
+		if err != nil {
+			iterator.Close()
+			return err
+		}
+		member.Address = addr.String()
+
+		keys = append(keys, iterator.Key())
+		members = append(members, member)
+	}
+	iterator.Close()
+
+	for i, key := range keys {
+		store.Set(key, cdc.MustMarshal(&members[i]))
+	}
+
+	return nil
+}
+
+// migrateParams sets each param that is missing from the store to its
+// default, as reading a missing param panics
+func migrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) {
+	defaults := types.DefaultParams()
+	params := []struct {
+		key   []byte
+		value interface{}
+	}{
+		{types.KeyRecallThreshold, defaults.RecallThreshold},
+		{types.KeyAppealPeriod, defaults.AppealPeriod},
+		{types.KeyElectionPeriod, defaults.ElectionPeriod},
+		{types.KeyElectionDuration, defaults.ElectionDuration},
+		{types.KeyGuardianSeats, defaults.GuardianSeats},
+		{types.KeyElectionMethod, defaults.ElectionMethod},
+		{types.KeyGuardianTermLength, defaults.GuardianTermLength},
+		{types.KeyMaxConsecutiveTerms, defaults.MaxConsecutiveTerms},
+		{types.KeyBicameralMsgTypes, defaults.BicameralMsgTypes},
+		{types.KeyTallyRules, defaults.TallyRules},
+		{types.KeyAllowSplitVotes, defaults.AllowSplitVotes},
+		{types.KeyMaxDelegationDepth, defaults.MaxDelegationDepth},
+		{types.KeyRevealPeriod, defaults.RevealPeriod},
+		{types.KeyCountUnrevealedVotes, defaults.CountUnrevealedVotes},
+		{types.KeyRestrictGovMessages, defaults.RestrictGovMessages},
+		{types.KeyFeeWaiverMsgTypes, defaults.FeeWaiverMsgTypes},
+		{types.KeyFeeWaiverLimit, defaults.FeeWaiverLimit},
+		{types.KeyFeeWaiverEpoch, defaults.FeeWaiverEpoch},
+		{types.KeyWasmAccessRole, defaults.WasmAccessRole},
+		{types.KeyRestrictValidators, defaults.RestrictValidators},
+		{types.KeyEnrollmentFee, defaults.EnrollmentFee},
+		{types.KeyDividendEpoch, defaults.DividendEpoch},
+		{types.KeyDividendTreasuryShare, defaults.DividendTreasuryShare},
+		{types.KeyRecallPetitionPeriod, defaults.RecallPetitionPeriod},
+		{types.KeyMaxSuspensionDuration, defaults.MaxSuspensionDuration},
+		{types.KeyMinElectionTurnout, defaults.MinElectionTurnout},
+		{types.KeyMaxCandidacies, defaults.MaxCandidacies},
+		{types.KeyGuardianChamberRule, defaults.GuardianChamberRule},
+		{types.KeyMemberChamberRule, defaults.MemberChamberRule},
+		{types.KeyFeeWaiverMaxGas, defaults.FeeWaiverMaxGas},
+	}
+
+	for _, param := range params {
+		if !paramSpace.Has(ctx, param.key) {
+			paramSpace.Set(ctx, param.key, param.value)
+		}
+	}
+}
+
+// memberAddressFromKey parses the length prefixed address of a member key,
+// without its prefix
+func memberAddressFromKey(key []byte) (sdk.AccAddress, error) {
+	if len(key) == 0 || len(key) != int(key[0])+1 {
+		return nil, errors.Wrapf(sdkerrors.ErrLogic, "invalid member key: %X", key)
+	}
+	return sdk.AccAddress(key[1:]), nil
+}
@@ -0,0 +1,101 @@
+package v2_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v2 "github.com/noria-net/module-membership/x/membership/migrations/v2"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/stretchr/testify/require"
+)
+
+// legacyMember encodes a member as v1 stored it, with its BaseAccount
+// embedded as field 1
+func legacyMember(t *testing.T, addr sdk.AccAddress, member types.Member) []byte {
+	account, err := authtypes.NewBaseAccount(addr, nil, 7, 3).Marshal()
+	require.NoError(t, err)
+	rest, err := member.Marshal()
+	require.NoError(t, err)
+
+	bz := []byte{0x0a}
+	bz = binary.AppendUvarint(bz, uint64(len(account)))
+	bz = append(bz, account...)
+	return append(bz, rest...)
+}
+
+// setupStore returns a store holding nothing, as v1 had no params
+func setupStore() (sdk.Context, storetypes.StoreKey, moduletestutil.TestEncodingConfig, paramtypes.Subspace) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tStoreKey)
+	paramSpace := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, storeKey, tStoreKey, "MembershipParams").
+		WithKeyTable(types.ParamKeyTable())
+	return ctx, storeKey, encCfg, paramSpace
+}
+
+func TestMigrateStore(t *testing.T) {
+	ctx, storeKey, encCfg, paramSpace := setupStore()
+	store := ctx.KVStore(storeKey)
+
+	alice := sdk.AccAddress([]byte("alice_______________"))
+	bob := sdk.AccAddress([]byte("bob_________________________________"))
+	store.Set(types.MemberKey(alice), legacyMember(t, alice, types.Member{
+		Status:     types.MembershipStatus_MemberElectorate,
+		IsGuardian: true,
+	}))
+	store.Set(types.MemberKey(bob), legacyMember(t, bob, types.Member{
+		Status: types.MembershipStatus_MemberStatusPendingApproval,
+	}))
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, encCfg.Codec, paramSpace))
+
+	var member types.Member
+	encCfg.Codec.MustUnmarshal(store.Get(types.MemberKey(alice)), &member)
+	require.Equal(t, types.Member{
+		Address:    alice.String(),
+		Status:     types.MembershipStatus_MemberElectorate,
+		IsGuardian: true,
+	}, member)
+
+	// Addresses of any length are migrated
+	member = types.Member{}
+	encCfg.Codec.MustUnmarshal(store.Get(types.MemberKey(bob)), &member)
+	require.Equal(t, types.Member{
+		Address: bob.String(),
+		Status:  types.MembershipStatus_MemberStatusPendingApproval,
+	}, member)
+
+	// Nothing but the members was touched
+	count := 0
+	iterator := prefix.NewStore(store, types.MembersKeyPrefix).Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+	iterator.Close()
+	require.Equal(t, 2, count)
+}
+
+func TestMigrateParams(t *testing.T) {
+	ctx, storeKey, encCfg, paramSpace := setupStore()
+
+	// Params set since v1 are kept
+	recallThreshold := sdk.NewDecWithPrec(4, 1)
+	paramSpace.Set(ctx, types.KeyRecallThreshold, recallThreshold)
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, encCfg.Codec, paramSpace))
+
+	// Every param is set to its default, and can be read without panicking
+	var params types.Params
+	require.NotPanics(t, func() { paramSpace.GetParamSet(ctx, &params) })
+	want := types.DefaultParams()
+	want.RecallThreshold = recallThreshold
+	require.Equal(t, want, params)
+}
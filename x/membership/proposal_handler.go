@@ -18,6 +18,12 @@ func NewMembershipProposalHandler(k keeper.Keeper) gov_v1beta1.Handler {
 			return keeper.HandleRemoveGuardiansProposal(ctx, k, c)
 		case *types.UpdateTotalVotingWeightProposal:
 			return keeper.HandleUpdateTotalVotingWeightProposal(ctx, k, c)
+		case *types.SetVotingWeightDecayScheduleProposal:
+			return keeper.HandleSetVotingWeightDecayScheduleProposal(ctx, k, c)
+		case *types.PauseVotingWeightDecayProposal:
+			return keeper.HandlePauseVotingWeightDecayProposal(ctx, k, c)
+		case *types.UpdateGuardianWeightsProposal:
+			return keeper.HandleUpdateGuardianWeightsProposal(ctx, k, c)
 		default:
 			return errors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized membership proposal content type: %T", c)
 		}
@@ -0,0 +1,15 @@
+package types
+
+import (
+	"time"
+)
+
+// AppealStatementMaxLength is the maximum number of characters allowed for
+// an appeal statement. It matches the gov module's default metadata length,
+// as the statement is used as the reinstatement proposal's summary.
+const AppealStatementMaxLength = 255
+
+// CanAppeal returns true if the expulsion can still be appealed at the given time
+func (a ExpulsionAppeal) CanAppeal(now time.Time) bool {
+	return a.Status == AppealStatus_AppealWindowOpen && now.Before(a.Deadline)
+}
@@ -0,0 +1,608 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: membershipmodule/membership/appeal.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AppealStatus enumerates the stages of an expulsion appeal
+type AppealStatus int32
+
+const (
+	// APPEAL_STATUS_UNSPECIFIED defines a no-op status
+	AppealStatus_AppealStatusEmpty AppealStatus = 0
+	// APPEAL_STATUS_WINDOW_OPEN defines an expulsion that can still be appealed
+	AppealStatus_AppealWindowOpen AppealStatus = 1
+	// APPEAL_STATUS_FILED defines an appeal that is being voted on by the electorate
+	AppealStatus_AppealFiled AppealStatus = 2
+	// APPEAL_STATUS_FINALIZED defines an expulsion that can no longer be appealed
+	AppealStatus_AppealFinalized AppealStatus = 3
+)
+
+var AppealStatus_name = map[int32]string{
+	0: "APPEAL_STATUS_UNSPECIFIED",
+	1: "APPEAL_STATUS_WINDOW_OPEN",
+	2: "APPEAL_STATUS_FILED",
+	3: "APPEAL_STATUS_FINALIZED",
+}
+
+var AppealStatus_value = map[string]int32{
+	"APPEAL_STATUS_UNSPECIFIED": 0,
+	"APPEAL_STATUS_WINDOW_OPEN": 1,
+	"APPEAL_STATUS_FILED":       2,
+	"APPEAL_STATUS_FINALIZED":   3,
+}
+
+func (x AppealStatus) String() string {
+	return proto.EnumName(AppealStatus_name, int32(x))
+}
+
+func (AppealStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6adeb14f4dd90f51, []int{0}
+}
+
+// ExpulsionAppeal tracks an expelled member's right to appeal their expulsion
+type ExpulsionAppeal struct {
+	// member is the address of the expelled member
+	Member string `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	// expelled_at is the time the member was expelled
+	ExpelledAt time.Time `protobuf:"bytes,2,opt,name=expelled_at,json=expelledAt,proto3,stdtime" json:"expelled_at"`
+	// deadline is the time after which the expulsion can no longer be appealed
+	Deadline time.Time `protobuf:"bytes,3,opt,name=deadline,proto3,stdtime" json:"deadline"`
+	// statement is the expelled member's case for reinstatement
+	Statement string `protobuf:"bytes,4,opt,name=statement,proto3" json:"statement,omitempty"`
+	// proposal_id is the reinstatement proposal created by the appeal, if any
+	ProposalId uint64 `protobuf:"varint,5,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// status is the stage the appeal has reached
+	Status AppealStatus `protobuf:"varint,6,opt,name=status,proto3,enum=membershipmodule.membership.AppealStatus" json:"status,omitempty"`
+}
+
+func (m *ExpulsionAppeal) Reset()         { *m = ExpulsionAppeal{} }
+func (m *ExpulsionAppeal) String() string { return proto.CompactTextString(m) }
+func (*ExpulsionAppeal) ProtoMessage()    {}
+func (*ExpulsionAppeal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6adeb14f4dd90f51, []int{0}
+}
+func (m *ExpulsionAppeal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExpulsionAppeal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExpulsionAppeal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExpulsionAppeal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpulsionAppeal.Merge(m, src)
+}
+func (m *ExpulsionAppeal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExpulsionAppeal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpulsionAppeal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExpulsionAppeal proto.InternalMessageInfo
+
+func (m *ExpulsionAppeal) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *ExpulsionAppeal) GetExpelledAt() time.Time {
+	if m != nil {
+		return m.ExpelledAt
+	}
+	return time.Time{}
+}
+
+func (m *ExpulsionAppeal) GetDeadline() time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return time.Time{}
+}
+
+func (m *ExpulsionAppeal) GetStatement() string {
+	if m != nil {
+		return m.Statement
+	}
+	return ""
+}
+
+func (m *ExpulsionAppeal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *ExpulsionAppeal) GetStatus() AppealStatus {
+	if m != nil {
+		return m.Status
+	}
+	return AppealStatus_AppealStatusEmpty
+}
+
+func init() {
+	proto.RegisterEnum("membershipmodule.membership.AppealStatus", AppealStatus_name, AppealStatus_value)
+	proto.RegisterType((*ExpulsionAppeal)(nil), "membershipmodule.membership.ExpulsionAppeal")
+}
+
+func init() {
+	proto.RegisterFile("membershipmodule/membership/appeal.proto", fileDescriptor_6adeb14f4dd90f51)
+}
+
+var fileDescriptor_6adeb14f4dd90f51 = []byte{
+	// 473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0xe3, 0x6e, 0x54, 0x9b, 0x8b, 0x68, 0xf0, 0x06, 0x84, 0x80, 0xd2, 0x88, 0x53, 0x40,
+	0x5a, 0x82, 0x36, 0x2e, 0xdc, 0xc8, 0x68, 0x2a, 0x45, 0xaa, 0xda, 0xaa, 0xe9, 0x54, 0x69, 0x97,
+	0x28, 0xc5, 0x26, 0xb3, 0xe4, 0xc4, 0x56, 0xe3, 0x88, 0x8e, 0x47, 0xe8, 0x69, 0x2f, 0xd0, 0xb7,
+	0xe0, 0x21, 0x76, 0xdc, 0x05, 0x89, 0x13, 0xa0, 0xf6, 0x45, 0xd0, 0x92, 0x85, 0x96, 0x1e, 0x90,
+	0x76, 0xf3, 0xf7, 0xf7, 0xff, 0xf7, 0xfd, 0xad, 0xcf, 0x1f, 0xb4, 0x12, 0x92, 0x4c, 0xc8, 0x34,
+	0xbb, 0xa0, 0x22, 0xe1, 0x38, 0x67, 0xc4, 0x59, 0x0b, 0x4e, 0x24, 0x04, 0x89, 0x98, 0x2d, 0xa6,
+	0x5c, 0x72, 0xf4, 0x62, 0xdb, 0x69, 0xaf, 0x05, 0xfd, 0x30, 0xe6, 0x31, 0x2f, 0x7c, 0xce, 0xed,
+	0xa9, 0x44, 0xf4, 0x56, 0xcc, 0x79, 0xcc, 0x88, 0x53, 0x54, 0x93, 0xfc, 0xb3, 0x23, 0x69, 0x42,
+	0x32, 0x19, 0x25, 0xa2, 0x34, 0xbc, 0xfa, 0x56, 0x83, 0x4d, 0x6f, 0x26, 0x72, 0x96, 0x51, 0x9e,
+	0xba, 0x45, 0x1a, 0x7a, 0x0a, 0xeb, 0x65, 0x63, 0x0d, 0x98, 0xc0, 0xda, 0x1f, 0xde, 0x55, 0xc8,
+	0x83, 0x0d, 0x32, 0x13, 0x84, 0x31, 0x82, 0xc3, 0x48, 0x6a, 0x35, 0x13, 0x58, 0x8d, 0x63, 0xdd,
+	0x2e, 0x23, 0xec, 0x2a, 0xc2, 0x1e, 0x55, 0x11, 0xa7, 0x7b, 0xd7, 0x3f, 0x5b, 0xca, 0xd5, 0xaf,
+	0x16, 0x18, 0xc2, 0x0a, 0x74, 0x25, 0xfa, 0x00, 0xf7, 0x30, 0x89, 0x30, 0xa3, 0x29, 0xd1, 0x76,
+	0xee, 0xd1, 0xe3, 0x2f, 0x85, 0x5e, 0xc2, 0xfd, 0x4c, 0x46, 0x92, 0x24, 0x24, 0x95, 0xda, 0x6e,
+	0xf1, 0xc6, 0xb5, 0x80, 0x5a, 0xb0, 0x21, 0xa6, 0x5c, 0xf0, 0x2c, 0x62, 0x21, 0xc5, 0xda, 0x03,
+	0x13, 0x58, 0xbb, 0x43, 0x58, 0x49, 0x3e, 0x46, 0x2e, 0xac, 0xdf, 0xba, 0xf3, 0x4c, 0xab, 0x9b,
+	0xc0, 0x7a, 0x74, 0xfc, 0xda, 0xfe, 0xcf, 0x60, 0xed, 0x72, 0x28, 0x41, 0x01, 0x0c, 0xef, 0xc0,
+	0x37, 0xdf, 0x01, 0x7c, 0xb8, 0x79, 0x81, 0xde, 0xc1, 0xe7, 0xee, 0x60, 0xe0, 0xb9, 0xdd, 0x30,
+	0x18, 0xb9, 0xa3, 0xb3, 0x20, 0x3c, 0xeb, 0x05, 0x03, 0xef, 0xa3, 0xdf, 0xf1, 0xbd, 0xb6, 0xaa,
+	0xe8, 0x4f, 0xe6, 0x0b, 0xf3, 0xf1, 0x26, 0xe0, 0x25, 0x42, 0x5e, 0xa2, 0x93, 0x6d, 0x6a, 0xec,
+	0xf7, 0xda, 0xfd, 0x71, 0xd8, 0x1f, 0x78, 0x3d, 0x15, 0xe8, 0x87, 0xf3, 0x85, 0xa9, 0x96, 0xd4,
+	0x98, 0xa6, 0x98, 0x7f, 0xe9, 0x0b, 0x92, 0x22, 0x0b, 0x1e, 0xfc, 0x0b, 0x75, 0xfc, 0xae, 0xd7,
+	0x56, 0x6b, 0x7a, 0x73, 0xbe, 0x30, 0x1b, 0xa5, 0xbd, 0x43, 0x19, 0xc1, 0xe8, 0x2d, 0x7c, 0xb6,
+	0xed, 0xec, 0xb9, 0x5d, 0xff, 0xdc, 0x6b, 0xab, 0x3b, 0xfa, 0xc1, 0x7c, 0x61, 0x36, 0x2b, 0x77,
+	0x1a, 0x31, 0xfa, 0x95, 0xe0, 0xd3, 0xe0, 0x7a, 0x69, 0x80, 0x9b, 0xa5, 0x01, 0x7e, 0x2f, 0x0d,
+	0x70, 0xb5, 0x32, 0x94, 0x9b, 0x95, 0xa1, 0xfc, 0x58, 0x19, 0xca, 0xf9, 0xfb, 0x98, 0xca, 0x8b,
+	0x7c, 0x62, 0x7f, 0xe2, 0x89, 0x93, 0xf2, 0x29, 0x8d, 0x8e, 0x52, 0x22, 0x9d, 0x72, 0x5c, 0x47,
+	0x1b, 0x1b, 0x3b, 0xdb, 0x5c, 0x5f, 0x79, 0x29, 0x48, 0x36, 0xa9, 0x17, 0xdf, 0x7a, 0xf2, 0x67,
+	0x00, 0xbf, 0xf8, 0x02, 0x7a, 0xea, 0x02, 0x00, 0x00,
+}
+
+func (m *ExpulsionAppeal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExpulsionAppeal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExpulsionAppeal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintAppeal(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintAppeal(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Statement) > 0 {
+		i -= len(m.Statement)
+		copy(dAtA[i:], m.Statement)
+		i = encodeVarintAppeal(dAtA, i, uint64(len(m.Statement)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAppeal(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpelledAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpelledAt):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintAppeal(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintAppeal(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAppeal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAppeal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ExpulsionAppeal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovAppeal(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpelledAt)
+	n += 1 + l + sovAppeal(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline)
+	n += 1 + l + sovAppeal(uint64(l))
+	l = len(m.Statement)
+	if l > 0 {
+		n += 1 + l + sovAppeal(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovAppeal(uint64(m.ProposalId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovAppeal(uint64(m.Status))
+	}
+	return n
+}
+
+func sovAppeal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAppeal(x uint64) (n int) {
+	return sovAppeal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ExpulsionAppeal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAppeal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpulsionAppeal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpulsionAppeal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppeal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAppeal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAppeal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpelledAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppeal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAppeal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAppeal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpelledAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppeal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAppeal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAppeal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statement", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppeal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAppeal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAppeal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statement = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppeal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAppeal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= AppealStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAppeal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAppeal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAppeal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAppeal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAppeal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAppeal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAppeal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAppeal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAppeal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAppeal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAppeal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAppeal = fmt.Errorf("proto: unexpected end of group")
+)
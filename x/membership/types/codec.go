@@ -5,6 +5,7 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	gov_v1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/cosmos/cosmos-sdk/x/group"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -15,7 +16,30 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&AddGuardiansProposal{}, "membership/AddGuardiansProposal", nil)
 	cdc.RegisterConcrete(&RemoveGuardiansProposal{}, "membership/RemoveGuardiansProposal", nil)
 	cdc.RegisterConcrete(&UpdateTotalVotingWeightProposal{}, "membership/UpdateTotalVotingWeightProposal", nil)
+	cdc.RegisterConcrete(&SetVotingWeightDecayScheduleProposal{}, "membership/SetVotingWeightDecayScheduleProposal", nil)
+	cdc.RegisterConcrete(&PauseVotingWeightDecayProposal{}, "membership/PauseVotingWeightDecayProposal", nil)
+	cdc.RegisterConcrete(&UpdateGuardianWeightsProposal{}, "membership/UpdateGuardianWeightsProposal", nil)
 	cdc.RegisterConcrete(&MsgApproveMember{}, "membership/ApproveMember", nil)
+	cdc.RegisterConcrete(&MsgCreateInvitation{}, "membership/CreateInvitation", nil)
+	cdc.RegisterConcrete(&MsgRevokeInvitation{}, "membership/RevokeInvitation", nil)
+	cdc.RegisterConcrete(&MsgPetitionRecall{}, "membership/PetitionRecall", nil)
+	cdc.RegisterConcrete(&MsgSignRecallPetition{}, "membership/SignRecallPetition", nil)
+	cdc.RegisterConcrete(&MsgRecallMember{}, "membership/RecallMember", nil)
+	cdc.RegisterConcrete(&MsgAppealExpulsion{}, "membership/AppealExpulsion", nil)
+	cdc.RegisterConcrete(&MsgReinstateMember{}, "membership/ReinstateMember", nil)
+	cdc.RegisterConcrete(&MsgSuspendMember{}, "membership/SuspendMember", nil)
+	cdc.RegisterConcrete(&MsgDeclareCandidacy{}, "membership/DeclareCandidacy", nil)
+	cdc.RegisterConcrete(&MsgCastElectionBallot{}, "membership/CastElectionBallot", nil)
+	cdc.RegisterConcrete(&MsgDelegateVote{}, "membership/DelegateVote", nil)
+	cdc.RegisterConcrete(&MsgUndelegateVote{}, "membership/UndelegateVote", nil)
+	cdc.RegisterConcrete(&MsgEnableSecretBallot{}, "membership/EnableSecretBallot", nil)
+	cdc.RegisterConcrete(&MsgCommitVote{}, "membership/CommitVote", nil)
+	cdc.RegisterConcrete(&MsgRevealVote{}, "membership/RevealVote", nil)
+	cdc.RegisterConcrete(&MsgCreateElectorateGroup{}, "membership/CreateElectorateGroup", nil)
+	cdc.RegisterConcrete(&ElectorateDecisionPolicy{}, "membership/ElectorateDecisionPolicy", nil)
+	cdc.RegisterConcrete(&MsgDonate{}, "membership/Donate", nil)
+	cdc.RegisterConcrete(&MsgTreasurySpend{}, "membership/TreasurySpend", nil)
+	cdc.RegisterConcrete(&MsgClaimMemberRewards{}, "membership/ClaimMemberRewards", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -35,9 +59,58 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*gov_v1beta1.Content)(nil),
 		&UpdateTotalVotingWeightProposal{},
 	)
+	registry.RegisterImplementations((*gov_v1beta1.Content)(nil),
+		&SetVotingWeightDecayScheduleProposal{},
+		&PauseVotingWeightDecayProposal{},
+	)
+	registry.RegisterImplementations((*gov_v1beta1.Content)(nil),
+		&UpdateGuardianWeightsProposal{},
+	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgApproveMember{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateInvitation{},
+		&MsgRevokeInvitation{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgPetitionRecall{},
+		&MsgSignRecallPetition{},
+		&MsgRecallMember{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAppealExpulsion{},
+		&MsgReinstateMember{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSuspendMember{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDeclareCandidacy{},
+		&MsgCastElectionBallot{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDelegateVote{},
+		&MsgUndelegateVote{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgEnableSecretBallot{},
+		&MsgCommitVote{},
+		&MsgRevealVote{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateElectorateGroup{},
+	)
+	registry.RegisterImplementations((*group.DecisionPolicy)(nil),
+		&ElectorateDecisionPolicy{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDonate{},
+		&MsgTreasurySpend{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgClaimMemberRewards{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
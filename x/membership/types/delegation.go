@@ -0,0 +1,31 @@
+package types
+
+// AppliesTo returns true if the delegation covers a proposal with the given
+// messages, each listed by its type URLs. A scoped delegation only covers
+// proposals whose messages all have a type in scope.
+func (d VoteDelegation) AppliesTo(msgTypeURLs [][]string) bool {
+	if len(d.MsgTypeUrls) == 0 {
+		return true
+	}
+	if len(msgTypeURLs) == 0 {
+		return false
+	}
+
+	scope := make(map[string]bool, len(d.MsgTypeUrls))
+	for _, msgType := range d.MsgTypeUrls {
+		scope[msgType] = true
+	}
+	for _, urls := range msgTypeURLs {
+		inScope := false
+		for _, url := range urls {
+			if scope[url] {
+				inScope = true
+				break
+			}
+		}
+		if !inScope {
+			return false
+		}
+	}
+	return true
+}
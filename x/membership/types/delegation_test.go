@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVoteDelegation_AppliesTo(t *testing.T) {
+	const (
+		msgSend       = "/cosmos.bank.v1beta1.MsgSend"
+		msgUpgrade    = "/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade"
+		msgExecLegacy = "/cosmos.gov.v1.MsgExecLegacyContent"
+		addGuardians  = "/membershipmodule.membership.AddGuardiansProposal"
+	)
+
+	for _, tc := range []struct {
+		desc        string
+		scope       []string
+		msgTypeURLs [][]string
+		applies     bool
+	}{
+		{
+			desc:        "unscoped delegation covers every proposal",
+			msgTypeURLs: [][]string{{msgSend}, {msgUpgrade}},
+			applies:     true,
+		},
+		{
+			desc:    "unscoped delegation covers proposals without messages",
+			applies: true,
+		},
+		{
+			desc:        "scoped delegation covers proposals within scope",
+			scope:       []string{msgSend, msgUpgrade},
+			msgTypeURLs: [][]string{{msgSend}, {msgUpgrade}},
+			applies:     true,
+		},
+		{
+			desc:        "scoped delegation does not cover proposals partly out of scope",
+			scope:       []string{msgSend},
+			msgTypeURLs: [][]string{{msgSend}, {msgUpgrade}},
+			applies:     false,
+		},
+		{
+			desc:    "scoped delegation does not cover proposals without messages",
+			scope:   []string{msgSend},
+			applies: false,
+		},
+		{
+			desc:        "scoped delegation covers the content of legacy proposals",
+			scope:       []string{addGuardians},
+			msgTypeURLs: [][]string{{addGuardians, msgExecLegacy}},
+			applies:     true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			delegation := types.VoteDelegation{MsgTypeUrls: tc.scope}
+			require.Equal(t, tc.applies, delegation.AppliesTo(tc.msgTypeURLs))
+		})
+	}
+}
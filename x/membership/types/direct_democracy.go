@@ -2,6 +2,7 @@ package types
 
 import (
 	fmt "fmt"
+	"time"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,6 +13,10 @@ const (
 	MAXIMUM_TOTAL_VOTING_WEIGHT = "1"
 )
 
+// DefaultGuardianWeight is the relative weight of a guardian without a weight
+// of their own
+const DefaultGuardianWeight uint64 = 1
+
 // DirectDemocracy is the struct that contains the direct democracy state
 func DefaultDirectDemocracy() DirectDemocracy {
 	return DirectDemocracy{
@@ -45,5 +50,133 @@ func (dd DirectDemocracy) Validate() error {
 		addresses[guardian] = true
 	}
 
+	// Keep a temporary map of weighted guardian addresses
+	weighted := make(map[string]bool)
+
+	for _, gw := range dd.GuardianWeights {
+		// Weights can only be given to guardians
+		if !addresses[gw.Guardian] {
+			return fmt.Errorf("weighted address is not a guardian: %s", gw.Guardian)
+		}
+
+		// Cannot weight the same guardian twice
+		if weighted[gw.Guardian] {
+			return fmt.Errorf("duplicate guardian weight: %s", gw.Guardian)
+		}
+
+		if gw.Weight == 0 {
+			return fmt.Errorf("guardian weight must be positive: %s", gw.Guardian)
+		}
+
+		weighted[gw.Guardian] = true
+	}
+
+	if dd.DecaySchedule != nil {
+		return dd.DecaySchedule.Validate()
+	}
+
+	return nil
+}
+
+// GetGuardianWeight returns the relative weight of the guardian
+func (dd DirectDemocracy) GetGuardianWeight(guardian string) uint64 {
+	for _, gw := range dd.GuardianWeights {
+		if gw.Guardian == guardian {
+			return gw.Weight
+		}
+	}
+	return DefaultGuardianWeight
+}
+
+// SetGuardianWeight sets the relative weight of the guardian. Guardians with
+// the default weight are not stored.
+func (dd *DirectDemocracy) SetGuardianWeight(guardian string, weight uint64) {
+	var weights []GuardianWeight
+	for _, gw := range dd.GuardianWeights {
+		if gw.Guardian != guardian {
+			weights = append(weights, gw)
+		}
+	}
+	if weight != DefaultGuardianWeight {
+		weights = append(weights, GuardianWeight{Guardian: guardian, Weight: weight})
+	}
+	dd.GuardianWeights = weights
+}
+
+// PruneGuardianWeights drops the weights of addresses that are no longer
+// listed as guardians
+func (dd *DirectDemocracy) PruneGuardianWeights() {
+	listed := make(map[string]bool, len(dd.Guardians))
+	for _, guardian := range dd.Guardians {
+		listed[guardian] = true
+	}
+
+	var weights []GuardianWeight
+	for _, gw := range dd.GuardianWeights {
+		if listed[gw.Guardian] {
+			weights = append(weights, gw)
+		}
+	}
+	dd.GuardianWeights = weights
+}
+
+// Validate ensures the decay schedule's weights are between 0 and 1, and that
+// it lowers the weight over a positive length of time
+func (s VotingWeightDecaySchedule) Validate() error {
+	min := math.LegacyMustNewDecFromStr(MINIMUM_TOTAL_VOTING_WEIGHT)
+	max := math.LegacyMustNewDecFromStr(MAXIMUM_TOTAL_VOTING_WEIGHT)
+
+	if s.StartWeight.IsNil() || s.StartWeight.LT(min) || s.StartWeight.GT(max) {
+		return fmt.Errorf("start weight must be between 0 and 1, inclusive: %s", s.StartWeight)
+	}
+	if s.FloorWeight.IsNil() || s.FloorWeight.LT(min) || s.FloorWeight.GT(s.StartWeight) {
+		return fmt.Errorf("floor weight must be between 0 and the start weight, inclusive: %s", s.FloorWeight)
+	}
+
+	switch s.Mode {
+	case DecayMode_DecayModeStep:
+		if s.Step.IsNil() || !s.Step.IsPositive() {
+			return fmt.Errorf("step must be positive")
+		}
+		if s.Interval <= 0 {
+			return fmt.Errorf("interval must be positive: %s", s.Interval)
+		}
+	case DecayMode_DecayModeLinear:
+		if s.Span <= 0 {
+			return fmt.Errorf("span must be positive: %s", s.Span)
+		}
+	default:
+		return fmt.Errorf("unsupported decay mode: %s", s.Mode)
+	}
+
 	return nil
 }
+
+// WeightAt returns the total voting weight the schedule prescribes at the
+// given time, which never falls below the floor weight
+func (s VotingWeightDecaySchedule) WeightAt(t time.Time) sdk.Dec {
+	if !t.After(s.StartTime) {
+		return s.StartWeight
+	}
+	elapsed := t.Sub(s.StartTime)
+
+	var weight sdk.Dec
+	switch s.Mode {
+	case DecayMode_DecayModeStep:
+		steps := int64(elapsed / s.Interval)
+		weight = s.StartWeight.Sub(s.Step.MulInt64(steps))
+	case DecayMode_DecayModeLinear:
+		if elapsed >= s.Span {
+			return s.FloorWeight
+		}
+		fraction := sdk.NewDec(int64(elapsed)).QuoInt64(int64(s.Span))
+		weight = s.StartWeight.Sub(s.StartWeight.Sub(s.FloorWeight).Mul(fraction))
+	default:
+		return s.StartWeight
+	}
+
+	if weight.LT(s.FloorWeight) {
+		return s.FloorWeight
+	}
+	return weight
+}
@@ -8,15 +8,20 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -24,12 +29,49 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DecayMode enumerates the ways a decay schedule lowers the total voting weight
+type DecayMode int32
+
+const (
+	// DECAY_MODE_UNSPECIFIED defines a no-op mode
+	DecayMode_DecayModeEmpty DecayMode = 0
+	// DECAY_MODE_STEP lowers the weight by a fixed amount at every interval
+	DecayMode_DecayModeStep DecayMode = 1
+	// DECAY_MODE_LINEAR lowers the weight continuously over a time span
+	DecayMode_DecayModeLinear DecayMode = 2
+)
+
+var DecayMode_name = map[int32]string{
+	0: "DECAY_MODE_UNSPECIFIED",
+	1: "DECAY_MODE_STEP",
+	2: "DECAY_MODE_LINEAR",
+}
+
+var DecayMode_value = map[string]int32{
+	"DECAY_MODE_UNSPECIFIED": 0,
+	"DECAY_MODE_STEP":        1,
+	"DECAY_MODE_LINEAR":      2,
+}
+
+func (x DecayMode) String() string {
+	return proto.EnumName(DecayMode_name, int32(x))
+}
+
+func (DecayMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dc1833cea5478edf, []int{0}
+}
+
 // DirectDemocracy holds the list of guardians and the total voting weight percentage available to them
 type DirectDemocracy struct {
-	// Total voting weight percentage available to the Guardians, divided equally among them
+	// Total voting weight percentage available to the Guardians, divided among them by their relative weights
 	TotalVotingWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=total_voting_weight,json=totalVotingWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_voting_weight,omitempty"`
 	// Guardians is the list of members who have elevated democratic privileges
 	Guardians []string `protobuf:"bytes,2,rep,name=guardians,proto3" json:"guardians,omitempty"`
+	// Schedule that gradually lowers the total voting weight, if any
+	DecaySchedule *VotingWeightDecaySchedule `protobuf:"bytes,3,opt,name=decay_schedule,json=decaySchedule,proto3" json:"decay_schedule,omitempty"`
+	// Guardian weights are the relative weights of the guardians. Guardians
+	// without a weight have the default weight of 1.
+	GuardianWeights []GuardianWeight `protobuf:"bytes,4,rep,name=guardian_weights,json=guardianWeights,proto3" json:"guardian_weights,omitempty"`
 }
 
 func (m *DirectDemocracy) Reset()         { *m = DirectDemocracy{} }
@@ -72,8 +114,178 @@ func (m *DirectDemocracy) GetGuardians() []string {
 	return nil
 }
 
+func (m *DirectDemocracy) GetDecaySchedule() *VotingWeightDecaySchedule {
+	if m != nil {
+		return m.DecaySchedule
+	}
+	return nil
+}
+
+func (m *DirectDemocracy) GetGuardianWeights() []GuardianWeight {
+	if m != nil {
+		return m.GuardianWeights
+	}
+	return nil
+}
+
+// GuardianWeight is the relative weight of a guardian's vote
+type GuardianWeight struct {
+	// Guardian is the address of the guardian
+	Guardian string `protobuf:"bytes,1,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// Weight is the guardian's weight relative to the other guardians
+	Weight uint64 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *GuardianWeight) Reset()         { *m = GuardianWeight{} }
+func (m *GuardianWeight) String() string { return proto.CompactTextString(m) }
+func (*GuardianWeight) ProtoMessage()    {}
+func (*GuardianWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc1833cea5478edf, []int{1}
+}
+func (m *GuardianWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GuardianWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GuardianWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GuardianWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GuardianWeight.Merge(m, src)
+}
+func (m *GuardianWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *GuardianWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_GuardianWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GuardianWeight proto.InternalMessageInfo
+
+func (m *GuardianWeight) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+func (m *GuardianWeight) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+// VotingWeightDecaySchedule gradually lowers the guardians' total voting
+// weight from a start weight down to a floor
+type VotingWeightDecaySchedule struct {
+	// Mode is the way the weight is lowered
+	Mode DecayMode `protobuf:"varint,1,opt,name=mode,proto3,enum=membershipmodule.membership.DecayMode" json:"mode,omitempty"`
+	// Start weight is the total voting weight when the schedule starts
+	StartWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=start_weight,json=startWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"start_weight,omitempty"`
+	// Floor weight is the lowest total voting weight the schedule reaches
+	FloorWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=floor_weight,json=floorWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"floor_weight,omitempty"`
+	// Start time is the time the schedule starts
+	StartTime time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	// Step is the amount the weight is lowered by at every interval, in step mode
+	Step github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=step,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"step,omitempty"`
+	// Interval is the time between steps, in step mode
+	Interval time.Duration `protobuf:"bytes,6,opt,name=interval,proto3,stdduration" json:"interval,omitempty"`
+	// Span is the time taken to reach the floor weight, in linear mode
+	Span time.Duration `protobuf:"bytes,7,opt,name=span,proto3,stdduration" json:"span,omitempty"`
+	// Paused is true while the schedule is paused
+	Paused bool `protobuf:"varint,8,opt,name=paused,proto3" json:"paused,omitempty"`
+	// Paused at is the time the schedule was paused
+	PausedAt time.Time `protobuf:"bytes,9,opt,name=paused_at,json=pausedAt,proto3,stdtime" json:"paused_at,omitempty"`
+}
+
+func (m *VotingWeightDecaySchedule) Reset()         { *m = VotingWeightDecaySchedule{} }
+func (m *VotingWeightDecaySchedule) String() string { return proto.CompactTextString(m) }
+func (*VotingWeightDecaySchedule) ProtoMessage()    {}
+func (*VotingWeightDecaySchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc1833cea5478edf, []int{2}
+}
+func (m *VotingWeightDecaySchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotingWeightDecaySchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotingWeightDecaySchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VotingWeightDecaySchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotingWeightDecaySchedule.Merge(m, src)
+}
+func (m *VotingWeightDecaySchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotingWeightDecaySchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotingWeightDecaySchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VotingWeightDecaySchedule proto.InternalMessageInfo
+
+func (m *VotingWeightDecaySchedule) GetMode() DecayMode {
+	if m != nil {
+		return m.Mode
+	}
+	return DecayMode_DecayModeEmpty
+}
+
+func (m *VotingWeightDecaySchedule) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *VotingWeightDecaySchedule) GetInterval() time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *VotingWeightDecaySchedule) GetSpan() time.Duration {
+	if m != nil {
+		return m.Span
+	}
+	return 0
+}
+
+func (m *VotingWeightDecaySchedule) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *VotingWeightDecaySchedule) GetPausedAt() time.Time {
+	if m != nil {
+		return m.PausedAt
+	}
+	return time.Time{}
+}
+
 func init() {
+	proto.RegisterEnum("membershipmodule.membership.DecayMode", DecayMode_name, DecayMode_value)
 	proto.RegisterType((*DirectDemocracy)(nil), "membershipmodule.membership.DirectDemocracy")
+	proto.RegisterType((*GuardianWeight)(nil), "membershipmodule.membership.GuardianWeight")
+	proto.RegisterType((*VotingWeightDecaySchedule)(nil), "membershipmodule.membership.VotingWeightDecaySchedule")
 }
 
 func init() {
@@ -81,26 +293,56 @@ func init() {
 }
 
 var fileDescriptor_dc1833cea5478edf = []byte{
-	// 290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xca, 0x4d, 0xcd, 0x4d,
-	0x4a, 0x2d, 0x2a, 0xce, 0xc8, 0x2c, 0xc8, 0xcd, 0x4f, 0x29, 0xcd, 0x49, 0xd5, 0x47, 0x08, 0xe8,
-	0xa7, 0x64, 0x16, 0xa5, 0x26, 0x97, 0xc4, 0xa7, 0xa4, 0xe6, 0xe6, 0x27, 0x17, 0x25, 0x26, 0x57,
-	0xea, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x49, 0xa3, 0xeb, 0xd1, 0x43, 0x08, 0x48, 0x89, 0xa4,
-	0xe7, 0xa7, 0xe7, 0x83, 0xd5, 0xe9, 0x83, 0x58, 0x10, 0x2d, 0x4a, 0x67, 0x19, 0xb9, 0xf8, 0x5d,
-	0xc0, 0xa6, 0xb9, 0xc0, 0x0c, 0x13, 0xaa, 0xe7, 0x12, 0x2e, 0xc9, 0x2f, 0x49, 0xcc, 0x89, 0x2f,
-	0xcb, 0x2f, 0xc9, 0xcc, 0x4b, 0x8f, 0x2f, 0x4f, 0xcd, 0x4c, 0xcf, 0x28, 0x91, 0x60, 0x54, 0x60,
-	0xd4, 0xe0, 0x71, 0xf2, 0x3f, 0x71, 0x4f, 0x9e, 0xe1, 0xd6, 0x3d, 0x79, 0xb5, 0xf4, 0xcc, 0x92,
-	0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xe4, 0xfc, 0xe2, 0xdc, 0xfc, 0x62, 0x28, 0xa5,
-	0x5b, 0x9c, 0x92, 0xad, 0x5f, 0x52, 0x59, 0x90, 0x5a, 0xac, 0xe7, 0x92, 0x9a, 0xfc, 0xea, 0x9e,
-	0xbc, 0x2c, 0x16, 0xc3, 0x74, 0xf2, 0x73, 0x33, 0x4b, 0x52, 0x73, 0x0b, 0x4a, 0x2a, 0x83, 0x04,
-	0xc1, 0xd2, 0x61, 0x60, 0xd9, 0x70, 0xb0, 0xa4, 0x90, 0x25, 0x17, 0x67, 0x7a, 0x69, 0x62, 0x51,
-	0x4a, 0x66, 0x62, 0x5e, 0xb1, 0x04, 0x93, 0x02, 0xb3, 0x06, 0xa7, 0x93, 0x34, 0xc8, 0xda, 0x57,
-	0xf7, 0xe4, 0x85, 0xe1, 0x12, 0x48, 0x46, 0x20, 0x54, 0x3b, 0x05, 0x9f, 0x78, 0x24, 0xc7, 0x78,
-	0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7,
-	0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x25, 0x92, 0x83, 0xf3, 0xf2, 0x8b, 0x32, 0x13, 0x75, 0xf3,
-	0x52, 0x4b, 0xf4, 0x21, 0xe1, 0xa4, 0x8b, 0x14, 0xb6, 0x15, 0xc8, 0x01, 0x0d, 0xf6, 0x47, 0x12,
-	0x1b, 0x38, 0xac, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb9, 0x13, 0x23, 0x67, 0x94, 0x01,
-	0x00, 0x00,
+	// 771 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4d, 0x6f, 0xeb, 0x44,
+	0x14, 0x8d, 0x9b, 0x10, 0x92, 0x69, 0x5e, 0x92, 0x4e, 0xab, 0xca, 0xcf, 0x0f, 0x6c, 0x2b, 0x8b,
+	0x2a, 0x7a, 0xb4, 0xb6, 0x14, 0x24, 0xa4, 0xb2, 0xa2, 0xae, 0x03, 0x44, 0xea, 0x97, 0x92, 0x96,
+	0xcf, 0x85, 0xe5, 0xd8, 0x53, 0xc7, 0x6a, 0xec, 0xb1, 0xec, 0x49, 0x21, 0xb0, 0x60, 0xdf, 0x05,
+	0x74, 0xc9, 0xa6, 0x7f, 0x07, 0x75, 0xd9, 0x25, 0x62, 0x11, 0x50, 0xbb, 0xcb, 0xaf, 0x40, 0x1e,
+	0x7f, 0x64, 0x1a, 0xa0, 0x55, 0x59, 0xd9, 0x33, 0xf7, 0x9c, 0x73, 0xcf, 0x9d, 0x3b, 0xd7, 0x06,
+	0x1d, 0x0f, 0x79, 0x43, 0x14, 0x46, 0x23, 0x37, 0xf0, 0xb0, 0x3d, 0x19, 0x23, 0x75, 0xb1, 0xa1,
+	0xda, 0x6e, 0x88, 0x2c, 0x62, 0xd8, 0xc8, 0xc3, 0x56, 0x68, 0x5a, 0x53, 0x25, 0x08, 0x31, 0xc1,
+	0xf0, 0xcd, 0x32, 0x47, 0x59, 0x6c, 0x08, 0x1b, 0x0e, 0x76, 0x30, 0xc5, 0xa9, 0xf1, 0x5b, 0x42,
+	0x11, 0x44, 0x07, 0x63, 0x67, 0x8c, 0x54, 0xba, 0x1a, 0x4e, 0xce, 0x55, 0x7b, 0x12, 0x9a, 0xc4,
+	0xc5, 0x7e, 0x1a, 0x97, 0x96, 0xe3, 0xc4, 0xf5, 0x50, 0x44, 0x4c, 0x2f, 0x48, 0x00, 0xad, 0xdf,
+	0x8a, 0xa0, 0xa1, 0x53, 0x3b, 0x7a, 0xe6, 0x06, 0xfe, 0x04, 0xd6, 0x09, 0x26, 0xe6, 0xd8, 0xb8,
+	0xc4, 0xc4, 0xf5, 0x1d, 0xe3, 0x3b, 0xe4, 0x3a, 0x23, 0xc2, 0x73, 0x32, 0xd7, 0xae, 0x69, 0xc7,
+	0xb7, 0x33, 0xa9, 0xf0, 0xc7, 0x4c, 0xda, 0x72, 0x5c, 0x32, 0x9a, 0x0c, 0x15, 0x0b, 0x7b, 0xaa,
+	0x85, 0x23, 0x0f, 0x47, 0xe9, 0x63, 0x27, 0xb2, 0x2f, 0x54, 0x32, 0x0d, 0x50, 0xa4, 0xe8, 0xc8,
+	0x9a, 0xcf, 0xa4, 0xf7, 0xff, 0x45, 0x6c, 0x1b, 0x7b, 0x2e, 0x41, 0x5e, 0x40, 0xa6, 0xfd, 0x35,
+	0x1a, 0xfe, 0x82, 0x46, 0xbf, 0xa4, 0x41, 0xb8, 0x0b, 0xaa, 0xce, 0xc4, 0x0c, 0x6d, 0xd7, 0xf4,
+	0x23, 0x7e, 0x45, 0x2e, 0xb6, 0xab, 0xda, 0x9b, 0x38, 0xed, 0x7c, 0x26, 0xad, 0xe7, 0x01, 0x46,
+	0x62, 0x81, 0x86, 0x3f, 0x82, 0xba, 0x8d, 0x2c, 0x73, 0x6a, 0x44, 0xd6, 0x08, 0xc5, 0x67, 0xc8,
+	0x17, 0x65, 0xae, 0xbd, 0xda, 0xf9, 0x48, 0x79, 0xe2, 0x70, 0x15, 0x36, 0xbb, 0x1e, 0xd3, 0x07,
+	0x29, 0x5b, 0x7b, 0x6f, 0x3e, 0x93, 0xf8, 0xc7, 0x8a, 0x4c, 0xe2, 0x57, 0x36, 0x0b, 0x86, 0x3f,
+	0x80, 0x66, 0xe6, 0x24, 0xad, 0x33, 0xe2, 0x4b, 0x72, 0xb1, 0xbd, 0xda, 0xf9, 0xe0, 0xc9, 0xf4,
+	0x9f, 0xa5, 0xa4, 0xc4, 0x80, 0xd6, 0x4a, 0x6b, 0x15, 0x96, 0xc5, 0x98, 0xcc, 0x0d, 0xe7, 0x11,
+	0x27, 0x6a, 0xe9, 0xa0, 0xfe, 0x58, 0x06, 0x0a, 0xa0, 0x92, 0x81, 0x68, 0xef, 0xaa, 0xfd, 0x7c,
+	0x0d, 0x37, 0x41, 0x39, 0xed, 0xea, 0x8a, 0xcc, 0xb5, 0x4b, 0xfd, 0x74, 0xd5, 0xfa, 0xb9, 0x0c,
+	0x5e, 0xff, 0xe7, 0x61, 0xc0, 0x8f, 0x41, 0xc9, 0xc3, 0x36, 0xa2, 0x6a, 0xf5, 0xce, 0xd6, 0x93,
+	0x35, 0x51, 0xe6, 0x21, 0xb6, 0x51, 0x9f, 0x72, 0xe0, 0x05, 0xa8, 0x45, 0xc4, 0x0c, 0x89, 0xc1,
+	0xe4, 0xad, 0x69, 0x9f, 0xbf, 0xf8, 0x36, 0x6d, 0xb2, 0x2a, 0xcc, 0x81, 0xac, 0xd2, 0xfd, 0xb4,
+	0xf4, 0x0b, 0x50, 0x3b, 0x1f, 0x63, 0x1c, 0x66, 0xc9, 0x8a, 0xff, 0x37, 0x19, 0xab, 0xc2, 0x26,
+	0xa3, 0xfb, 0x69, 0xb2, 0x6f, 0x01, 0x48, 0x3c, 0xc5, 0xb3, 0xc5, 0x97, 0xe8, 0x75, 0x13, 0x94,
+	0x64, 0xf0, 0x94, 0x6c, 0xf0, 0x94, 0xd3, 0x6c, 0xf0, 0x34, 0x39, 0x6d, 0xef, 0xc6, 0x82, 0xb5,
+	0x90, 0xbe, 0xfe, 0x53, 0xe2, 0xfa, 0x55, 0x1a, 0x89, 0x19, 0xf0, 0x14, 0x94, 0x22, 0x82, 0x02,
+	0xfe, 0x1d, 0x5a, 0xc1, 0x27, 0x2f, 0xae, 0xa0, 0x1e, 0xb3, 0x19, 0xe7, 0x54, 0x0d, 0x9e, 0x81,
+	0x8a, 0xeb, 0x13, 0x14, 0x5e, 0x9a, 0x63, 0xbe, 0x4c, 0x0d, 0xbf, 0xfe, 0x87, 0x61, 0x3d, 0xfd,
+	0x92, 0x68, 0x62, 0xea, 0x17, 0x66, 0x94, 0x85, 0xdc, 0xaf, 0xb1, 0xdb, 0x5c, 0x0a, 0xf6, 0x40,
+	0x29, 0x0a, 0x4c, 0x9f, 0x7f, 0xf7, 0x39, 0x49, 0x21, 0x95, 0xac, 0xc7, 0xf0, 0x25, 0x39, 0x2a,
+	0x01, 0xb7, 0x41, 0x39, 0x30, 0x27, 0x11, 0xb2, 0xf9, 0x8a, 0xcc, 0xb5, 0x2b, 0xda, 0xc6, 0x7c,
+	0x26, 0x35, 0x93, 0x1d, 0xa6, 0x9a, 0x14, 0x03, 0xbf, 0x02, 0xd5, 0xe4, 0xcd, 0x30, 0x09, 0x5f,
+	0x7d, 0xb6, 0x03, 0x52, 0xf6, 0x31, 0xc9, 0x49, 0x4b, 0x0d, 0xa8, 0x24, 0x81, 0x3d, 0xf2, 0xf6,
+	0x17, 0x0e, 0x54, 0xf3, 0xab, 0x0c, 0x15, 0xb0, 0xa9, 0x77, 0xf7, 0xf7, 0xbe, 0x36, 0x0e, 0x8f,
+	0xf5, 0xae, 0x71, 0x76, 0x34, 0x38, 0xe9, 0xee, 0xf7, 0x3e, 0xed, 0x75, 0xf5, 0x66, 0x41, 0x80,
+	0x57, 0x37, 0x72, 0x3d, 0x87, 0x76, 0x63, 0x2d, 0xb8, 0x05, 0x1a, 0x0c, 0x7e, 0x70, 0xda, 0x3d,
+	0x69, 0x72, 0xc2, 0xda, 0xd5, 0x8d, 0xfc, 0x2a, 0x07, 0x0e, 0xe2, 0x7e, 0xbc, 0x05, 0x6b, 0x0c,
+	0xee, 0xa0, 0x77, 0xd4, 0xdd, 0xeb, 0x37, 0x57, 0x84, 0xf5, 0xab, 0x1b, 0xb9, 0x91, 0x23, 0x0f,
+	0x5c, 0x1f, 0x99, 0xa1, 0x36, 0xb8, 0xbd, 0x17, 0xb9, 0xbb, 0x7b, 0x91, 0xfb, 0xeb, 0x5e, 0xe4,
+	0xae, 0x1f, 0xc4, 0xc2, 0xdd, 0x83, 0x58, 0xf8, 0xfd, 0x41, 0x2c, 0x7c, 0xb3, 0xcb, 0xdc, 0x0a,
+	0x1f, 0x87, 0xae, 0xb9, 0xe3, 0x23, 0xa2, 0x26, 0xa3, 0xb9, 0xc3, 0xfc, 0x7e, 0xbe, 0x67, 0xff,
+	0x45, 0xf4, 0xb2, 0x0c, 0xcb, 0xf4, 0x94, 0x3e, 0xfc, 0x7b, 0x00, 0xf5, 0x44, 0x92, 0xff, 0xb7,
+	0x06, 0x00, 0x00,
 }
 
 func (m *DirectDemocracy) Marshal() (dAtA []byte, err error) {
@@ -123,6 +365,32 @@ func (m *DirectDemocracy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GuardianWeights) > 0 {
+		for iNdEx := len(m.GuardianWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GuardianWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDirectDemocracy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.DecaySchedule != nil {
+		{
+			size, err := m.DecaySchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDirectDemocracy(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Guardians) > 0 {
 		for iNdEx := len(m.Guardians) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Guardians[iNdEx])
@@ -145,6 +413,141 @@ func (m *DirectDemocracy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GuardianWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GuardianWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GuardianWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintDirectDemocracy(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintDirectDemocracy(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VotingWeightDecaySchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotingWeightDecaySchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotingWeightDecaySchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PausedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PausedAt):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintDirectDemocracy(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x4a
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Span, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Span):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintDirectDemocracy(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x3a
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintDirectDemocracy(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Step.Size()
+		i -= size
+		if _, err := m.Step.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDirectDemocracy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintDirectDemocracy(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.FloorWeight.Size()
+		i -= size
+		if _, err := m.FloorWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDirectDemocracy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.StartWeight.Size()
+		i -= size
+		if _, err := m.StartWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDirectDemocracy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Mode != 0 {
+		i = encodeVarintDirectDemocracy(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDirectDemocracy(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDirectDemocracy(v)
 	base := offset
@@ -170,6 +573,61 @@ func (m *DirectDemocracy) Size() (n int) {
 			n += 1 + l + sovDirectDemocracy(uint64(l))
 		}
 	}
+	if m.DecaySchedule != nil {
+		l = m.DecaySchedule.Size()
+		n += 1 + l + sovDirectDemocracy(uint64(l))
+	}
+	if len(m.GuardianWeights) > 0 {
+		for _, e := range m.GuardianWeights {
+			l = e.Size()
+			n += 1 + l + sovDirectDemocracy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GuardianWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovDirectDemocracy(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovDirectDemocracy(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *VotingWeightDecaySchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		n += 1 + sovDirectDemocracy(uint64(m.Mode))
+	}
+	l = m.StartWeight.Size()
+	n += 1 + l + sovDirectDemocracy(uint64(l))
+	l = m.FloorWeight.Size()
+	n += 1 + l + sovDirectDemocracy(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovDirectDemocracy(uint64(l))
+	l = m.Step.Size()
+	n += 1 + l + sovDirectDemocracy(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovDirectDemocracy(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Span)
+	n += 1 + l + sovDirectDemocracy(uint64(l))
+	if m.Paused {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PausedAt)
+	n += 1 + l + sovDirectDemocracy(uint64(l))
 	return n
 }
 
@@ -273,6 +731,497 @@ func (m *DirectDemocracy) Unmarshal(dAtA []byte) error {
 			}
 			m.Guardians = append(m.Guardians, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecaySchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDirectDemocracy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDirectDemocracy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDirectDemocracy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecaySchedule == nil {
+				m.DecaySchedule = &VotingWeightDecaySchedule{}
+			}
+			if err := m.DecaySchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDirectDemocracy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDirectDemocracy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDirectDemocracy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GuardianWeights = append(m.GuardianWeights, GuardianWeight{})
+			if err := m.GuardianWeights[len(m.GuardianWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDirectDemocracy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDirectDemocracy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GuardianWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDirectDemocracy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GuardianWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GuardianWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDirectDemocracy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDirectDemocracy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDirectDemocracy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDirectDemocracy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDirectDemocracy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDirectDemocracy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VotingWeightDecaySchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDirectDemocracy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VotingWeightDecaySchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VotingWeightDecaySchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDirectDemocracy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= DecayMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartWeight", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDirectDemocracy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDirectDemocracy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDirectDemocracy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloorWeight", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDirectDemocracy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDirectDemocracy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDirectDemocracy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FloorWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDirectDemocracy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDirectDemocracy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDirectDemocracy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDirectDemocracy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDirectDemocracy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDirectDemocracy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Step.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDirectDemocracy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDirectDemocracy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDirectDemocracy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Span", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDirectDemocracy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDirectDemocracy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDirectDemocracy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Span, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDirectDemocracy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDirectDemocracy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDirectDemocracy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDirectDemocracy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PausedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDirectDemocracy(dAtA[iNdEx:])
@@ -0,0 +1,113 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVotingWeightDecaySchedule_WeightAt(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	step := VotingWeightDecaySchedule{
+		Mode:        DecayMode_DecayModeStep,
+		StartWeight: sdk.NewDecWithPrec(5, 1),
+		FloorWeight: sdk.NewDecWithPrec(15, 2),
+		StartTime:   start,
+		Step:        sdk.NewDecWithPrec(1, 1),
+		Interval:    time.Hour,
+	}
+	require.NoError(t, step.Validate())
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), step.WeightAt(start.Add(-time.Hour)))
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), step.WeightAt(start.Add(59*time.Minute)))
+	require.Equal(t, sdk.NewDecWithPrec(4, 1), step.WeightAt(start.Add(time.Hour)))
+	require.Equal(t, sdk.NewDecWithPrec(2, 1), step.WeightAt(start.Add(3*time.Hour)))
+	// Never falls below the floor
+	require.Equal(t, sdk.NewDecWithPrec(15, 2), step.WeightAt(start.Add(4*time.Hour)))
+
+	linear := VotingWeightDecaySchedule{
+		Mode:        DecayMode_DecayModeLinear,
+		StartWeight: sdk.NewDecWithPrec(5, 1),
+		FloorWeight: sdk.NewDecWithPrec(1, 1),
+		StartTime:   start,
+		Span:        4 * time.Hour,
+	}
+	require.NoError(t, linear.Validate())
+	require.Equal(t, sdk.NewDecWithPrec(4, 1), linear.WeightAt(start.Add(time.Hour)))
+	require.Equal(t, sdk.NewDecWithPrec(3, 1), linear.WeightAt(start.Add(2*time.Hour)))
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), linear.WeightAt(start.Add(5*time.Hour)))
+}
+
+func TestVotingWeightDecaySchedule_Validate(t *testing.T) {
+	valid := func() VotingWeightDecaySchedule {
+		return VotingWeightDecaySchedule{
+			Mode:        DecayMode_DecayModeStep,
+			StartWeight: sdk.NewDecWithPrec(5, 1),
+			FloorWeight: sdk.ZeroDec(),
+			Step:        sdk.NewDecWithPrec(1, 1),
+			Interval:    time.Hour,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		change func(s *VotingWeightDecaySchedule)
+		valid  bool
+	}{
+		{name: "valid schedule", change: func(s *VotingWeightDecaySchedule) {}, valid: true},
+		{name: "start weight above 1", change: func(s *VotingWeightDecaySchedule) { s.StartWeight = sdk.NewDec(2) }},
+		{name: "floor above start weight", change: func(s *VotingWeightDecaySchedule) { s.FloorWeight = sdk.NewDecWithPrec(6, 1) }},
+		{name: "negative floor", change: func(s *VotingWeightDecaySchedule) { s.FloorWeight = sdk.NewDec(-1) }},
+		{name: "zero step", change: func(s *VotingWeightDecaySchedule) { s.Step = sdk.ZeroDec() }},
+		{name: "zero interval", change: func(s *VotingWeightDecaySchedule) { s.Interval = 0 }},
+		{name: "linear without span", change: func(s *VotingWeightDecaySchedule) { s.Mode = DecayMode_DecayModeLinear }},
+		{name: "unspecified mode", change: func(s *VotingWeightDecaySchedule) { s.Mode = DecayMode_DecayModeEmpty }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule := valid()
+			tt.change(&schedule)
+			err := schedule.Validate()
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestDirectDemocracy_GuardianWeights(t *testing.T) {
+	founder := sample.AccAddress()
+	newcomer := sample.AccAddress()
+
+	dd := DefaultDirectDemocracy()
+	dd.Guardians = []string{founder, newcomer}
+
+	// Guardians start with the default weight
+	require.Equal(t, DefaultGuardianWeight, dd.GetGuardianWeight(founder))
+
+	dd.SetGuardianWeight(founder, 3)
+	require.Equal(t, uint64(3), dd.GetGuardianWeight(founder))
+	require.Equal(t, DefaultGuardianWeight, dd.GetGuardianWeight(newcomer))
+	require.NoError(t, dd.Validate())
+
+	// Setting the default weight drops the stored weight
+	dd.SetGuardianWeight(founder, DefaultGuardianWeight)
+	require.Empty(t, dd.GuardianWeights)
+
+	// Weights of former guardians are pruned
+	dd.SetGuardianWeight(founder, 3)
+	dd.Guardians = []string{newcomer}
+	require.Error(t, dd.Validate())
+	dd.PruneGuardianWeights()
+	require.Empty(t, dd.GuardianWeights)
+	require.NoError(t, dd.Validate())
+
+	// Weights must be positive
+	dd.GuardianWeights = []GuardianWeight{{Guardian: newcomer, Weight: 0}}
+	require.Error(t, dd.Validate())
+}
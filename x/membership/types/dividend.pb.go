@@ -0,0 +1,709 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: membershipmodule/membership/dividend.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DividendPool tracks the member dividend paid out of the treasury. Each
+// epoch, every electorate member's share is added to the cumulative reward
+// index, so that members can claim what they are owed without the chain
+// iterating over them.
+type DividendPool struct {
+	// index is the cumulative amount owed to a member who has been in the
+	// electorate since the first epoch
+	Index github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=index,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"index"`
+	// reserve is the part of the treasury's funds distributed to members but
+	// not yet claimed
+	Reserve github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=reserve,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reserve"`
+}
+
+func (m *DividendPool) Reset()         { *m = DividendPool{} }
+func (m *DividendPool) String() string { return proto.CompactTextString(m) }
+func (*DividendPool) ProtoMessage()    {}
+func (*DividendPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60b1474171e46a5a, []int{0}
+}
+func (m *DividendPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DividendPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DividendPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DividendPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DividendPool.Merge(m, src)
+}
+func (m *DividendPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *DividendPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_DividendPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DividendPool proto.InternalMessageInfo
+
+func (m *DividendPool) GetIndex() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Index
+	}
+	return nil
+}
+
+func (m *DividendPool) GetReserve() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Reserve
+	}
+	return nil
+}
+
+// MemberDividend is a member's position against the dividend pool's reward
+// index
+type MemberDividend struct {
+	// member is the address of the member
+	Member string `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	// index is the reward index the member's rewards were last settled at
+	Index github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=index,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"index"`
+	// pending is the amount settled to the member and not yet claimed
+	Pending github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=pending,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pending"`
+}
+
+func (m *MemberDividend) Reset()         { *m = MemberDividend{} }
+func (m *MemberDividend) String() string { return proto.CompactTextString(m) }
+func (*MemberDividend) ProtoMessage()    {}
+func (*MemberDividend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60b1474171e46a5a, []int{1}
+}
+func (m *MemberDividend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MemberDividend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MemberDividend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MemberDividend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemberDividend.Merge(m, src)
+}
+func (m *MemberDividend) XXX_Size() int {
+	return m.Size()
+}
+func (m *MemberDividend) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemberDividend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemberDividend proto.InternalMessageInfo
+
+func (m *MemberDividend) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *MemberDividend) GetIndex() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Index
+	}
+	return nil
+}
+
+func (m *MemberDividend) GetPending() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Pending
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DividendPool)(nil), "membershipmodule.membership.DividendPool")
+	proto.RegisterType((*MemberDividend)(nil), "membershipmodule.membership.MemberDividend")
+}
+
+func init() {
+	proto.RegisterFile("membershipmodule/membership/dividend.proto", fileDescriptor_60b1474171e46a5a)
+}
+
+var fileDescriptor_60b1474171e46a5a = []byte{
+	// 310 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0xb1, 0x4e, 0xc3, 0x30,
+	0x10, 0x86, 0xe3, 0x56, 0x14, 0x61, 0x10, 0x43, 0x84, 0x50, 0x28, 0x92, 0x5b, 0x75, 0xaa, 0x90,
+	0x6a, 0x53, 0x98, 0x58, 0x0b, 0x2b, 0x12, 0x2a, 0x1b, 0x5b, 0x52, 0x9f, 0x52, 0x8b, 0xc6, 0x17,
+	0xc5, 0x69, 0x55, 0xde, 0x82, 0xe7, 0xe0, 0x49, 0x3a, 0x76, 0xec, 0x04, 0x28, 0x79, 0x03, 0x9e,
+	0x00, 0x35, 0x4e, 0x95, 0x88, 0x99, 0x4e, 0x3e, 0x9f, 0x7c, 0xdf, 0xef, 0xff, 0xf4, 0xd3, 0xab,
+	0x08, 0xa2, 0x00, 0x12, 0x33, 0x55, 0x71, 0x84, 0x72, 0x3e, 0x03, 0x51, 0x35, 0x84, 0x54, 0x0b,
+	0x25, 0x41, 0x4b, 0x1e, 0x27, 0x98, 0xa2, 0x7b, 0xf9, 0xf7, 0x2d, 0xaf, 0x1a, 0x6d, 0x36, 0x41,
+	0x13, 0xa1, 0x11, 0x81, 0x6f, 0x40, 0x2c, 0x86, 0x01, 0xa4, 0xfe, 0x50, 0x4c, 0x50, 0x69, 0x3b,
+	0xdc, 0x3e, 0x0b, 0x31, 0xc4, 0xa2, 0x14, 0xdb, 0xca, 0x76, 0x7b, 0x1b, 0x42, 0x4f, 0x1e, 0x4a,
+	0x95, 0x27, 0xc4, 0x99, 0xeb, 0xd3, 0x03, 0xa5, 0x25, 0x2c, 0x3d, 0xd2, 0x6d, 0xf6, 0x8f, 0x6f,
+	0x2e, 0xb8, 0xc5, 0xf2, 0x2d, 0x96, 0x97, 0x58, 0x7e, 0x8f, 0x4a, 0x8f, 0xae, 0x57, 0x9f, 0x1d,
+	0xe7, 0xe3, 0xab, 0xd3, 0x0f, 0x55, 0x3a, 0x9d, 0x07, 0x7c, 0x82, 0x91, 0x28, 0xff, 0x60, 0x8f,
+	0x81, 0x91, 0xaf, 0x22, 0x7d, 0x8b, 0xc1, 0x14, 0x03, 0x66, 0x6c, 0xc9, 0x2e, 0xd0, 0xc3, 0x04,
+	0x0c, 0x24, 0x0b, 0xf0, 0x1a, 0xff, 0x2f, 0xb2, 0x63, 0xf7, 0x7e, 0x08, 0x3d, 0x7d, 0x2c, 0xf6,
+	0xb3, 0x33, 0xe8, 0x9e, 0xd3, 0x96, 0xdd, 0x98, 0x47, 0xba, 0xa4, 0x7f, 0x34, 0x2e, 0x6f, 0x95,
+	0xe9, 0xc6, 0x3e, 0x4d, 0xc7, 0xa0, 0xa5, 0xd2, 0xa1, 0xd7, 0xdc, 0x83, 0xe9, 0x92, 0x3d, 0x7a,
+	0x5e, 0x65, 0x8c, 0xac, 0x33, 0x46, 0xbe, 0x33, 0x46, 0xde, 0x73, 0xe6, 0xac, 0x73, 0xe6, 0x6c,
+	0x72, 0xe6, 0xbc, 0xdc, 0xd5, 0x60, 0x1a, 0x13, 0xe5, 0x0f, 0x34, 0xa4, 0xc2, 0xe6, 0x68, 0x50,
+	0xcb, 0xdc, 0xb2, 0x1e, 0xc0, 0x42, 0x23, 0x68, 0x15, 0x59, 0xb9, 0xfd, 0x1d, 0x00, 0xba, 0x1d,
+	0xc9, 0xd1, 0xac, 0x02, 0x00, 0x00,
+}
+
+func (m *DividendPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DividendPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DividendPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reserve) > 0 {
+		for iNdEx := len(m.Reserve) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reserve[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDividend(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Index) > 0 {
+		for iNdEx := len(m.Index) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Index[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDividend(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MemberDividend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemberDividend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MemberDividend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pending) > 0 {
+		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDividend(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Index) > 0 {
+		for iNdEx := len(m.Index) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Index[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDividend(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintDividend(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDividend(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDividend(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DividendPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		for _, e := range m.Index {
+			l = e.Size()
+			n += 1 + l + sovDividend(uint64(l))
+		}
+	}
+	if len(m.Reserve) > 0 {
+		for _, e := range m.Reserve {
+			l = e.Size()
+			n += 1 + l + sovDividend(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MemberDividend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovDividend(uint64(l))
+	}
+	if len(m.Index) > 0 {
+		for _, e := range m.Index {
+			l = e.Size()
+			n += 1 + l + sovDividend(uint64(l))
+		}
+	}
+	if len(m.Pending) > 0 {
+		for _, e := range m.Pending {
+			l = e.Size()
+			n += 1 + l + sovDividend(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovDividend(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDividend(x uint64) (n int) {
+	return sovDividend(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DividendPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDividend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DividendPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DividendPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDividend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDividend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDividend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = append(m.Index, types.Coin{})
+			if err := m.Index[len(m.Index)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDividend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDividend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDividend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reserve = append(m.Reserve, types.Coin{})
+			if err := m.Reserve[len(m.Reserve)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDividend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDividend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemberDividend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDividend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemberDividend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemberDividend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDividend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDividend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDividend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDividend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDividend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDividend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = append(m.Index, types.Coin{})
+			if err := m.Index[len(m.Index)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDividend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDividend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDividend
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pending = append(m.Pending, types.Coin{})
+			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDividend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDividend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDividend(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDividend
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDividend
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDividend
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDividend
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDividend
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDividend
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDividend        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDividend          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDividend = fmt.Errorf("proto: unexpected end of group")
+)
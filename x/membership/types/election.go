@@ -0,0 +1,209 @@
+package types
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CandidacyStatementMaxLength is the maximum number of characters allowed for
+// a candidate's statement
+const CandidacyStatementMaxLength = 1000
+
+// IsClosing returns true once the election has reached its end height
+func (e Election) IsClosing(height int64) bool {
+	return height >= e.EndHeight
+}
+
+// NewElectionResult creates an empty result for the given election
+func NewElectionResult(election Election) ElectionResult {
+	return ElectionResult{
+		ElectionId:  election.Id,
+		Method:      election.Method,
+		StartHeight: election.StartHeight,
+		EndHeight:   election.EndHeight,
+		Seats:       election.Seats,
+		Quota:       sdk.ZeroDec(),
+	}
+}
+
+// Count tallies the ballots using the result's method, and records the
+// winners. Ballot entries that are not among the candidates are ignored, as
+// are ballots left without any valid entries.
+func (r *ElectionResult) Count(candidates []string, ballots [][]string) {
+	sorted := make([]string, len(candidates))
+	copy(sorted, candidates)
+	sort.Strings(sorted)
+
+	valid := sanitizeBallots(sorted, ballots)
+	r.Ballots = uint64(len(valid))
+
+	switch r.Method {
+	case ElectionMethod_ElectionMethodSTV:
+		r.countSTV(sorted, valid)
+	default:
+		r.countApproval(sorted, valid)
+	}
+}
+
+// countApproval elects the candidates approved by the most ballots, with
+// ties broken by address
+func (r *ElectionResult) countApproval(candidates []string, ballots [][]string) {
+	approvals := make(map[string]int64, len(candidates))
+	for _, ballot := range ballots {
+		for _, candidate := range ballot {
+			approvals[candidate]++
+		}
+	}
+
+	ranked := make([]string, len(candidates))
+	copy(ranked, candidates)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return approvals[ranked[i]] > approvals[ranked[j]]
+	})
+
+	for _, candidate := range ranked {
+		elected := uint64(len(r.Winners)) < r.Seats && approvals[candidate] > 0
+		if elected {
+			r.Winners = append(r.Winners, candidate)
+		}
+		r.Tallies = append(r.Tallies, CandidateTally{
+			Candidate: candidate,
+			Votes:     sdk.NewDec(approvals[candidate]),
+			Elected:   elected,
+			Round:     1,
+		})
+	}
+}
+
+// countSTV elects candidates by single transferable vote. Each round, a
+// candidate reaching the Droop quota is elected and the surplus of their
+// ballots is transferred at a reduced weight. Otherwise, the candidate with
+// the fewest votes is eliminated and their ballots transferred in full.
+// Ties are broken by address.
+func (r *ElectionResult) countSTV(candidates []string, ballots [][]string) {
+	r.Quota = sdk.NewDec(int64(r.Ballots/(r.Seats+1) + 1))
+
+	weights := make([]sdk.Dec, len(ballots))
+	for i := range weights {
+		weights[i] = sdk.OneDec()
+	}
+
+	continuing := make(map[string]bool, len(candidates))
+	for _, candidate := range candidates {
+		continuing[candidate] = true
+	}
+
+	// remaining returns the continuing candidates in address order
+	remaining := func() []string {
+		var res []string
+		for _, candidate := range candidates {
+			if continuing[candidate] {
+				res = append(res, candidate)
+			}
+		}
+		return res
+	}
+
+	// preference returns the ballot's highest ranked continuing candidate
+	preference := func(ballot []string) (string, bool) {
+		for _, candidate := range ballot {
+			if continuing[candidate] {
+				return candidate, true
+			}
+		}
+		return "", false
+	}
+
+	totals := make(map[string]sdk.Dec, len(candidates))
+	for round := uint64(1); len(remaining()) > 0; round++ {
+		open := remaining()
+		for _, candidate := range open {
+			totals[candidate] = sdk.ZeroDec()
+		}
+		for i, ballot := range ballots {
+			if candidate, ok := preference(ballot); ok {
+				totals[candidate] = totals[candidate].Add(weights[i])
+			}
+		}
+
+		// Stop once every seat has been filled
+		seatsLeft := r.Seats - uint64(len(r.Winners))
+		if seatsLeft == 0 {
+			for _, candidate := range open {
+				r.Tallies = append(r.Tallies, CandidateTally{Candidate: candidate, Votes: totals[candidate], Round: round})
+			}
+			return
+		}
+
+		// Elect every candidate with votes if there are no more of them than seats left
+		if uint64(len(open)) <= seatsLeft {
+			sort.SliceStable(open, func(i, j int) bool {
+				return totals[open[i]].GT(totals[open[j]])
+			})
+			for _, candidate := range open {
+				elected := totals[candidate].IsPositive()
+				if elected {
+					r.Winners = append(r.Winners, candidate)
+				}
+				r.Tallies = append(r.Tallies, CandidateTally{Candidate: candidate, Votes: totals[candidate], Elected: elected, Round: round})
+			}
+			return
+		}
+
+		best, worst := open[0], open[len(open)-1]
+		for _, candidate := range open {
+			if totals[candidate].GT(totals[best]) {
+				best = candidate
+			}
+		}
+		for i := len(open) - 1; i >= 0; i-- {
+			if totals[open[i]].LT(totals[worst]) {
+				worst = open[i]
+			}
+		}
+
+		if totals[best].GTE(r.Quota) {
+			// Transfer the surplus at a fraction of each ballot's weight
+			factor := totals[best].Sub(r.Quota).QuoTruncate(totals[best])
+			for i, ballot := range ballots {
+				if candidate, ok := preference(ballot); ok && candidate == best {
+					weights[i] = weights[i].MulTruncate(factor)
+				}
+			}
+
+			continuing[best] = false
+			r.Winners = append(r.Winners, best)
+			r.Tallies = append(r.Tallies, CandidateTally{Candidate: best, Votes: totals[best], Elected: true, Round: round})
+			continue
+		}
+
+		continuing[worst] = false
+		r.Tallies = append(r.Tallies, CandidateTally{Candidate: worst, Votes: totals[worst], Round: round})
+	}
+}
+
+// sanitizeBallots drops unknown and repeated candidates from each ballot, as
+// well as ballots left empty
+func sanitizeBallots(candidates []string, ballots [][]string) [][]string {
+	known := make(map[string]bool, len(candidates))
+	for _, candidate := range candidates {
+		known[candidate] = true
+	}
+
+	var valid [][]string
+	for _, ballot := range ballots {
+		seen := make(map[string]bool, len(ballot))
+		var entries []string
+		for _, candidate := range ballot {
+			if known[candidate] && !seen[candidate] {
+				seen[candidate] = true
+				entries = append(entries, candidate)
+			}
+		}
+		if len(entries) > 0 {
+			valid = append(valid, entries)
+		}
+	}
+	return valid
+}
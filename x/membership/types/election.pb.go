@@ -0,0 +1,1918 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: membershipmodule/membership/election.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ElectionMethod enumerates the ways guardian election winners are chosen
+type ElectionMethod int32
+
+const (
+	// ELECTION_METHOD_UNSPECIFIED defines a no-op method
+	ElectionMethod_ElectionMethodEmpty ElectionMethod = 0
+	// ELECTION_METHOD_APPROVAL elects the candidates approved by the most ballots
+	ElectionMethod_ElectionMethodApproval ElectionMethod = 1
+	// ELECTION_METHOD_STV elects candidates by single transferable vote, using the Droop quota
+	ElectionMethod_ElectionMethodSTV ElectionMethod = 2
+)
+
+var ElectionMethod_name = map[int32]string{
+	0: "ELECTION_METHOD_UNSPECIFIED",
+	1: "ELECTION_METHOD_APPROVAL",
+	2: "ELECTION_METHOD_STV",
+}
+
+var ElectionMethod_value = map[string]int32{
+	"ELECTION_METHOD_UNSPECIFIED": 0,
+	"ELECTION_METHOD_APPROVAL":    1,
+	"ELECTION_METHOD_STV":         2,
+}
+
+func (x ElectionMethod) String() string {
+	return proto.EnumName(ElectionMethod_name, int32(x))
+}
+
+func (ElectionMethod) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fb83710448a8caf5, []int{0}
+}
+
+// Candidacy is a member's declaration that they are standing for guardian
+type Candidacy struct {
+	// candidate is the address of the member standing for guardian
+	Candidate string `protobuf:"bytes,1,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	// statement is the candidate's pitch to the electorate
+	Statement string `protobuf:"bytes,2,opt,name=statement,proto3" json:"statement,omitempty"`
+	// declared_at is the block height at which the candidacy was declared
+	DeclaredAt int64 `protobuf:"varint,3,opt,name=declared_at,json=declaredAt,proto3" json:"declared_at,omitempty"`
+}
+
+func (m *Candidacy) Reset()         { *m = Candidacy{} }
+func (m *Candidacy) String() string { return proto.CompactTextString(m) }
+func (*Candidacy) ProtoMessage()    {}
+func (*Candidacy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb83710448a8caf5, []int{0}
+}
+func (m *Candidacy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Candidacy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Candidacy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Candidacy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Candidacy.Merge(m, src)
+}
+func (m *Candidacy) XXX_Size() int {
+	return m.Size()
+}
+func (m *Candidacy) XXX_DiscardUnknown() {
+	xxx_messageInfo_Candidacy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Candidacy proto.InternalMessageInfo
+
+func (m *Candidacy) GetCandidate() string {
+	if m != nil {
+		return m.Candidate
+	}
+	return ""
+}
+
+func (m *Candidacy) GetStatement() string {
+	if m != nil {
+		return m.Statement
+	}
+	return ""
+}
+
+func (m *Candidacy) GetDeclaredAt() int64 {
+	if m != nil {
+		return m.DeclaredAt
+	}
+	return 0
+}
+
+// Election is a guardian election that is open for ballots
+type Election struct {
+	// id is the unique identifier of the election
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// start_height is the block height at which the election opened
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the block height at which the election closes
+	EndHeight int64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// method is the method used to choose the winners
+	Method ElectionMethod `protobuf:"varint,4,opt,name=method,proto3,enum=membershipmodule.membership.ElectionMethod" json:"method,omitempty"`
+	// seats is the number of guardians to be elected
+	Seats uint64 `protobuf:"varint,5,opt,name=seats,proto3" json:"seats,omitempty"`
+}
+
+func (m *Election) Reset()         { *m = Election{} }
+func (m *Election) String() string { return proto.CompactTextString(m) }
+func (*Election) ProtoMessage()    {}
+func (*Election) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb83710448a8caf5, []int{1}
+}
+func (m *Election) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Election) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Election.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Election) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Election.Merge(m, src)
+}
+func (m *Election) XXX_Size() int {
+	return m.Size()
+}
+func (m *Election) XXX_DiscardUnknown() {
+	xxx_messageInfo_Election.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Election proto.InternalMessageInfo
+
+func (m *Election) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Election) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *Election) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *Election) GetMethod() ElectionMethod {
+	if m != nil {
+		return m.Method
+	}
+	return ElectionMethod_ElectionMethodEmpty
+}
+
+func (m *Election) GetSeats() uint64 {
+	if m != nil {
+		return m.Seats
+	}
+	return 0
+}
+
+// ElectionBallot is an electorate member's ballot in a guardian election
+type ElectionBallot struct {
+	// election_id is the election the ballot was cast in
+	ElectionId uint64 `protobuf:"varint,1,opt,name=election_id,json=electionId,proto3" json:"election_id,omitempty"`
+	// voter is the address of the member who cast the ballot
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// candidates are the candidates approved by the voter, in order of
+	// preference when the election uses STV
+	Candidates []string `protobuf:"bytes,3,rep,name=candidates,proto3" json:"candidates,omitempty"`
+}
+
+func (m *ElectionBallot) Reset()         { *m = ElectionBallot{} }
+func (m *ElectionBallot) String() string { return proto.CompactTextString(m) }
+func (*ElectionBallot) ProtoMessage()    {}
+func (*ElectionBallot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb83710448a8caf5, []int{2}
+}
+func (m *ElectionBallot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ElectionBallot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ElectionBallot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ElectionBallot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ElectionBallot.Merge(m, src)
+}
+func (m *ElectionBallot) XXX_Size() int {
+	return m.Size()
+}
+func (m *ElectionBallot) XXX_DiscardUnknown() {
+	xxx_messageInfo_ElectionBallot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ElectionBallot proto.InternalMessageInfo
+
+func (m *ElectionBallot) GetElectionId() uint64 {
+	if m != nil {
+		return m.ElectionId
+	}
+	return 0
+}
+
+func (m *ElectionBallot) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *ElectionBallot) GetCandidates() []string {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
+// CandidateTally is a candidate's standing at the close of an election
+type CandidateTally struct {
+	// candidate is the address of the candidate
+	Candidate string `protobuf:"bytes,1,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	// votes is the candidate's vote total when they were elected or eliminated,
+	// or at the close of counting
+	Votes github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=votes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"votes"`
+	// elected is true if the candidate won a seat
+	Elected bool `protobuf:"varint,3,opt,name=elected,proto3" json:"elected,omitempty"`
+	// round is the counting round in which the candidate was elected or
+	// eliminated, and is always 1 for approval elections
+	Round uint64 `protobuf:"varint,4,opt,name=round,proto3" json:"round,omitempty"`
+}
+
+func (m *CandidateTally) Reset()         { *m = CandidateTally{} }
+func (m *CandidateTally) String() string { return proto.CompactTextString(m) }
+func (*CandidateTally) ProtoMessage()    {}
+func (*CandidateTally) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb83710448a8caf5, []int{3}
+}
+func (m *CandidateTally) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CandidateTally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CandidateTally.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CandidateTally) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandidateTally.Merge(m, src)
+}
+func (m *CandidateTally) XXX_Size() int {
+	return m.Size()
+}
+func (m *CandidateTally) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandidateTally.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CandidateTally proto.InternalMessageInfo
+
+func (m *CandidateTally) GetCandidate() string {
+	if m != nil {
+		return m.Candidate
+	}
+	return ""
+}
+
+func (m *CandidateTally) GetElected() bool {
+	if m != nil {
+		return m.Elected
+	}
+	return false
+}
+
+func (m *CandidateTally) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+// ElectionResult is the outcome of a closed guardian election
+type ElectionResult struct {
+	// election_id is the election the result belongs to
+	ElectionId uint64 `protobuf:"varint,1,opt,name=election_id,json=electionId,proto3" json:"election_id,omitempty"`
+	// method is the method used to choose the winners
+	Method ElectionMethod `protobuf:"varint,2,opt,name=method,proto3,enum=membershipmodule.membership.ElectionMethod" json:"method,omitempty"`
+	// start_height is the block height at which the election opened
+	StartHeight int64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the block height at which the election closed
+	EndHeight int64 `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// seats is the number of guardians to be elected
+	Seats uint64 `protobuf:"varint,5,opt,name=seats,proto3" json:"seats,omitempty"`
+	// ballots is the number of valid ballots counted
+	Ballots uint64 `protobuf:"varint,6,opt,name=ballots,proto3" json:"ballots,omitempty"`
+	// quota is the Droop quota used by STV, and is zero for approval elections
+	Quota github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=quota,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quota"`
+	// tallies contains every candidate's standing
+	Tallies []CandidateTally `protobuf:"bytes,8,rep,name=tallies,proto3" json:"tallies"`
+	// winners are the elected guardians, in the order they were elected
+	Winners []string `protobuf:"bytes,9,rep,name=winners,proto3" json:"winners,omitempty"`
+	// turnout is the number of ballots cast by electorate members
+	Turnout uint64 `protobuf:"varint,10,opt,name=turnout,proto3" json:"turnout,omitempty"`
+	// turnout_reached is true if the turnout met the minimum election turnout.
+	// Otherwise the ballots are not counted and the incumbents are kept.
+	TurnoutReached bool `protobuf:"varint,11,opt,name=turnout_reached,json=turnoutReached,proto3" json:"turnout_reached,omitempty"`
+}
+
+func (m *ElectionResult) Reset()         { *m = ElectionResult{} }
+func (m *ElectionResult) String() string { return proto.CompactTextString(m) }
+func (*ElectionResult) ProtoMessage()    {}
+func (*ElectionResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb83710448a8caf5, []int{4}
+}
+func (m *ElectionResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ElectionResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ElectionResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ElectionResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ElectionResult.Merge(m, src)
+}
+func (m *ElectionResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ElectionResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ElectionResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ElectionResult proto.InternalMessageInfo
+
+func (m *ElectionResult) GetElectionId() uint64 {
+	if m != nil {
+		return m.ElectionId
+	}
+	return 0
+}
+
+func (m *ElectionResult) GetMethod() ElectionMethod {
+	if m != nil {
+		return m.Method
+	}
+	return ElectionMethod_ElectionMethodEmpty
+}
+
+func (m *ElectionResult) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *ElectionResult) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *ElectionResult) GetSeats() uint64 {
+	if m != nil {
+		return m.Seats
+	}
+	return 0
+}
+
+func (m *ElectionResult) GetBallots() uint64 {
+	if m != nil {
+		return m.Ballots
+	}
+	return 0
+}
+
+func (m *ElectionResult) GetTallies() []CandidateTally {
+	if m != nil {
+		return m.Tallies
+	}
+	return nil
+}
+
+func (m *ElectionResult) GetWinners() []string {
+	if m != nil {
+		return m.Winners
+	}
+	return nil
+}
+
+func (m *ElectionResult) GetTurnout() uint64 {
+	if m != nil {
+		return m.Turnout
+	}
+	return 0
+}
+
+func (m *ElectionResult) GetTurnoutReached() bool {
+	if m != nil {
+		return m.TurnoutReached
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("membershipmodule.membership.ElectionMethod", ElectionMethod_name, ElectionMethod_value)
+	proto.RegisterType((*Candidacy)(nil), "membershipmodule.membership.Candidacy")
+	proto.RegisterType((*Election)(nil), "membershipmodule.membership.Election")
+	proto.RegisterType((*ElectionBallot)(nil), "membershipmodule.membership.ElectionBallot")
+	proto.RegisterType((*CandidateTally)(nil), "membershipmodule.membership.CandidateTally")
+	proto.RegisterType((*ElectionResult)(nil), "membershipmodule.membership.ElectionResult")
+}
+
+func init() {
+	proto.RegisterFile("membershipmodule/membership/election.proto", fileDescriptor_fb83710448a8caf5)
+}
+
+var fileDescriptor_fb83710448a8caf5 = []byte{
+	// 668 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x8d, 0xe3, 0xf4, 0x91, 0x49, 0x15, 0xca, 0xb4, 0xc0, 0x28, 0x05, 0xd7, 0x64, 0x01, 0x51,
+	0x51, 0x1d, 0x09, 0x36, 0x65, 0x99, 0x87, 0x51, 0x23, 0xfa, 0x92, 0x13, 0xba, 0x60, 0x13, 0x4d,
+	0xec, 0x51, 0x6c, 0x61, 0x7b, 0x82, 0x67, 0x5c, 0xe8, 0x2f, 0x74, 0xc5, 0x0f, 0x74, 0xc7, 0x27,
+	0x20, 0xf1, 0x0b, 0x5d, 0x76, 0x59, 0xb1, 0xa8, 0x50, 0xfb, 0x23, 0xc8, 0x33, 0x76, 0xf3, 0x10,
+	0x22, 0xa8, 0x2b, 0xfb, 0x9c, 0x99, 0x33, 0x73, 0xef, 0x39, 0x57, 0x03, 0xb6, 0x02, 0x12, 0x0c,
+	0x48, 0xc4, 0x5c, 0x6f, 0x14, 0x50, 0x27, 0xf6, 0x49, 0x7d, 0x4c, 0xd4, 0x89, 0x4f, 0x6c, 0xee,
+	0xd1, 0xd0, 0x18, 0x45, 0x94, 0x53, 0xb8, 0x31, 0xbb, 0xd7, 0x18, 0x13, 0x95, 0xf5, 0x21, 0x1d,
+	0x52, 0xb1, 0xaf, 0x9e, 0xfc, 0x49, 0x49, 0xd5, 0x05, 0xc5, 0x16, 0x0e, 0x1d, 0xcf, 0xc1, 0xf6,
+	0x29, 0x7c, 0x0a, 0x8a, 0xb6, 0x04, 0x9c, 0x20, 0x45, 0x57, 0x6a, 0x45, 0x6b, 0x4c, 0x24, 0xab,
+	0x8c, 0x63, 0x4e, 0x02, 0x12, 0x72, 0x94, 0x97, 0xab, 0x77, 0x04, 0xdc, 0x04, 0x25, 0x87, 0xd8,
+	0x3e, 0x8e, 0x88, 0xd3, 0xc7, 0x1c, 0xa9, 0xba, 0x52, 0x53, 0x2d, 0x90, 0x51, 0x0d, 0x5e, 0xfd,
+	0xa9, 0x80, 0x65, 0x33, 0xad, 0x17, 0x96, 0x41, 0xde, 0x73, 0xc4, 0x15, 0x05, 0x2b, 0xef, 0x39,
+	0xf0, 0x39, 0x58, 0x61, 0x1c, 0x47, 0xbc, 0xef, 0x12, 0x6f, 0xe8, 0xca, 0xe3, 0x55, 0xab, 0x24,
+	0xb8, 0x5d, 0x41, 0xc1, 0x67, 0x00, 0x90, 0xd0, 0xc9, 0x36, 0xc8, 0xf3, 0x8b, 0x24, 0x74, 0xd2,
+	0xe5, 0x16, 0x58, 0x0c, 0x08, 0x77, 0xa9, 0x83, 0x0a, 0xba, 0x52, 0x2b, 0xbf, 0x7e, 0x65, 0xfc,
+	0xc3, 0x0c, 0x23, 0x2b, 0x64, 0x5f, 0x48, 0xac, 0x54, 0x0a, 0xd7, 0xc1, 0x02, 0x23, 0x98, 0x33,
+	0xb4, 0x20, 0x2a, 0x93, 0xa0, 0x3a, 0x04, 0xe5, 0x6c, 0x7f, 0x13, 0xfb, 0x3e, 0x15, 0xcd, 0x66,
+	0xd6, 0xf7, 0xef, 0xfa, 0x00, 0x19, 0xd5, 0x11, 0x07, 0x9d, 0x50, 0x4e, 0xa2, 0xd4, 0x27, 0x09,
+	0xa0, 0x06, 0xc0, 0x9d, 0x9d, 0x0c, 0xa9, 0xba, 0x5a, 0x2b, 0x5a, 0x13, 0x4c, 0xf5, 0xbb, 0x02,
+	0xca, 0xad, 0x0c, 0xf6, 0xb0, 0xef, 0xcf, 0x8b, 0xa4, 0x2d, 0xaf, 0x61, 0xe2, 0x9a, 0x95, 0xa6,
+	0x71, 0x71, 0xbd, 0x99, 0xfb, 0x75, 0xbd, 0xf9, 0x62, 0xe8, 0x71, 0x37, 0x1e, 0x18, 0x36, 0x0d,
+	0xea, 0x36, 0x65, 0x01, 0x65, 0xe9, 0x67, 0x9b, 0x39, 0x9f, 0xea, 0xfc, 0x74, 0x44, 0x98, 0xd1,
+	0x26, 0xb6, 0x2c, 0x8b, 0x41, 0x04, 0x96, 0x44, 0xe9, 0xc4, 0x11, 0xb6, 0x2e, 0x5b, 0x19, 0x4c,
+	0xda, 0x88, 0x68, 0x1c, 0x4a, 0x4f, 0x0b, 0x96, 0x04, 0xd5, 0x2b, 0x75, 0x6c, 0x88, 0x45, 0x58,
+	0xec, 0xff, 0x87, 0x21, 0xe3, 0x78, 0xf2, 0xf7, 0x8f, 0x67, 0x76, 0x4a, 0xd4, 0x79, 0x53, 0x52,
+	0x98, 0x9d, 0x92, 0xbf, 0x06, 0x9c, 0x18, 0x30, 0x10, 0xc1, 0x32, 0xb4, 0x28, 0xf8, 0x0c, 0x26,
+	0x06, 0x7f, 0x8e, 0x29, 0xc7, 0x68, 0xe9, 0x7e, 0x06, 0x0b, 0x31, 0x7c, 0x0f, 0x96, 0x38, 0xf6,
+	0x7d, 0x8f, 0x30, 0xb4, 0xac, 0xab, 0xb5, 0xd2, 0x9c, 0xee, 0xa7, 0x47, 0xa0, 0x59, 0x48, 0x2e,
+	0xb5, 0xb2, 0x13, 0x92, 0x62, 0xbf, 0x78, 0x61, 0x48, 0x22, 0x86, 0x8a, 0x62, 0x82, 0x32, 0x98,
+	0xac, 0xf0, 0x38, 0x0a, 0x69, 0xcc, 0x11, 0x90, 0x6d, 0xa4, 0x10, 0xbe, 0x04, 0x0f, 0xd2, 0xdf,
+	0x7e, 0x44, 0xb0, 0xed, 0x12, 0x07, 0x95, 0x44, 0xd2, 0xe5, 0x94, 0xb6, 0x24, 0xbb, 0xf5, 0x43,
+	0x01, 0xe5, 0x69, 0xf3, 0xe1, 0x0e, 0xd8, 0x30, 0xf7, 0xcc, 0x56, 0xaf, 0x73, 0x78, 0xd0, 0xdf,
+	0x37, 0x7b, 0xbb, 0x87, 0xed, 0xfe, 0x87, 0x83, 0xee, 0x91, 0xd9, 0xea, 0xbc, 0xeb, 0x98, 0xed,
+	0xd5, 0x5c, 0xe5, 0xc9, 0xd9, 0xb9, 0xbe, 0x36, 0x2d, 0x32, 0x83, 0x11, 0x3f, 0x85, 0x3b, 0x00,
+	0xcd, 0x2a, 0x1b, 0x47, 0x47, 0xd6, 0xe1, 0x71, 0x63, 0x6f, 0x55, 0xa9, 0x54, 0xce, 0xce, 0xf5,
+	0xc7, 0xd3, 0xb2, 0xc6, 0x68, 0x14, 0xd1, 0x13, 0xec, 0x43, 0x03, 0xac, 0xcd, 0x2a, 0xbb, 0xbd,
+	0xe3, 0xd5, 0x7c, 0xe5, 0xd1, 0xd9, 0xb9, 0xfe, 0x70, 0x5a, 0xd4, 0xed, 0x1d, 0x37, 0xbb, 0x17,
+	0x37, 0x9a, 0x72, 0x79, 0xa3, 0x29, 0xbf, 0x6f, 0x34, 0xe5, 0xdb, 0xad, 0x96, 0xbb, 0xbc, 0xd5,
+	0x72, 0x57, 0xb7, 0x5a, 0xee, 0xe3, 0xdb, 0x89, 0xa4, 0x42, 0x1a, 0x79, 0x78, 0x3b, 0x24, 0xbc,
+	0x2e, 0x3d, 0xdf, 0x9e, 0x78, 0x49, 0xbf, 0x4e, 0x3e, 0xab, 0x22, 0xc0, 0xc1, 0xa2, 0x78, 0x21,
+	0xdf, 0xfc, 0x19, 0x00, 0x13, 0x0f, 0x2b, 0xc7, 0x82, 0x05, 0x00, 0x00,
+}
+
+func (m *Candidacy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Candidacy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Candidacy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeclaredAt != 0 {
+		i = encodeVarintElection(dAtA, i, uint64(m.DeclaredAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Statement) > 0 {
+		i -= len(m.Statement)
+		copy(dAtA[i:], m.Statement)
+		i = encodeVarintElection(dAtA, i, uint64(len(m.Statement)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Candidate) > 0 {
+		i -= len(m.Candidate)
+		copy(dAtA[i:], m.Candidate)
+		i = encodeVarintElection(dAtA, i, uint64(len(m.Candidate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Election) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Election) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Election) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Seats != 0 {
+		i = encodeVarintElection(dAtA, i, uint64(m.Seats))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Method != 0 {
+		i = encodeVarintElection(dAtA, i, uint64(m.Method))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintElection(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintElection(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintElection(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ElectionBallot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ElectionBallot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ElectionBallot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Candidates) > 0 {
+		for iNdEx := len(m.Candidates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Candidates[iNdEx])
+			copy(dAtA[i:], m.Candidates[iNdEx])
+			i = encodeVarintElection(dAtA, i, uint64(len(m.Candidates[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintElection(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ElectionId != 0 {
+		i = encodeVarintElection(dAtA, i, uint64(m.ElectionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CandidateTally) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CandidateTally) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CandidateTally) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Round != 0 {
+		i = encodeVarintElection(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Elected {
+		i--
+		if m.Elected {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Votes.Size()
+		i -= size
+		if _, err := m.Votes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintElection(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Candidate) > 0 {
+		i -= len(m.Candidate)
+		copy(dAtA[i:], m.Candidate)
+		i = encodeVarintElection(dAtA, i, uint64(len(m.Candidate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ElectionResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ElectionResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ElectionResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TurnoutReached {
+		i--
+		if m.TurnoutReached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Turnout != 0 {
+		i = encodeVarintElection(dAtA, i, uint64(m.Turnout))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Winners) > 0 {
+		for iNdEx := len(m.Winners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Winners[iNdEx])
+			copy(dAtA[i:], m.Winners[iNdEx])
+			i = encodeVarintElection(dAtA, i, uint64(len(m.Winners[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Tallies) > 0 {
+		for iNdEx := len(m.Tallies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tallies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintElection(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size := m.Quota.Size()
+		i -= size
+		if _, err := m.Quota.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintElection(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Ballots != 0 {
+		i = encodeVarintElection(dAtA, i, uint64(m.Ballots))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Seats != 0 {
+		i = encodeVarintElection(dAtA, i, uint64(m.Seats))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintElection(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintElection(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Method != 0 {
+		i = encodeVarintElection(dAtA, i, uint64(m.Method))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ElectionId != 0 {
+		i = encodeVarintElection(dAtA, i, uint64(m.ElectionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintElection(dAtA []byte, offset int, v uint64) int {
+	offset -= sovElection(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Candidacy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Candidate)
+	if l > 0 {
+		n += 1 + l + sovElection(uint64(l))
+	}
+	l = len(m.Statement)
+	if l > 0 {
+		n += 1 + l + sovElection(uint64(l))
+	}
+	if m.DeclaredAt != 0 {
+		n += 1 + sovElection(uint64(m.DeclaredAt))
+	}
+	return n
+}
+
+func (m *Election) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovElection(uint64(m.Id))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovElection(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovElection(uint64(m.EndHeight))
+	}
+	if m.Method != 0 {
+		n += 1 + sovElection(uint64(m.Method))
+	}
+	if m.Seats != 0 {
+		n += 1 + sovElection(uint64(m.Seats))
+	}
+	return n
+}
+
+func (m *ElectionBallot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ElectionId != 0 {
+		n += 1 + sovElection(uint64(m.ElectionId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovElection(uint64(l))
+	}
+	if len(m.Candidates) > 0 {
+		for _, s := range m.Candidates {
+			l = len(s)
+			n += 1 + l + sovElection(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CandidateTally) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Candidate)
+	if l > 0 {
+		n += 1 + l + sovElection(uint64(l))
+	}
+	l = m.Votes.Size()
+	n += 1 + l + sovElection(uint64(l))
+	if m.Elected {
+		n += 2
+	}
+	if m.Round != 0 {
+		n += 1 + sovElection(uint64(m.Round))
+	}
+	return n
+}
+
+func (m *ElectionResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ElectionId != 0 {
+		n += 1 + sovElection(uint64(m.ElectionId))
+	}
+	if m.Method != 0 {
+		n += 1 + sovElection(uint64(m.Method))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovElection(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovElection(uint64(m.EndHeight))
+	}
+	if m.Seats != 0 {
+		n += 1 + sovElection(uint64(m.Seats))
+	}
+	if m.Ballots != 0 {
+		n += 1 + sovElection(uint64(m.Ballots))
+	}
+	l = m.Quota.Size()
+	n += 1 + l + sovElection(uint64(l))
+	if len(m.Tallies) > 0 {
+		for _, e := range m.Tallies {
+			l = e.Size()
+			n += 1 + l + sovElection(uint64(l))
+		}
+	}
+	if len(m.Winners) > 0 {
+		for _, s := range m.Winners {
+			l = len(s)
+			n += 1 + l + sovElection(uint64(l))
+		}
+	}
+	if m.Turnout != 0 {
+		n += 1 + sovElection(uint64(m.Turnout))
+	}
+	if m.TurnoutReached {
+		n += 2
+	}
+	return n
+}
+
+func sovElection(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozElection(x uint64) (n int) {
+	return sovElection(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Candidacy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowElection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Candidacy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Candidacy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candidate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthElection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthElection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Candidate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statement", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthElection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthElection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statement = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeclaredAt", wireType)
+			}
+			m.DeclaredAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeclaredAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipElection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthElection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Election) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowElection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Election: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Election: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			m.Method = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Method |= ElectionMethod(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seats", wireType)
+			}
+			m.Seats = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seats |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipElection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthElection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ElectionBallot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowElection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ElectionBallot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ElectionBallot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElectionId", wireType)
+			}
+			m.ElectionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElectionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthElection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthElection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candidates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthElection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthElection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Candidates = append(m.Candidates, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipElection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthElection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CandidateTally) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowElection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CandidateTally: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CandidateTally: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candidate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthElection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthElection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Candidate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthElection
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthElection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Votes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Elected", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Elected = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipElection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthElection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ElectionResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowElection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ElectionResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ElectionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElectionId", wireType)
+			}
+			m.ElectionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElectionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			m.Method = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Method |= ElectionMethod(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seats", wireType)
+			}
+			m.Seats = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seats |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ballots", wireType)
+			}
+			m.Ballots = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ballots |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthElection
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthElection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tallies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthElection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthElection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tallies = append(m.Tallies, CandidateTally{})
+			if err := m.Tallies[len(m.Tallies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Winners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthElection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthElection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Winners = append(m.Winners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Turnout", wireType)
+			}
+			m.Turnout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Turnout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TurnoutReached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TurnoutReached = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipElection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthElection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipElection(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowElection
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowElection
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthElection
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupElection
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthElection
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthElection        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowElection          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupElection = fmt.Errorf("proto: unexpected end of group")
+)
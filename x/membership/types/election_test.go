@@ -0,0 +1,80 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func repeatBallot(ballot []string, n int) [][]string {
+	var ballots [][]string
+	for i := 0; i < n; i++ {
+		ballots = append(ballots, ballot)
+	}
+	return ballots
+}
+
+func TestElectionResult_CountApproval(t *testing.T) {
+	result := ElectionResult{Method: ElectionMethod_ElectionMethodApproval, Seats: 2}
+	result.Count([]string{"c", "a", "b", "d"}, [][]string{
+		{"a", "b"},
+		{"b"},
+		{"b", "c"},
+		{"unknown"},
+		{"a", "a"},
+	})
+
+	require.Equal(t, uint64(4), result.Ballots)
+	require.Equal(t, []string{"b", "a"}, result.Winners)
+	require.Equal(t, []CandidateTally{
+		{Candidate: "b", Votes: sdk.NewDec(3), Elected: true, Round: 1},
+		{Candidate: "a", Votes: sdk.NewDec(2), Elected: true, Round: 1},
+		{Candidate: "c", Votes: sdk.NewDec(1), Round: 1},
+		{Candidate: "d", Votes: sdk.NewDec(0), Round: 1},
+	}, result.Tallies)
+}
+
+func TestElectionResult_CountSTVSurplusTransfer(t *testing.T) {
+	result := ElectionResult{Method: ElectionMethod_ElectionMethodSTV, Seats: 2}
+	ballots := repeatBallot([]string{"a", "b"}, 4)
+	ballots = append(ballots, repeatBallot([]string{"b"}, 2)...)
+	ballots = append(ballots, []string{"c"})
+	result.Count([]string{"a", "b", "c"}, ballots)
+
+	// Quota is floor(7 / 3) + 1
+	require.Equal(t, sdk.NewDec(3), result.Quota)
+	require.Equal(t, []string{"a", "b"}, result.Winners)
+	require.Equal(t, []CandidateTally{
+		{Candidate: "a", Votes: sdk.NewDec(4), Elected: true, Round: 1},
+		// b receives a quarter of each of a's four ballots
+		{Candidate: "b", Votes: sdk.NewDec(3), Elected: true, Round: 2},
+		{Candidate: "c", Votes: sdk.NewDec(1), Round: 3},
+	}, result.Tallies)
+}
+
+func TestElectionResult_CountSTVElimination(t *testing.T) {
+	result := ElectionResult{Method: ElectionMethod_ElectionMethodSTV, Seats: 1}
+	ballots := repeatBallot([]string{"a"}, 3)
+	ballots = append(ballots, repeatBallot([]string{"b"}, 2)...)
+	ballots = append(ballots, repeatBallot([]string{"c", "b"}, 2)...)
+	result.Count([]string{"a", "b", "c"}, ballots)
+
+	// Quota is floor(7 / 2) + 1
+	require.Equal(t, sdk.NewDec(4), result.Quota)
+	require.Equal(t, []string{"b"}, result.Winners)
+	require.Equal(t, []CandidateTally{
+		// b and c are tied, so the later address is eliminated
+		{Candidate: "c", Votes: sdk.NewDec(2), Round: 1},
+		{Candidate: "b", Votes: sdk.NewDec(4), Elected: true, Round: 2},
+		{Candidate: "a", Votes: sdk.NewDec(3), Round: 3},
+	}, result.Tallies)
+}
+
+func TestElectionResult_CountWithoutBallots(t *testing.T) {
+	for _, method := range []ElectionMethod{ElectionMethod_ElectionMethodApproval, ElectionMethod_ElectionMethodSTV} {
+		result := ElectionResult{Method: method, Seats: 2, Quota: sdk.ZeroDec()}
+		result.Count([]string{"a", "b"}, nil)
+		require.Empty(t, result.Winners, method.String())
+	}
+}
@@ -0,0 +1,120 @@
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+var _ group.DecisionPolicy = &ElectorateDecisionPolicy{}
+
+// NewElectorateDecisionPolicy creates a new electorate decision policy
+func NewElectorateDecisionPolicy(quorum, threshold, vetoThreshold sdk.Dec, votingPeriod, minExecutionPeriod time.Duration) *ElectorateDecisionPolicy {
+	return &ElectorateDecisionPolicy{
+		Quorum:        quorum.String(),
+		Threshold:     threshold.String(),
+		VetoThreshold: vetoThreshold.String(),
+		Windows: &group.DecisionPolicyWindows{
+			VotingPeriod:       votingPeriod,
+			MinExecutionPeriod: minExecutionPeriod,
+		},
+	}
+}
+
+// GetVotingPeriod returns the voting period of the policy
+func (p ElectorateDecisionPolicy) GetVotingPeriod() time.Duration {
+	return p.Windows.VotingPeriod
+}
+
+// GetMinExecutionPeriod returns the minimum execution period of the policy
+func (p ElectorateDecisionPolicy) GetMinExecutionPeriod() time.Duration {
+	return p.Windows.MinExecutionPeriod
+}
+
+// ValidateBasic checks that the shares are between 0 and 1, and that
+// proposals can be voted on
+func (p ElectorateDecisionPolicy) ValidateBasic() error {
+	shares := []struct {
+		name  string
+		value string
+	}{
+		{"quorum", p.Quorum},
+		{"threshold", p.Threshold},
+		{"veto threshold", p.VetoThreshold},
+	}
+	for _, share := range shares {
+		dec, err := sdk.NewDecFromStr(share.value)
+		if err != nil {
+			return errors.Wrapf(ErrInvalidElectorateGroup, "invalid %s: %s", share.name, err)
+		}
+		if dec.IsNegative() || dec.GT(sdk.OneDec()) {
+			return errors.Wrapf(ErrInvalidElectorateGroup, "%s must be between 0 and 1: %s", share.name, dec)
+		}
+	}
+
+	if p.Windows == nil || p.Windows.VotingPeriod == 0 {
+		return errors.Wrap(ErrInvalidElectorateGroup, "voting period cannot be 0")
+	}
+	return nil
+}
+
+// Validate validates the policy against the group
+func (p *ElectorateDecisionPolicy) Validate(_ group.GroupInfo, config group.Config) error {
+	if p.Windows.MinExecutionPeriod > p.Windows.VotingPeriod+config.MaxExecutionPeriod {
+		return errors.Wrap(ErrInvalidElectorateGroup, "min_execution_period should be smaller than voting_period + max_execution_period")
+	}
+	return nil
+}
+
+// Allow decides the proposal by its tally, as a governance proposal would
+// be. The decision only becomes final early once the whole group has voted.
+func (p ElectorateDecisionPolicy) Allow(tally group.TallyResult, totalPower string) (group.DecisionPolicyResult, error) {
+	counts := make([]sdk.Dec, 5)
+	for i, count := range []string{tally.YesCount, tally.AbstainCount, tally.NoCount, tally.NoWithVetoCount, totalPower} {
+		dec, err := sdk.NewDecFromStr(count)
+		if err != nil {
+			return group.DecisionPolicyResult{}, errors.Wrapf(ErrInvalidElectorateGroup, "invalid tally: %s", err)
+		}
+		counts[i] = dec
+	}
+	yes, abstain, no, veto, total := counts[0], counts[1], counts[2], counts[3], counts[4]
+
+	quorum, threshold, vetoThreshold, err := p.shares()
+	if err != nil {
+		return group.DecisionPolicyResult{}, err
+	}
+
+	// Nobody can vote in an empty group
+	if !total.IsPositive() {
+		return group.DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	voted := yes.Add(abstain).Add(no).Add(veto)
+	result := group.DecisionPolicyResult{Final: voted.GTE(total)}
+
+	// The quorum must be reached
+	if voted.Quo(total).LT(quorum) {
+		return result, nil
+	}
+	// Too many vetoes reject the proposal
+	if veto.Quo(voted).GT(vetoThreshold) {
+		return result, nil
+	}
+	// Only the votes that did not abstain count towards the threshold
+	nonAbstain := voted.Sub(abstain)
+	if nonAbstain.IsZero() {
+		return result, nil
+	}
+
+	result.Allow = yes.Quo(nonAbstain).GT(threshold)
+	return result, nil
+}
+
+func (p ElectorateDecisionPolicy) shares() (quorum sdk.Dec, threshold sdk.Dec, vetoThreshold sdk.Dec, err error) {
+	if err := p.ValidateBasic(); err != nil {
+		return quorum, threshold, vetoThreshold, err
+	}
+	return sdk.MustNewDecFromStr(p.Quorum), sdk.MustNewDecFromStr(p.Threshold), sdk.MustNewDecFromStr(p.VetoThreshold), nil
+}
@@ -0,0 +1,820 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: membershipmodule/membership/electorate_group.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	group "github.com/cosmos/cosmos-sdk/x/group"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ElectorateDecisionPolicy is an x/group decision policy that decides
+// proposals by the same rules as this module's governance tally: a quorum of
+// the group's weight must vote, vetoes must stay under the veto threshold,
+// and yes votes must exceed the threshold of the votes that did not abstain
+type ElectorateDecisionPolicy struct {
+	// quorum is the minimum share of the group's total weight that must vote
+	Quorum string `protobuf:"bytes,1,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// threshold is the share of yes votes, among the votes that did not
+	// abstain, that must be exceeded for a proposal to pass
+	Threshold string `protobuf:"bytes,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// veto_threshold is the share of veto votes that rejects a proposal when
+	// exceeded
+	VetoThreshold string `protobuf:"bytes,3,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	// windows are the voting and minimum execution periods of proposals
+	Windows *group.DecisionPolicyWindows `protobuf:"bytes,4,opt,name=windows,proto3" json:"windows,omitempty"`
+}
+
+func (m *ElectorateDecisionPolicy) Reset()         { *m = ElectorateDecisionPolicy{} }
+func (m *ElectorateDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*ElectorateDecisionPolicy) ProtoMessage()    {}
+func (*ElectorateDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a1775a08096b915, []int{0}
+}
+func (m *ElectorateDecisionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ElectorateDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ElectorateDecisionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ElectorateDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ElectorateDecisionPolicy.Merge(m, src)
+}
+func (m *ElectorateDecisionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ElectorateDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ElectorateDecisionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ElectorateDecisionPolicy proto.InternalMessageInfo
+
+func (m *ElectorateDecisionPolicy) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
+	}
+	return ""
+}
+
+func (m *ElectorateDecisionPolicy) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
+func (m *ElectorateDecisionPolicy) GetVetoThreshold() string {
+	if m != nil {
+		return m.VetoThreshold
+	}
+	return ""
+}
+
+func (m *ElectorateDecisionPolicy) GetWindows() *group.DecisionPolicyWindows {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
+// ElectorateGroup is the x/group group whose members mirror the electorate
+type ElectorateGroup struct {
+	// group_id is the id of the group
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// group_policy_address is the address of the group's policy
+	GroupPolicyAddress string `protobuf:"bytes,2,opt,name=group_policy_address,json=groupPolicyAddress,proto3" json:"group_policy_address,omitempty"`
+	// member_weight is the weight that members who are not guardians were last
+	// given in the group
+	MemberWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=member_weight,json=memberWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"member_weight"`
+	// guardians are the guardians who were last given a weight in the group
+	Guardians []string `protobuf:"bytes,4,rep,name=guardians,proto3" json:"guardians,omitempty"`
+}
+
+func (m *ElectorateGroup) Reset()         { *m = ElectorateGroup{} }
+func (m *ElectorateGroup) String() string { return proto.CompactTextString(m) }
+func (*ElectorateGroup) ProtoMessage()    {}
+func (*ElectorateGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a1775a08096b915, []int{1}
+}
+func (m *ElectorateGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ElectorateGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ElectorateGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ElectorateGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ElectorateGroup.Merge(m, src)
+}
+func (m *ElectorateGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *ElectorateGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_ElectorateGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ElectorateGroup proto.InternalMessageInfo
+
+func (m *ElectorateGroup) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *ElectorateGroup) GetGroupPolicyAddress() string {
+	if m != nil {
+		return m.GroupPolicyAddress
+	}
+	return ""
+}
+
+func (m *ElectorateGroup) GetGuardians() []string {
+	if m != nil {
+		return m.Guardians
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ElectorateDecisionPolicy)(nil), "membershipmodule.membership.ElectorateDecisionPolicy")
+	proto.RegisterType((*ElectorateGroup)(nil), "membershipmodule.membership.ElectorateGroup")
+}
+
+func init() {
+	proto.RegisterFile("membershipmodule/membership/electorate_group.proto", fileDescriptor_4a1775a08096b915)
+}
+
+var fileDescriptor_4a1775a08096b915 = []byte{
+	// 416 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xcf, 0x8a, 0xd3, 0x40,
+	0x18, 0xcf, 0xb8, 0xcb, 0xae, 0x3b, 0xee, 0x2a, 0x0c, 0x8b, 0x64, 0x77, 0x25, 0x5b, 0x0a, 0x96,
+	0x5e, 0x92, 0xd8, 0x7a, 0xd2, 0x93, 0x96, 0x8a, 0x78, 0x93, 0x54, 0x28, 0x78, 0x09, 0x69, 0x66,
+	0x48, 0x06, 0x93, 0x7c, 0x71, 0x66, 0xd2, 0xda, 0xb7, 0xf0, 0x61, 0x7c, 0x88, 0xe2, 0xa9, 0xde,
+	0x44, 0xb0, 0x48, 0xfb, 0x22, 0x92, 0x99, 0xd8, 0x54, 0x0f, 0x9e, 0x92, 0xef, 0xf7, 0xe7, 0x9b,
+	0xef, 0x1f, 0x1e, 0xe6, 0x2c, 0x9f, 0x31, 0x21, 0x53, 0x5e, 0xe6, 0x40, 0xab, 0x8c, 0xf9, 0x2d,
+	0xe0, 0xb3, 0x8c, 0xc5, 0x0a, 0x44, 0xa4, 0x58, 0x98, 0x08, 0xa8, 0x4a, 0xaf, 0x14, 0xa0, 0x80,
+	0xdc, 0xfc, 0xeb, 0xf1, 0x5a, 0xe0, 0xfa, 0x26, 0x06, 0x99, 0x83, 0xf4, 0xb5, 0xc1, 0x9f, 0x0f,
+	0x7c, 0xb5, 0x2c, 0x99, 0x34, 0xce, 0xeb, 0x2b, 0x43, 0x86, 0x3a, 0xf2, 0x4d, 0xd0, 0x50, 0x97,
+	0x09, 0x24, 0x60, 0xf0, 0xfa, 0xcf, 0xa0, 0xdd, 0x9f, 0x08, 0xdb, 0xaf, 0xf6, 0x55, 0x8c, 0x59,
+	0xcc, 0x25, 0x87, 0xe2, 0x2d, 0x64, 0x3c, 0x5e, 0x92, 0x87, 0xf8, 0xe4, 0x63, 0x05, 0xa2, 0xca,
+	0x6d, 0xd4, 0x41, 0xfd, 0xb3, 0xa0, 0x89, 0xc8, 0x23, 0x7c, 0xa6, 0x52, 0xc1, 0x64, 0x0a, 0x19,
+	0xb5, 0xef, 0x68, 0xaa, 0x05, 0xc8, 0x63, 0x7c, 0x7f, 0xce, 0x14, 0x84, 0xad, 0xe4, 0x48, 0x4b,
+	0x2e, 0x6a, 0xf4, 0xdd, 0x5e, 0xf6, 0x02, 0x9f, 0x2e, 0x78, 0x41, 0x61, 0x21, 0xed, 0xe3, 0x0e,
+	0xea, 0xdf, 0x1b, 0xf6, 0xbc, 0xa6, 0x5e, 0x33, 0x8a, 0xf9, 0xc0, 0xfb, 0xbb, 0x9c, 0xa9, 0x51,
+	0x07, 0x7f, 0x6c, 0xcf, 0xbb, 0x5f, 0xbf, 0xb8, 0xce, 0xff, 0x3d, 0xdd, 0x6f, 0x08, 0x3f, 0x68,
+	0xfb, 0x7b, 0x5d, 0xab, 0xc8, 0x15, 0xbe, 0xab, 0xe5, 0x21, 0xa7, 0xba, 0xb1, 0xe3, 0xe0, 0x54,
+	0xc7, 0x6f, 0x28, 0x79, 0x82, 0x2f, 0x0d, 0x55, 0x6a, 0x7b, 0x18, 0x51, 0x2a, 0x98, 0x94, 0x4d,
+	0x93, 0x44, 0x73, 0x26, 0xf3, 0x4b, 0xc3, 0x90, 0x09, 0xbe, 0x30, 0xcb, 0x09, 0x17, 0x8c, 0x27,
+	0xa9, 0xd2, 0xcd, 0x9e, 0x8f, 0xbc, 0xd5, 0xe6, 0xd6, 0xfa, 0xb1, 0xb9, 0xed, 0x25, 0x5c, 0xa5,
+	0xd5, 0xcc, 0x8b, 0x21, 0x6f, 0xd6, 0xd1, 0x7c, 0x5c, 0x49, 0x3f, 0x34, 0xab, 0x1b, 0xb3, 0x38,
+	0x38, 0x37, 0x49, 0xa6, 0x3a, 0x47, 0x3d, 0xe0, 0xa4, 0x8a, 0x04, 0xe5, 0x51, 0x51, 0x4f, 0xe7,
+	0xa8, 0x1e, 0xf0, 0x1e, 0x18, 0x4d, 0x56, 0x5b, 0x07, 0xad, 0xb7, 0x0e, 0xfa, 0xb5, 0x75, 0xd0,
+	0xe7, 0x9d, 0x63, 0xad, 0x77, 0x8e, 0xf5, 0x7d, 0xe7, 0x58, 0xef, 0x9f, 0x1d, 0xbc, 0x56, 0x80,
+	0xe0, 0x91, 0x5b, 0x30, 0xe5, 0x9b, 0x1b, 0x72, 0x0f, 0xee, 0xee, 0xd3, 0xe1, 0x11, 0xea, 0x22,
+	0x66, 0x27, 0xfa, 0x1e, 0x9e, 0xfe, 0x1e, 0x00, 0xdd, 0xfd, 0xa3, 0xac, 0xb0, 0x02, 0x00, 0x00,
+}
+
+func (m *ElectorateDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ElectorateDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ElectorateDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Windows != nil {
+		{
+			size, err := m.Windows.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintElectorateGroup(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.VetoThreshold) > 0 {
+		i -= len(m.VetoThreshold)
+		copy(dAtA[i:], m.VetoThreshold)
+		i = encodeVarintElectorateGroup(dAtA, i, uint64(len(m.VetoThreshold)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintElectorateGroup(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintElectorateGroup(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ElectorateGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ElectorateGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ElectorateGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Guardians) > 0 {
+		for iNdEx := len(m.Guardians) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Guardians[iNdEx])
+			copy(dAtA[i:], m.Guardians[iNdEx])
+			i = encodeVarintElectorateGroup(dAtA, i, uint64(len(m.Guardians[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.MemberWeight.Size()
+		i -= size
+		if _, err := m.MemberWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintElectorateGroup(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.GroupPolicyAddress) > 0 {
+		i -= len(m.GroupPolicyAddress)
+		copy(dAtA[i:], m.GroupPolicyAddress)
+		i = encodeVarintElectorateGroup(dAtA, i, uint64(len(m.GroupPolicyAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintElectorateGroup(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintElectorateGroup(dAtA []byte, offset int, v uint64) int {
+	offset -= sovElectorateGroup(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ElectorateDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovElectorateGroup(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovElectorateGroup(uint64(l))
+	}
+	l = len(m.VetoThreshold)
+	if l > 0 {
+		n += 1 + l + sovElectorateGroup(uint64(l))
+	}
+	if m.Windows != nil {
+		l = m.Windows.Size()
+		n += 1 + l + sovElectorateGroup(uint64(l))
+	}
+	return n
+}
+
+func (m *ElectorateGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovElectorateGroup(uint64(m.GroupId))
+	}
+	l = len(m.GroupPolicyAddress)
+	if l > 0 {
+		n += 1 + l + sovElectorateGroup(uint64(l))
+	}
+	l = m.MemberWeight.Size()
+	n += 1 + l + sovElectorateGroup(uint64(l))
+	if len(m.Guardians) > 0 {
+		for _, s := range m.Guardians {
+			l = len(s)
+			n += 1 + l + sovElectorateGroup(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovElectorateGroup(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozElectorateGroup(x uint64) (n int) {
+	return sovElectorateGroup(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ElectorateDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowElectorateGroup
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ElectorateDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ElectorateDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElectorateGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthElectorateGroup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthElectorateGroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElectorateGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthElectorateGroup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthElectorateGroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElectorateGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthElectorateGroup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthElectorateGroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElectorateGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthElectorateGroup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthElectorateGroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Windows == nil {
+				m.Windows = &group.DecisionPolicyWindows{}
+			}
+			if err := m.Windows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipElectorateGroup(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthElectorateGroup
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ElectorateGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowElectorateGroup
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ElectorateGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ElectorateGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElectorateGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElectorateGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthElectorateGroup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthElectorateGroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberWeight", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElectorateGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthElectorateGroup
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthElectorateGroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MemberWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardians", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowElectorateGroup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthElectorateGroup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthElectorateGroup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardians = append(m.Guardians, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipElectorateGroup(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthElectorateGroup
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipElectorateGroup(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowElectorateGroup
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowElectorateGroup
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowElectorateGroup
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthElectorateGroup
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupElectorateGroup
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthElectorateGroup
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthElectorateGroup        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowElectorateGroup          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupElectorateGroup = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/stretchr/testify/require"
+)
+
+func TestElectorateDecisionPolicy_Allow(t *testing.T) {
+	policy := NewElectorateDecisionPolicy(sdk.NewDecWithPrec(4, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(334, 3), time.Hour, 0)
+
+	tests := []struct {
+		name       string
+		tally      group.TallyResult
+		totalPower string
+		want       group.DecisionPolicyResult
+	}{
+		{
+			name:       "empty group",
+			tally:      group.DefaultTallyResult(),
+			totalPower: "0",
+			want:       group.DecisionPolicyResult{Allow: false, Final: true},
+		}, {
+			name:       "quorum not reached",
+			tally:      group.TallyResult{YesCount: "3", AbstainCount: "0", NoCount: "0", NoWithVetoCount: "0"},
+			totalPower: "10",
+			want:       group.DecisionPolicyResult{Allow: false, Final: false},
+		}, {
+			name:       "passes once quorum is reached",
+			tally:      group.TallyResult{YesCount: "3", AbstainCount: "1", NoCount: "0", NoWithVetoCount: "0"},
+			totalPower: "10",
+			want:       group.DecisionPolicyResult{Allow: true, Final: false},
+		}, {
+			name:       "abstentions only count towards the quorum",
+			tally:      group.TallyResult{YesCount: "1", AbstainCount: "8", NoCount: "1", NoWithVetoCount: "0"},
+			totalPower: "10",
+			want:       group.DecisionPolicyResult{Allow: false, Final: true},
+		}, {
+			name:       "fractional weights",
+			tally:      group.TallyResult{YesCount: "2.5", AbstainCount: "0", NoCount: "1.25", NoWithVetoCount: "0"},
+			totalPower: "3.75",
+			want:       group.DecisionPolicyResult{Allow: true, Final: true},
+		}, {
+			name:       "vetoed",
+			tally:      group.TallyResult{YesCount: "6", AbstainCount: "0", NoCount: "0", NoWithVetoCount: "4"},
+			totalPower: "10",
+			want:       group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := policy.Allow(tt.tally, tt.totalPower)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := policy.Allow(group.TallyResult{YesCount: "invalid"}, "10")
+	require.ErrorIs(t, err, ErrInvalidElectorateGroup)
+}
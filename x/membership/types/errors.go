@@ -18,4 +18,27 @@ var (
 	ErrMemberNotEligibleToVote          = errors.Register(ModuleName, 9, "member is not eligible to vote")
 	ErrInvalidVoteWeighting             = errors.Register(ModuleName, 10, "invalid vote weighting")
 	ErrMemberNotPendingApproval         = errors.Register(ModuleName, 11, "member's status is not pending")
+	ErrInvitationNotFound               = errors.Register(ModuleName, 12, "invitation not found")
+	ErrInvalidInvitation                = errors.Register(ModuleName, 13, "invalid invitation")
+	ErrRecallPetitionNotFound           = errors.Register(ModuleName, 14, "recall petition not found")
+	ErrInvalidRecallPetition            = errors.Register(ModuleName, 15, "invalid recall petition")
+	ErrExpulsionAppealNotFound          = errors.Register(ModuleName, 16, "expulsion appeal not found")
+	ErrInvalidExpulsionAppeal           = errors.Register(ModuleName, 17, "invalid expulsion appeal")
+	ErrInvalidSuspension                = errors.Register(ModuleName, 18, "invalid suspension")
+	ErrInvalidCandidacy                 = errors.Register(ModuleName, 19, "invalid candidacy")
+	ErrElectionNotFound                 = errors.Register(ModuleName, 20, "election not found")
+	ErrInvalidElectionBallot            = errors.Register(ModuleName, 21, "invalid election ballot")
+	ErrTermLimitReached                 = errors.Register(ModuleName, 22, "guardian term limit reached")
+	ErrInvalidDecaySchedule             = errors.Register(ModuleName, 23, "invalid voting weight decay schedule")
+	ErrInvalidVoteDelegation            = errors.Register(ModuleName, 24, "invalid vote delegation")
+	ErrVoteDelegationNotFound           = errors.Register(ModuleName, 25, "vote delegation not found")
+	ErrInvalidSecretBallot              = errors.Register(ModuleName, 26, "invalid secret ballot")
+	ErrInvalidVoteCommit                = errors.Register(ModuleName, 27, "invalid vote commitment")
+	ErrSignerNotElectorate              = errors.Register(ModuleName, 28, "signer is not an electorate member")
+	ErrInvalidElectorateGroup           = errors.Register(ModuleName, 29, "invalid electorate group")
+	ErrElectorateGroupNotFound          = errors.Register(ModuleName, 30, "electorate group not found")
+	ErrSignerNotGuardian                = errors.Register(ModuleName, 31, "signer is not a guardian")
+	ErrInvalidTreasurySpend             = errors.Register(ModuleName, 32, "invalid treasury spend")
+	ErrInsufficientTreasuryFunds        = errors.Register(ModuleName, 33, "insufficient treasury funds")
+	ErrNoMemberRewards                  = errors.Register(ModuleName, 34, "no member rewards to claim")
 )
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -9,6 +10,8 @@ import (
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	govtypes_v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -23,6 +26,37 @@ type AccountKeeper interface {
 	SetAccount(sdk.Context, types.AccountI)
 }
 
+// BankKeeper defines the expected bank keeper, used to move funds in and out
+// of the treasury
+type BankKeeper interface {
+	// GetAllBalances returns all the account balances for the given account address.
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	// SendCoinsFromAccountToModule transfers coins from an AccAddress to a ModuleAccount
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	// SendCoinsFromModuleToAccount transfers coins from a ModuleAccount to an AccAddress
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	// BlockedAddr checks if a given address is restricted from receiving funds.
+	BlockedAddr(addr sdk.AccAddress) bool
+}
+
+// GroupKeeper defines the expected group keeper, used to mirror the electorate
+// in a group
+type GroupKeeper interface {
+	// CreateGroupWithPolicy creates a group and a policy for it
+	CreateGroupWithPolicy(goCtx context.Context, req *group.MsgCreateGroupWithPolicy) (*group.MsgCreateGroupWithPolicyResponse, error)
+	// UpdateGroupMembers adds, updates and removes the members of a group
+	UpdateGroupMembers(goCtx context.Context, req *group.MsgUpdateGroupMembers) (*group.MsgUpdateGroupMembersResponse, error)
+}
+
+// StakingKeeper defines the expected staking keeper, used to jail the
+// validators of members who leave the electorate
+type StakingKeeper interface {
+	// GetValidator gets a single validator
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+	// Jail sends a validator to jail
+	Jail(ctx sdk.Context, consAddr sdk.ConsAddress)
+}
+
 // internalGovKeeper implements everything except Hooks(), which expects a pointer receiver
 type internalGovKeeper interface {
 	// IterateInactiveProposalsQueue iterates over the proposals in the inactive proposal queue
@@ -39,8 +73,12 @@ type internalGovKeeper interface {
 	Router() *baseapp.MsgServiceRouter
 	// GetProposal gets a proposal from store by ProposalID.
 	GetProposal(ctx sdk.Context, proposalID uint64) (govtypes_v1.Proposal, bool)
+	// GetProposalID gets the ID of the next proposal to be submitted
+	GetProposalID(ctx sdk.Context) (proposalID uint64, err error)
 	// SetProposal set a proposal to store
 	SetProposal(ctx sdk.Context, proposal govtypes_v1.Proposal)
+	// InsertActiveProposalQueue inserts a proposalID into the active proposal queue at endTime
+	InsertActiveProposalQueue(ctx sdk.Context, proposalID uint64, endTime time.Time)
 	// RemoveFromActiveProposalQueue removes a proposalID from the Active Proposal Queue
 	RemoveFromActiveProposalQueue(ctx sdk.Context, proposalID uint64, endTime time.Time)
 	// DeleteProposal deletes a proposal from store.
@@ -50,6 +88,10 @@ type internalGovKeeper interface {
 	GetParams(clientCtx sdk.Context) (params govtypes_v1.Params)
 	// DeleteAndBurnDeposits deletes and burns all the deposits on a specific proposal.
 	DeleteAndBurnDeposits(ctx sdk.Context, proposalID uint64)
+	// SubmitProposal creates a new proposal given an array of messages
+	SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress) (govtypes_v1.Proposal, error)
+	// ActivateVotingPeriod moves a proposal straight into its voting period
+	ActivateVotingPeriod(ctx sdk.Context, proposal govtypes_v1.Proposal)
 }
 
 // GovKeeper implements our expected contract as well as exposes Gov's hooks
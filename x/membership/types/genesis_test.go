@@ -2,8 +2,10 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/noria-net/module-membership/testutil/sample"
 	"github.com/noria-net/module-membership/x/membership/types"
 	"github.com/stretchr/testify/require"
@@ -25,6 +27,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "valid genesis state: one guardian",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				DirectDemocracy: types.DirectDemocracy{
 					TotalVotingWeight: math.LegacyZeroDec(),
 					Guardians: []string{
@@ -85,6 +88,213 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "invalid genesis state: recall threshold of zero",
+			genState: &types.GenesisState{
+				Params:          paramsWith(func(p *types.Params) { p.RecallThreshold = math.LegacyZeroDec() }),
+				DirectDemocracy: types.DefaultDirectDemocracy(),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state: recall threshold > 1",
+			genState: &types.GenesisState{
+				Params:          paramsWith(func(p *types.Params) { p.RecallThreshold = math.LegacyMustNewDecFromStr("1.5") }),
+				DirectDemocracy: types.DefaultDirectDemocracy(),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state: appeal period of zero",
+			genState: &types.GenesisState{
+				Params:          paramsWith(func(p *types.Params) { p.AppealPeriod = 0 }),
+				DirectDemocracy: types.DefaultDirectDemocracy(),
+			},
+			valid: false,
+		},
+		{
+			desc: "valid genesis state: elections enabled",
+			genState: &types.GenesisState{
+				Params: paramsWith(func(p *types.Params) {
+					p.ElectionPeriod = 1000
+					p.ElectionDuration = 100
+					p.ElectionMethod = types.ElectionMethod_ElectionMethodSTV
+				}),
+				DirectDemocracy: types.DefaultDirectDemocracy(),
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid genesis state: election duration not shorter than the election period",
+			genState: &types.GenesisState{
+				Params: paramsWith(func(p *types.Params) {
+					p.ElectionPeriod = 1000
+					p.ElectionDuration = 1000
+				}),
+				DirectDemocracy: types.DefaultDirectDemocracy(),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state: min election turnout above one",
+			genState: &types.GenesisState{
+				Params:          paramsWith(func(p *types.Params) { p.MinElectionTurnout = sdk.NewDecWithPrec(11, 1) }),
+				DirectDemocracy: types.DefaultDirectDemocracy(),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state: zero max candidacies",
+			genState: &types.GenesisState{
+				Params:          paramsWith(func(p *types.Params) { p.MaxCandidacies = 0 }),
+				DirectDemocracy: types.DefaultDirectDemocracy(),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state: negative guardian term length",
+			genState: &types.GenesisState{
+				Params:          paramsWith(func(p *types.Params) { p.GuardianTermLength = -time.Hour }),
+				DirectDemocracy: types.DefaultDirectDemocracy(),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state: election duration of zero",
+			genState: &types.GenesisState{
+				Params:          paramsWith(func(p *types.Params) { p.ElectionDuration = 0 }),
+				DirectDemocracy: types.DefaultDirectDemocracy(),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state: zero guardian seats",
+			genState: &types.GenesisState{
+				Params:          paramsWith(func(p *types.Params) { p.GuardianSeats = 0 }),
+				DirectDemocracy: types.DefaultDirectDemocracy(),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state: unspecified election method",
+			genState: &types.GenesisState{
+				Params:          paramsWith(func(p *types.Params) { p.ElectionMethod = types.ElectionMethod_ElectionMethodEmpty }),
+				DirectDemocracy: types.DefaultDirectDemocracy(),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state: unspecified wasm access role",
+			genState: &types.GenesisState{
+				Params:          paramsWith(func(p *types.Params) { p.WasmAccessRole = types.WasmAccessRole_WasmAccessRoleEmpty }),
+				DirectDemocracy: types.DefaultDirectDemocracy(),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state: negative enrollment fee",
+			genState: &types.GenesisState{
+				Params: paramsWith(func(p *types.Params) {
+					p.EnrollmentFee = sdk.Coins{{Denom: "stake", Amount: math.NewInt(-1)}}
+				}),
+				DirectDemocracy: types.DefaultDirectDemocracy(),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state: dividend treasury share above one",
+			genState: &types.GenesisState{
+				Params:          paramsWith(func(p *types.Params) { p.DividendTreasuryShare = sdk.NewDecWithPrec(11, 1) }),
+				DirectDemocracy: types.DefaultDirectDemocracy(),
+			},
+			valid: false,
+		},
+		{
+			desc: "valid genesis state: bicameral message types",
+			genState: &types.GenesisState{
+				Params: paramsWith(func(p *types.Params) {
+					p.BicameralMsgTypes = []string{"/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade"}
+				}),
+				DirectDemocracy: types.DefaultDirectDemocracy(),
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid genesis state: duplicate bicameral message type",
+			genState: &types.GenesisState{
+				Params: paramsWith(func(p *types.Params) {
+					p.BicameralMsgTypes = []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgSend"}
+				}),
+				DirectDemocracy: types.DefaultDirectDemocracy(),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state: bicameral message type without a leading slash",
+			genState: &types.GenesisState{
+				Params:          paramsWith(func(p *types.Params) { p.BicameralMsgTypes = []string{"cosmos.bank.v1beta1.MsgSend"} }),
+				DirectDemocracy: types.DefaultDirectDemocracy(),
+			},
+			valid: false,
+		},
+		{
+			desc: "valid genesis state: tally rules",
+			genState: &types.GenesisState{
+				Params: paramsWith(func(p *types.Params) {
+					p.TallyRules = []types.TallyRule{{
+						MsgTypeUrl:    "/membershipmodule.membership.AddGuardiansProposal",
+						Quorum:        math.LegacyMustNewDecFromStr("0.4"),
+						Threshold:     math.LegacyMustNewDecFromStr("0.667"),
+						VetoThreshold: math.LegacyMustNewDecFromStr("0.334"),
+					}}
+				}),
+				DirectDemocracy: types.DefaultDirectDemocracy(),
+			},
+			valid: true,
+		},
+		{
+			desc: "valid genesis state: chamber rules",
+			genState: &types.GenesisState{
+				Params: paramsWith(func(p *types.Params) {
+					p.GuardianChamberRule = types.ChamberRule{
+						Quorum:        math.LegacyMustNewDecFromStr("0.5"),
+						Threshold:     math.LegacyMustNewDecFromStr("0.667"),
+						VetoThreshold: math.LegacyMustNewDecFromStr("0.334"),
+					}
+				}),
+				DirectDemocracy: types.DefaultDirectDemocracy(),
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid genesis state: member chamber quorum above 1",
+			genState: &types.GenesisState{
+				Params: paramsWith(func(p *types.Params) {
+					p.MemberChamberRule = types.ChamberRule{
+						Quorum:        math.LegacyMustNewDecFromStr("1.5"),
+						Threshold:     math.LegacyMustNewDecFromStr("0.5"),
+						VetoThreshold: math.LegacyMustNewDecFromStr("0.334"),
+					}
+				}),
+				DirectDemocracy: types.DefaultDirectDemocracy(),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state: tally rule threshold above 1",
+			genState: &types.GenesisState{
+				Params: paramsWith(func(p *types.Params) {
+					p.TallyRules = []types.TallyRule{{
+						MsgTypeUrl:    "/membershipmodule.membership.AddGuardiansProposal",
+						Quorum:        math.LegacyMustNewDecFromStr("0.4"),
+						Threshold:     math.LegacyMustNewDecFromStr("1.5"),
+						VetoThreshold: math.LegacyMustNewDecFromStr("0.334"),
+					}}
+				}),
+				DirectDemocracy: types.DefaultDirectDemocracy(),
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
@@ -98,3 +308,10 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+// paramsWith returns the default params with the given changes applied
+func paramsWith(change func(p *types.Params)) types.Params {
+	params := types.DefaultParams()
+	change(&params)
+	return params
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InvitationSecretMaxLength is the maximum number of characters allowed for
+// an invitation secret
+const InvitationSecretMaxLength = 128
+
+// InvitationHash returns the hex-encoded sha256 hash of an invitation secret
+// followed by the address it invites. Binding the invitee into the hash means
+// a secret seen in the mempool cannot enroll any other address.
+func InvitationHash(secret string, invitee sdk.AccAddress) string {
+	hash := sha256.Sum256(append([]byte(secret), invitee...))
+	return hex.EncodeToString(hash[:])
+}
+
+// ParseInvitationHash decodes a hex-encoded invitation hash and checks its length
+func ParseInvitationHash(hash string) ([]byte, error) {
+	bz, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidInvitation, "invitation hash is not valid hex (%s)", err)
+	}
+	if len(bz) != sha256.Size {
+		return nil, errors.Wrapf(ErrInvalidInvitation, "invitation hash must be %d bytes", sha256.Size)
+	}
+	return bz, nil
+}
+
+// IsUsable returns true if the invitation can still admit a new member at the given time
+func (i Invitation) IsUsable(now time.Time) bool {
+	return !i.Revoked &&
+		i.Uses < i.MaxUses &&
+		now.Before(i.ExpiresAt)
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -30,14 +32,104 @@ const (
 // - 0x02<memberStatus (1 Byte)><memberAddrLen (1 Byte)><memberAddr_Bytes>: Status-Filtered Member
 //
 // - 0x03<memberStatus (1 Byte)>: Status-Filtered Member Count
+//
+// - 0x07<invitationHash (32 Bytes)>: Invitation
+//
+// - 0x08<targetAddrLen (1 Byte)><targetAddr_Bytes>: RecallPetition
+//
+// - 0x09<proposalID (8 Bytes)>: Recall petition target address
+//
+// - 0x0A<memberAddrLen (1 Byte)><memberAddr_Bytes>: ExpulsionAppeal
+//
+// - 0x0B<deadline (Time Bytes)><memberAddrLen (1 Byte)><memberAddr_Bytes>: Open appeal window queue
+//
+// - 0x0C<proposalID (8 Bytes)>: Appealing member address
+//
+// - 0x0D<memberAddrLen (1 Byte)><memberAddr_Bytes>: Suspension
+//
+// - 0x0E<endTime (Time Bytes)><memberAddrLen (1 Byte)><memberAddr_Bytes>: Suspension queue
+//
+// - 0x0F<memberAddrLen (1 Byte)><memberAddr_Bytes><startTime (Time Bytes)>: Past suspension
+//
+// - 0x10<candidateAddrLen (1 Byte)><candidateAddr_Bytes>: Candidacy
+//
+// - 0x11: Open guardian election
+//
+// - 0x12<electionID (8 Bytes)><voterAddrLen (1 Byte)><voterAddr_Bytes>: ElectionBallot
+//
+// - 0x13<electionID (8 Bytes)>: ElectionResult
+//
+// - 0x14: Guardian election count
+//
+// - 0x15<guardianAddrLen (1 Byte)><guardianAddr_Bytes>: GuardianTerm
+//
+// - 0x16<endTime (Time Bytes)><guardianAddrLen (1 Byte)><guardianAddr_Bytes>: Guardian term queue
+//
+// - 0x17<proposalID (8 Bytes)>: TallyBreakdown
+//
+// - 0x18<delegatorAddrLen (1 Byte)><delegatorAddr_Bytes>: VoteDelegation
+//
+// - 0x19<proposalID (8 Bytes)>: SecretBallot
+//
+// - 0x1A<proposalID (8 Bytes)><voterAddrLen (1 Byte)><voterAddr_Bytes>: VoteCommit
+//
+// - 0x1B<memberAddrLen (1 Byte)><memberAddr_Bytes>: FeeWaiverUsage
+//
+// - 0x1C: ElectorateGroup
+//
+// - 0x1D<memberAddrLen (1 Byte)><memberAddr_Bytes>: Electorate group weight
+//
+// - 0x1E<memberAddrLen (1 Byte)><memberAddr_Bytes>: Electorate group sync queue
+//
+// - 0x1F<spendID (8 Bytes)>: TreasurySpend
+//
+// - 0x20: TreasurySpend count
+//
+// - 0x21<spendID (8 Bytes)>: Streamed treasury spend still being paid out
+//
+// - 0x22: DividendPool
+//
+// - 0x23<memberAddrLen (1 Byte)><memberAddr_Bytes>: MemberDividend
+//
+// - 0x24<expiresAt (Time Bytes)><targetAddrLen (1 Byte)><targetAddr_Bytes>: Recall petition queue
 var (
-	MembersKeyPrefix           = []byte{0x00} // prefix for each key to a member
-	MemberCountKey             = []byte{0x01} // key for the member count
-	MemberStatusKeyPrefix      = []byte{0x02} // prefix for each key to a member filtered by status
-	MemberStatusCountKeyPrefix = []byte{0x03} // prefix for the count of members filtered by status
-	MemberMetadataKeyPrefix    = []byte{0x04} // prefix for each key to a member's metadata
-	VotesToDeleteKeyPrefix     = []byte{0x05} // prefix for each key to a vote
-	DirectDemocracyKey         = []byte{0x06} // key for the Direct Democracy settings
+	MembersKeyPrefix               = []byte{0x00} // prefix for each key to a member
+	MemberCountKey                 = []byte{0x01} // key for the member count
+	MemberStatusKeyPrefix          = []byte{0x02} // prefix for each key to a member filtered by status
+	MemberStatusCountKeyPrefix     = []byte{0x03} // prefix for the count of members filtered by status
+	MemberMetadataKeyPrefix        = []byte{0x04} // prefix for each key to a member's metadata
+	VotesToDeleteKeyPrefix         = []byte{0x05} // prefix for each key to a vote
+	DirectDemocracyKey             = []byte{0x06} // key for the Direct Democracy settings
+	InvitationKeyPrefix            = []byte{0x07} // prefix for each key to an invitation
+	RecallPetitionKeyPrefix        = []byte{0x08} // prefix for each key to a recall petition
+	RecallProposalKeyPrefix        = []byte{0x09} // prefix for each key to a recall proposal's target
+	ExpulsionAppealKeyPrefix       = []byte{0x0A} // prefix for each key to an expulsion appeal
+	AppealQueueKeyPrefix           = []byte{0x0B} // prefix for the queue of open appeal windows, ordered by deadline
+	AppealProposalKeyPrefix        = []byte{0x0C} // prefix for each key to a reinstatement proposal's member
+	SuspensionKeyPrefix            = []byte{0x0D} // prefix for each key to an active suspension
+	SuspensionQueueKeyPrefix       = []byte{0x0E} // prefix for the queue of active suspensions, ordered by end time
+	SuspensionHistoryKeyPrefix     = []byte{0x0F} // prefix for each key to a past suspension
+	CandidacyKeyPrefix             = []byte{0x10} // prefix for each key to a guardian candidacy
+	CurrentElectionKey             = []byte{0x11} // key for the open guardian election
+	ElectionBallotKeyPrefix        = []byte{0x12} // prefix for each key to a guardian election ballot
+	ElectionResultKeyPrefix        = []byte{0x13} // prefix for each key to a guardian election result
+	ElectionCountKey               = []byte{0x14} // key for the number of guardian elections held
+	GuardianTermKeyPrefix          = []byte{0x15} // prefix for each key to a guardian's term
+	GuardianTermQueueKeyPrefix     = []byte{0x16} // prefix for the queue of guardian terms, ordered by end time
+	TallyBreakdownKeyPrefix        = []byte{0x17} // prefix for each key to a proposal's tally breakdown
+	VoteDelegationKeyPrefix        = []byte{0x18} // prefix for each key to a member's vote delegation
+	SecretBallotKeyPrefix          = []byte{0x19} // prefix for each key to a secret ballot proposal
+	VoteCommitKeyPrefix            = []byte{0x1A} // prefix for each key to a secret vote commitment
+	FeeWaiverUsageKeyPrefix        = []byte{0x1B} // prefix for each key to a member's fee waiver usage
+	ElectorateGroupKey             = []byte{0x1C} // key for the group that mirrors the electorate
+	ElectorateGroupWeightKeyPrefix = []byte{0x1D} // prefix for each key to a member's weight in the electorate group
+	ElectorateGroupSyncKeyPrefix   = []byte{0x1E} // prefix for the members whose electorate group weight must be synced
+	TreasurySpendKeyPrefix         = []byte{0x1F} // prefix for each key to a treasury spend
+	TreasurySpendCountKey          = []byte{0x20} // key for the number of treasury spends approved
+	TreasuryStreamKeyPrefix        = []byte{0x21} // prefix for the treasury spends still being streamed to their recipient
+	DividendPoolKey                = []byte{0x22} // key for the member dividend's reward index and reserve
+	MemberDividendKeyPrefix        = []byte{0x23} // prefix for each key to a member's dividend position
+	RecallPetitionQueueKeyPrefix   = []byte{0x24} // prefix for the queue of recall petitions collecting signatures, ordered by expiry
 
 	// Add keys here so that we can check for duplicate values in a unit test
 	AllKeys = [][]byte{
@@ -48,6 +140,36 @@ var (
 		MemberMetadataKeyPrefix,
 		VotesToDeleteKeyPrefix,
 		DirectDemocracyKey,
+		InvitationKeyPrefix,
+		RecallPetitionKeyPrefix,
+		RecallProposalKeyPrefix,
+		ExpulsionAppealKeyPrefix,
+		AppealQueueKeyPrefix,
+		AppealProposalKeyPrefix,
+		SuspensionKeyPrefix,
+		SuspensionQueueKeyPrefix,
+		SuspensionHistoryKeyPrefix,
+		CandidacyKeyPrefix,
+		CurrentElectionKey,
+		ElectionBallotKeyPrefix,
+		ElectionResultKeyPrefix,
+		ElectionCountKey,
+		GuardianTermKeyPrefix,
+		GuardianTermQueueKeyPrefix,
+		TallyBreakdownKeyPrefix,
+		VoteDelegationKeyPrefix,
+		SecretBallotKeyPrefix,
+		VoteCommitKeyPrefix,
+		FeeWaiverUsageKeyPrefix,
+		ElectorateGroupKey,
+		ElectorateGroupWeightKeyPrefix,
+		ElectorateGroupSyncKeyPrefix,
+		TreasurySpendKeyPrefix,
+		TreasurySpendCountKey,
+		TreasuryStreamKeyPrefix,
+		DividendPoolKey,
+		MemberDividendKeyPrefix,
+		RecallPetitionQueueKeyPrefix,
 	}
 )
 
@@ -84,3 +206,166 @@ func VotesToDeleteKey(proposalID uint64) []byte {
 func VoteToDeleteKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
 	return append(VotesToDeleteKey(proposalID), address.MustLengthPrefix(voterAddr.Bytes())...)
 }
+
+// InvitationKey returns the key for the invitation with the given secret hash
+func InvitationKey(hash []byte) []byte {
+	return append(InvitationKeyPrefix, hash...)
+}
+
+// RecallPetitionKey returns the key for the recall petition against the given address
+func RecallPetitionKey(target sdk.AccAddress) []byte {
+	return append(RecallPetitionKeyPrefix, address.MustLengthPrefix(target.Bytes())...)
+}
+
+// RecallProposalKey returns the key for the target of the recall proposal with the given ID
+func RecallProposalKey(proposalID uint64) []byte {
+	return append(RecallProposalKeyPrefix, govtypes.GetProposalIDBytes(proposalID)...)
+}
+
+// RecallPetitionQueueByTimeKey returns the key prefix for recall petitions expiring at the given time
+func RecallPetitionQueueByTimeKey(expiresAt time.Time) []byte {
+	return append(RecallPetitionQueueKeyPrefix, sdk.FormatTimeBytes(expiresAt)...)
+}
+
+// RecallPetitionQueueKey returns the key for the petition against the given address expiring at the given time
+func RecallPetitionQueueKey(expiresAt time.Time, target sdk.AccAddress) []byte {
+	return append(RecallPetitionQueueByTimeKey(expiresAt), address.MustLengthPrefix(target.Bytes())...)
+}
+
+// ExpulsionAppealKey returns the key for the expulsion appeal of the given address
+func ExpulsionAppealKey(member sdk.AccAddress) []byte {
+	return append(ExpulsionAppealKeyPrefix, address.MustLengthPrefix(member.Bytes())...)
+}
+
+// AppealQueueByTimeKey returns the key prefix for appeal windows closing at the given time
+func AppealQueueByTimeKey(deadline time.Time) []byte {
+	return append(AppealQueueKeyPrefix, sdk.FormatTimeBytes(deadline)...)
+}
+
+// AppealQueueKey returns the key for the member's appeal window closing at the given time
+func AppealQueueKey(deadline time.Time, member sdk.AccAddress) []byte {
+	return append(AppealQueueByTimeKey(deadline), address.MustLengthPrefix(member.Bytes())...)
+}
+
+// AppealProposalKey returns the key for the member appealing through the proposal with the given ID
+func AppealProposalKey(proposalID uint64) []byte {
+	return append(AppealProposalKeyPrefix, govtypes.GetProposalIDBytes(proposalID)...)
+}
+
+// SuspensionKey returns the key for the active suspension of the given address
+func SuspensionKey(member sdk.AccAddress) []byte {
+	return append(SuspensionKeyPrefix, address.MustLengthPrefix(member.Bytes())...)
+}
+
+// SuspensionQueueByTimeKey returns the key prefix for suspensions ending at the given time
+func SuspensionQueueByTimeKey(endTime time.Time) []byte {
+	return append(SuspensionQueueKeyPrefix, sdk.FormatTimeBytes(endTime)...)
+}
+
+// SuspensionQueueKey returns the key for the member's suspension ending at the given time
+func SuspensionQueueKey(endTime time.Time, member sdk.AccAddress) []byte {
+	return append(SuspensionQueueByTimeKey(endTime), address.MustLengthPrefix(member.Bytes())...)
+}
+
+// SuspensionHistoryPrefix returns the key prefix for the past suspensions of the given address
+func SuspensionHistoryPrefix(member sdk.AccAddress) []byte {
+	return append(SuspensionHistoryKeyPrefix, address.MustLengthPrefix(member.Bytes())...)
+}
+
+// SuspensionHistoryKey returns the key for the member's past suspension that started at the given time
+func SuspensionHistoryKey(member sdk.AccAddress, startTime time.Time) []byte {
+	return append(SuspensionHistoryPrefix(member), sdk.FormatTimeBytes(startTime)...)
+}
+
+// CandidacyKey returns the key for the candidacy of the given address
+func CandidacyKey(candidate sdk.AccAddress) []byte {
+	return append(CandidacyKeyPrefix, address.MustLengthPrefix(candidate.Bytes())...)
+}
+
+// ElectionBallotsKey returns the key prefix for the ballots cast in the election with the given ID
+func ElectionBallotsKey(electionID uint64) []byte {
+	return append(ElectionBallotKeyPrefix, sdk.Uint64ToBigEndian(electionID)...)
+}
+
+// ElectionBallotKey returns the key for the voter's ballot in the election with the given ID
+func ElectionBallotKey(electionID uint64, voter sdk.AccAddress) []byte {
+	return append(ElectionBallotsKey(electionID), address.MustLengthPrefix(voter.Bytes())...)
+}
+
+// ElectionResultKey returns the key for the result of the election with the given ID
+func ElectionResultKey(electionID uint64) []byte {
+	return append(ElectionResultKeyPrefix, sdk.Uint64ToBigEndian(electionID)...)
+}
+
+// GuardianTermKey returns the key for the term of the given guardian
+func GuardianTermKey(guardian sdk.AccAddress) []byte {
+	return append(GuardianTermKeyPrefix, address.MustLengthPrefix(guardian.Bytes())...)
+}
+
+// GuardianTermQueueByTimeKey returns the key prefix for guardian terms ending at the given time
+func GuardianTermQueueByTimeKey(endTime time.Time) []byte {
+	return append(GuardianTermQueueKeyPrefix, sdk.FormatTimeBytes(endTime)...)
+}
+
+// GuardianTermQueueKey returns the key for the guardian's term ending at the given time
+func GuardianTermQueueKey(endTime time.Time, guardian sdk.AccAddress) []byte {
+	return append(GuardianTermQueueByTimeKey(endTime), address.MustLengthPrefix(guardian.Bytes())...)
+}
+
+// TallyBreakdownKey returns the key for the tally breakdown of the given proposal
+func TallyBreakdownKey(proposalID uint64) []byte {
+	return append(TallyBreakdownKeyPrefix, sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// VoteDelegationKey returns the key for the vote delegation of the given delegator
+func VoteDelegationKey(delegator sdk.AccAddress) []byte {
+	return append(VoteDelegationKeyPrefix, address.MustLengthPrefix(delegator.Bytes())...)
+}
+
+// SecretBallotKey returns the key for the secret ballot of the given proposal
+func SecretBallotKey(proposalID uint64) []byte {
+	return append(SecretBallotKeyPrefix, sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// VoteCommitsKey returns the key prefix for the vote commitments on the given proposal
+func VoteCommitsKey(proposalID uint64) []byte {
+	return append(VoteCommitKeyPrefix, sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// VoteCommitKey returns the key for the voter's commitment on the given proposal
+func VoteCommitKey(proposalID uint64, voter sdk.AccAddress) []byte {
+	return append(VoteCommitsKey(proposalID), address.MustLengthPrefix(voter.Bytes())...)
+}
+
+// FeeWaiverUsageKey returns the key for the fee waiver usage of the given member
+func FeeWaiverUsageKey(member sdk.AccAddress) []byte {
+	return append(FeeWaiverUsageKeyPrefix, address.MustLengthPrefix(member.Bytes())...)
+}
+
+// ElectorateGroupWeightKey returns the key for the member's weight in the
+// electorate group
+func ElectorateGroupWeightKey(member sdk.AccAddress) []byte {
+	return append(ElectorateGroupWeightKeyPrefix, address.MustLengthPrefix(member.Bytes())...)
+}
+
+// ElectorateGroupSyncKey returns the key that queues the member's
+// electorate group weight to be synced
+func ElectorateGroupSyncKey(member sdk.AccAddress) []byte {
+	return append(ElectorateGroupSyncKeyPrefix, address.MustLengthPrefix(member.Bytes())...)
+}
+
+// TreasurySpendKey returns the key for the treasury spend with the given ID
+func TreasurySpendKey(spendID uint64) []byte {
+	return append(TreasurySpendKeyPrefix, sdk.Uint64ToBigEndian(spendID)...)
+}
+
+// TreasuryStreamKey returns the key that marks the treasury spend with the
+// given ID as still being streamed
+func TreasuryStreamKey(spendID uint64) []byte {
+	return append(TreasuryStreamKeyPrefix, sdk.Uint64ToBigEndian(spendID)...)
+}
+
+// MemberDividendKey returns the key for the dividend position of the given member
+func MemberDividendKey(member sdk.AccAddress) []byte {
+	return append(MemberDividendKeyPrefix, address.MustLengthPrefix(member.Bytes())...)
+}
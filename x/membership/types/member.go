@@ -3,7 +3,7 @@ package types
 import (
 	"strings"
 
-	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NicknameMaxLength is the maximum number of characters allowed for
@@ -12,26 +12,39 @@ const NicknameMaxLength = 30
 
 const MembershipStatusPrefix = "MEMBERSHIP_STATUS_"
 const MemberMetadata_Nickname = "nickname"
+const MemberMetadata_Invitation = "invitation"
 
 // Members is a collection of Member objects
 type Members []*Member
 
 // AllowedMembershipStatusTransitions holds a truth table of all permissable membership status changes
 var AllowedMembershipStatusTransitions = map[MembershipStatus][]MembershipStatus{
-	MembershipStatus_MemberElectorate: {MembershipStatus_MemberInactive, MembershipStatus_MemberExpulsed},
-	MembershipStatus_MemberInactive:   {MembershipStatus_MemberElectorate},
-	MembershipStatus_MemberRecalled:   {MembershipStatus_MemberElectorate},
-	MembershipStatus_MemberExpulsed:   {MembershipStatus_MemberElectorate},
+	MembershipStatus_MemberStatusPendingApproval: {MembershipStatus_MemberElectorate},
+	MembershipStatus_MemberElectorate:            {MembershipStatus_MemberInactive, MembershipStatus_MemberExpulsed, MembershipStatus_MemberSuspended},
+	MembershipStatus_MemberInactive:              {MembershipStatus_MemberElectorate, MembershipStatus_MemberSuspended},
+	MembershipStatus_MemberRecalled:              {MembershipStatus_MemberElectorate},
+	MembershipStatus_MemberExpulsed:              {MembershipStatus_MemberElectorate},
+	MembershipStatus_MemberSuspended:             {MembershipStatus_MemberElectorate, MembershipStatus_MemberInactive, MembershipStatus_MemberExpulsed},
 }
 
-// NewMemberAccountWithDefaultMemberStatus creats a new member account with a
-// default member status of Electorate.
-func NewMemberAccountWithDefaultMemberStatus(baseAccount *authtypes.BaseAccount) *Member {
-	acc := &Member{
-		BaseAccount: baseAccount,
-		Status:      MembershipStatus_MemberStatusPendingApproval,
+// RecallableMembershipStatuses holds the statuses from which a member can be
+// recalled. Recalls are not a generic transition, as they must go through a
+// recall petition and a governance vote.
+var RecallableMembershipStatuses = []MembershipStatus{MembershipStatus_MemberElectorate, MembershipStatus_MemberInactive}
+
+// NewMemberWithDefaultMemberStatus creates a new member with a default member
+// status of Pending Approval.
+func NewMemberWithDefaultMemberStatus(address sdk.AccAddress) *Member {
+	return &Member{
+		Address: address.String(),
+		Status:  MembershipStatus_MemberStatusPendingApproval,
 	}
-	return acc
+}
+
+// GetAddress returns the address of the member
+func (m Member) GetAddress() sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Address)
+	return addr
 }
 
 // NewMemberAccountWithStatus parses the raw status and returns a valid status value
@@ -79,6 +92,16 @@ func (m MembershipStatus) CanTransitionTo(desired MembershipStatus) bool {
 	return false
 }
 
+// CanBeRecalled returns true if a member with the receiver MembershipStatus can be recalled.
+func (m MembershipStatus) CanBeRecalled() bool {
+	for _, s := range RecallableMembershipStatuses {
+		if s == m {
+			return true
+		}
+	}
+	return false
+}
+
 // IsValid returns true if the status is within range and is not zero / empty
 func (m MembershipStatus) IsValid() bool {
 	_, ok := MembershipStatus_name[int32(m)]
@@ -5,7 +5,6 @@ package types
 
 import (
 	fmt "fmt"
-	types "github.com/cosmos/cosmos-sdk/x/auth/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -41,6 +40,8 @@ const (
 	MembershipStatus_MemberRecalled MembershipStatus = 4
 	// MEMBERSHIP_STATUS_EXPULSED defines this member as being expulsed
 	MembershipStatus_MemberExpulsed MembershipStatus = 5
+	// MEMBERSHIP_STATUS_SUSPENDED defines this member as being temporarily suspended
+	MembershipStatus_MemberSuspended MembershipStatus = 6
 )
 
 var MembershipStatus_name = map[int32]string{
@@ -50,6 +51,7 @@ var MembershipStatus_name = map[int32]string{
 	3: "MEMBERSHIP_STATUS_INACTIVE",
 	4: "MEMBERSHIP_STATUS_RECALLED",
 	5: "MEMBERSHIP_STATUS_EXPULSED",
+	6: "MEMBERSHIP_STATUS_SUSPENDED",
 }
 
 var MembershipStatus_value = map[string]int32{
@@ -59,6 +61,7 @@ var MembershipStatus_value = map[string]int32{
 	"MEMBERSHIP_STATUS_INACTIVE":         3,
 	"MEMBERSHIP_STATUS_RECALLED":         4,
 	"MEMBERSHIP_STATUS_EXPULSED":         5,
+	"MEMBERSHIP_STATUS_SUSPENDED":        6,
 }
 
 func (x MembershipStatus) String() string {
@@ -69,20 +72,22 @@ func (MembershipStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_5ba2fc082642cdf4, []int{0}
 }
 
-// Member is a specialisation of BaseAccount that adds Member Status and
-// Nickname
+// Member is the membership record of an address. It is independent of the
+// account at that address, so that any kind of account can be a member.
 type Member struct {
-	*types.BaseAccount `protobuf:"bytes,1,opt,name=base_account,json=baseAccount,proto3,embedded=base_account" json:"base_account,omitempty"`
 	// status defines the membership status of this member
 	Status MembershipStatus `protobuf:"varint,2,opt,name=status,proto3,enum=membershipmodule.membership.MembershipStatus" json:"status,omitempty"`
 	// nickname defines the nickname of this member
 	Nickname string `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
 	// is_guardian defines whether this member is a guardian
 	IsGuardian bool `protobuf:"varint,4,opt,name=is_guardian,json=isGuardian,proto3" json:"is_guardian,omitempty"`
+	// address is the address of this member
+	Address string `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *Member) Reset()      { *m = Member{} }
-func (*Member) ProtoMessage() {}
+func (m *Member) Reset()         { *m = Member{} }
+func (m *Member) String() string { return proto.CompactTextString(m) }
+func (*Member) ProtoMessage()    {}
 func (*Member) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5ba2fc082642cdf4, []int{0}
 }
@@ -123,39 +128,39 @@ func init() {
 }
 
 var fileDescriptor_5ba2fc082642cdf4 = []byte{
-	// 508 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x8b, 0xd3, 0x40,
-	0x14, 0xc6, 0x33, 0xbb, 0xb5, 0xd4, 0xa9, 0x2c, 0x31, 0xac, 0x50, 0xb2, 0x9a, 0x86, 0x3d, 0x15,
-	0xa1, 0x09, 0x5b, 0x41, 0xd4, 0x5b, 0xda, 0x8e, 0x35, 0xd0, 0x76, 0x43, 0xd2, 0x2e, 0xe2, 0xa5,
-	0x4c, 0xd2, 0xa1, 0x0d, 0x26, 0x99, 0x90, 0x99, 0x94, 0xdd, 0xff, 0x40, 0x7a, 0xf2, 0xe8, 0xa5,
-	0xb0, 0x7f, 0x8e, 0xc7, 0x1e, 0x3d, 0x89, 0xb6, 0x27, 0xff, 0x0b, 0x31, 0x29, 0xdb, 0xb0, 0x55,
-	0x6f, 0xdf, 0xfb, 0x78, 0xbf, 0xef, 0xbd, 0xc3, 0x07, 0x1b, 0x21, 0x09, 0x5d, 0x92, 0xb0, 0xb9,
-	0x1f, 0x87, 0x74, 0x9a, 0x06, 0x44, 0xdf, 0x1b, 0x3b, 0xa9, 0xc5, 0x09, 0xe5, 0x54, 0x3a, 0xbb,
-	0xbf, 0xa9, 0xed, 0x0d, 0x59, 0xf1, 0x28, 0x0b, 0x29, 0xd3, 0x71, 0xca, 0xe7, 0xfa, 0xe2, 0xc2,
-	0x25, 0x1c, 0x5f, 0x64, 0x43, 0x0e, 0xcb, 0xa7, 0x33, 0x3a, 0xa3, 0x99, 0xd4, 0xff, 0xa8, 0xdc,
-	0x3d, 0xff, 0x09, 0x60, 0x79, 0x90, 0x85, 0x48, 0x26, 0x7c, 0xe4, 0x62, 0x46, 0x26, 0xd8, 0xf3,
-	0x68, 0x1a, 0xf1, 0x1a, 0x50, 0x41, 0xa3, 0xda, 0x52, 0xb5, 0x3c, 0x57, 0xcb, 0xa2, 0x76, 0xb9,
-	0x5a, 0x1b, 0x33, 0x62, 0xe4, 0x7b, 0xed, 0xd2, 0xfa, 0x7b, 0x1d, 0xd8, 0x55, 0x77, 0x6f, 0x49,
-	0x08, 0x96, 0x19, 0xc7, 0x3c, 0x65, 0xb5, 0x23, 0x15, 0x34, 0x4e, 0x5a, 0x4d, 0xed, 0x3f, 0x9f,
-	0x6b, 0x83, 0x3b, 0xe9, 0x64, 0x90, 0xbd, 0x83, 0x25, 0x19, 0x56, 0x22, 0xdf, 0xfb, 0x18, 0xe1,
-	0x90, 0xd4, 0x8e, 0x55, 0xd0, 0x78, 0x68, 0xdf, 0xcd, 0x52, 0x1d, 0x56, 0x7d, 0x36, 0x99, 0xa5,
-	0x38, 0x99, 0xfa, 0x38, 0xaa, 0x95, 0x54, 0xd0, 0xa8, 0xd8, 0xd0, 0x67, 0xbd, 0x9d, 0xf3, 0xa6,
-	0xf2, 0xe9, 0xb6, 0x2e, 0x7c, 0xb9, 0xad, 0x0b, 0xcf, 0x7f, 0x1d, 0x41, 0xf1, 0xfe, 0x0d, 0xe9,
-	0x15, 0x7c, 0x36, 0x40, 0x83, 0x36, 0xb2, 0x9d, 0x77, 0xa6, 0x35, 0x71, 0x46, 0xc6, 0x68, 0xec,
-	0x4c, 0xc6, 0x43, 0xc7, 0x42, 0x1d, 0xf3, 0xad, 0x89, 0xba, 0xa2, 0x20, 0x3f, 0x59, 0xae, 0xd4,
-	0xc7, 0x39, 0x98, 0x43, 0x28, 0x8c, 0xf9, 0x8d, 0xd4, 0x83, 0xe7, 0x87, 0xa4, 0x85, 0x86, 0x5d,
-	0x73, 0xd8, 0x9b, 0x18, 0x96, 0x65, 0x5f, 0x5e, 0x19, 0x7d, 0x11, 0xc8, 0xf5, 0xe5, 0x4a, 0x3d,
-	0x2b, 0xe2, 0x16, 0x89, 0xa6, 0x7e, 0x34, 0x33, 0xe2, 0x38, 0xa1, 0x0b, 0x1c, 0x48, 0x2f, 0xe1,
-	0xd3, 0xc3, 0x20, 0xd4, 0x47, 0x9d, 0xd1, 0xa5, 0x6d, 0x8c, 0x90, 0x78, 0x24, 0x9f, 0x2e, 0x57,
-	0xea, 0xee, 0x75, 0x14, 0x10, 0x8f, 0xd3, 0x04, 0x73, 0x22, 0xb5, 0xa0, 0x7c, 0xc8, 0x99, 0x43,
-	0xa3, 0x33, 0x32, 0xaf, 0x90, 0x78, 0x2c, 0x4b, 0xcb, 0x95, 0x7a, 0x92, 0x53, 0x66, 0x84, 0x3d,
-	0xee, 0x2f, 0xfe, 0xc1, 0xd8, 0xa8, 0x63, 0xf4, 0xfb, 0xa8, 0x2b, 0x96, 0x8a, 0x8c, 0x4d, 0x3c,
-	0x1c, 0x04, 0x64, 0xfa, 0x77, 0x06, 0xbd, 0xb7, 0xc6, 0x7d, 0x07, 0x75, 0xc5, 0x07, 0x45, 0x06,
-	0x5d, 0xc7, 0x69, 0xc0, 0xc8, 0xb4, 0xed, 0x7c, 0xdd, 0x28, 0x60, 0xbd, 0x51, 0xc0, 0x8f, 0x8d,
-	0x02, 0x3e, 0x6f, 0x15, 0x61, 0xbd, 0x55, 0x84, 0x6f, 0x5b, 0x45, 0xf8, 0xf0, 0x7a, 0xe6, 0xf3,
-	0x79, 0xea, 0x6a, 0x1e, 0x0d, 0xf5, 0x88, 0x26, 0x3e, 0x6e, 0x46, 0x84, 0xeb, 0x79, 0x1b, 0x9a,
-	0x85, 0xc6, 0x5f, 0x17, 0xeb, 0xcf, 0x6f, 0x62, 0xc2, 0xdc, 0x72, 0xd6, 0xd5, 0x17, 0xbf, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0x53, 0x48, 0x2a, 0xb4, 0x2a, 0x03, 0x00, 0x00,
+	// 511 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0xd3, 0x41, 0x6f, 0x12, 0x4f,
+	0x14, 0x00, 0x70, 0x16, 0xe8, 0xfe, 0xf9, 0x8f, 0xa6, 0xae, 0x63, 0x4d, 0xc8, 0xa2, 0xcb, 0xa6,
+	0x27, 0x62, 0x02, 0x24, 0xd5, 0x18, 0xf5, 0xb6, 0x85, 0x11, 0xb7, 0x01, 0xba, 0x99, 0x85, 0xc6,
+	0x78, 0x21, 0xc3, 0xee, 0x84, 0x4e, 0xdc, 0x9d, 0xd9, 0xec, 0xcc, 0x92, 0xf6, 0x1b, 0x18, 0x4e,
+	0x7e, 0x01, 0xa2, 0x1f, 0xc7, 0x63, 0xe3, 0xc9, 0xa3, 0x81, 0x2f, 0x62, 0x0a, 0xd8, 0x92, 0xa2,
+	0xde, 0xde, 0xbc, 0xbc, 0xdf, 0xcb, 0xcb, 0xcb, 0x1b, 0x50, 0x8b, 0x69, 0x3c, 0xa6, 0xa9, 0x3c,
+	0x67, 0x49, 0x2c, 0xc2, 0x2c, 0xa2, 0xcd, 0xdb, 0xc4, 0x26, 0x6c, 0x24, 0xa9, 0x50, 0x02, 0x56,
+	0xee, 0x56, 0x36, 0x6e, 0x13, 0xe6, 0xc1, 0x44, 0x4c, 0xc4, 0xaa, 0xae, 0x79, 0x1d, 0xad, 0xc9,
+	0xe1, 0x77, 0x0d, 0xe8, 0xbd, 0x55, 0x11, 0x44, 0x40, 0x97, 0x8a, 0xa8, 0x4c, 0x96, 0xf3, 0xb6,
+	0x56, 0xdb, 0x3f, 0xaa, 0x37, 0xfe, 0xd1, 0xae, 0xd1, 0xbb, 0x09, 0xfd, 0x15, 0xc2, 0x1b, 0x0c,
+	0x4d, 0x50, 0xe2, 0x2c, 0xf8, 0xc8, 0x49, 0x4c, 0xcb, 0x05, 0x5b, 0xab, 0xfd, 0x8f, 0x6f, 0xde,
+	0xb0, 0x0a, 0xee, 0x31, 0x39, 0x9a, 0x64, 0x24, 0x0d, 0x19, 0xe1, 0xe5, 0xa2, 0xad, 0xd5, 0x4a,
+	0x18, 0x30, 0xd9, 0xd9, 0x64, 0x60, 0x19, 0xfc, 0x47, 0xc2, 0x30, 0xa5, 0x52, 0x96, 0xf5, 0x95,
+	0xfd, 0xfd, 0x7c, 0x53, 0xfc, 0xf4, 0xb5, 0x9a, 0x3b, 0x29, 0x96, 0x34, 0x23, 0x7f, 0x52, 0x2c,
+	0xed, 0x19, 0x3a, 0xbe, 0x3f, 0x26, 0x92, 0x8e, 0x48, 0x10, 0x88, 0x8c, 0x2b, 0x0c, 0x18, 0x9f,
+	0x32, 0x45, 0x14, 0x13, 0xfc, 0xd9, 0x97, 0x02, 0x30, 0xee, 0xce, 0x07, 0x5f, 0x81, 0xa7, 0x3d,
+	0xd4, 0x3b, 0x46, 0xd8, 0x7f, 0xe7, 0x7a, 0x23, 0x7f, 0xe0, 0x0c, 0x86, 0xfe, 0x68, 0xd8, 0xf7,
+	0x3d, 0xd4, 0x72, 0xdf, 0xba, 0xa8, 0x6d, 0xe4, 0xcc, 0xc7, 0xb3, 0xb9, 0xfd, 0x70, 0x0d, 0xd7,
+	0x08, 0xc5, 0x89, 0xba, 0x84, 0x1d, 0x70, 0xb8, 0x2b, 0x3d, 0xd4, 0x6f, 0xbb, 0xfd, 0xce, 0xc8,
+	0xf1, 0x3c, 0x7c, 0x7a, 0xe6, 0x74, 0x0d, 0xcd, 0xac, 0xce, 0xe6, 0x76, 0x65, 0x9b, 0x7b, 0x94,
+	0x87, 0x8c, 0x4f, 0x9c, 0x24, 0x49, 0xc5, 0x94, 0x44, 0xf0, 0x25, 0x78, 0xb2, 0xdb, 0x08, 0x75,
+	0x51, 0x6b, 0x70, 0x8a, 0x9d, 0x01, 0x32, 0xf2, 0xe6, 0xc1, 0x6c, 0x6e, 0x6f, 0x46, 0x47, 0x11,
+	0x0d, 0x94, 0x48, 0x89, 0xa2, 0xf0, 0x08, 0x98, 0xbb, 0xce, 0xed, 0x3b, 0xad, 0x81, 0x7b, 0x86,
+	0x8c, 0x82, 0x09, 0x67, 0x73, 0x7b, 0x7f, 0xad, 0x5c, 0x4e, 0x02, 0xc5, 0xa6, 0x7f, 0x31, 0x18,
+	0xb5, 0x9c, 0x6e, 0x17, 0xb5, 0x8d, 0xe2, 0xb6, 0xc1, 0x34, 0x20, 0x51, 0x44, 0xc3, 0x3f, 0x1b,
+	0xf4, 0xde, 0x1b, 0x76, 0x7d, 0xd4, 0x36, 0xf6, 0xb6, 0x0d, 0xba, 0x48, 0xb2, 0x48, 0xd2, 0x10,
+	0xbe, 0x00, 0x95, 0x5d, 0xe3, 0x0f, 0xfd, 0xeb, 0xfd, 0xa0, 0xb6, 0xa1, 0x9b, 0x8f, 0x66, 0x73,
+	0xfb, 0xc1, 0x66, 0x2b, 0x99, 0x4c, 0x28, 0x0f, 0x69, 0x78, 0xec, 0x7f, 0x5b, 0x58, 0xda, 0xd5,
+	0xc2, 0xd2, 0x7e, 0x2e, 0x2c, 0xed, 0xf3, 0xd2, 0xca, 0x5d, 0x2d, 0xad, 0xdc, 0x8f, 0xa5, 0x95,
+	0xfb, 0xf0, 0x7a, 0xc2, 0xd4, 0x79, 0x36, 0x6e, 0x04, 0x22, 0x6e, 0x72, 0x91, 0x32, 0x52, 0xe7,
+	0x54, 0x35, 0xd7, 0xf7, 0x57, 0xdf, 0x3a, 0xfc, 0x8b, 0xed, 0x5f, 0xa0, 0x2e, 0x13, 0x2a, 0xc7,
+	0xfa, 0xea, 0xa4, 0x9f, 0xff, 0x1a, 0x00, 0x9a, 0x3f, 0x7c, 0x2b, 0x31, 0x03, 0x00, 0x00,
 }
 
 func (m *Member) Marshal() (dAtA []byte, err error) {
@@ -178,6 +183,13 @@ func (m *Member) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMember(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.IsGuardian {
 		i--
 		if m.IsGuardian {
@@ -200,18 +212,6 @@ func (m *Member) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.BaseAccount != nil {
-		{
-			size, err := m.BaseAccount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMember(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
@@ -232,10 +232,6 @@ func (m *Member) Size() (n int) {
 	}
 	var l int
 	_ = l
-	if m.BaseAccount != nil {
-		l = m.BaseAccount.Size()
-		n += 1 + l + sovMember(uint64(l))
-	}
 	if m.Status != 0 {
 		n += 1 + sovMember(uint64(m.Status))
 	}
@@ -246,6 +242,10 @@ func (m *Member) Size() (n int) {
 	if m.IsGuardian {
 		n += 2
 	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMember(uint64(l))
+	}
 	return n
 }
 
@@ -284,42 +284,6 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMember
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMember
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMember
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.BaseAccount == nil {
-				m.BaseAccount = &types.BaseAccount{}
-			}
-			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
@@ -391,6 +355,38 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsGuardian = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMember
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMember
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMember
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMember(dAtA[iNdEx:])
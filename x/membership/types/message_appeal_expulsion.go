@@ -0,0 +1,54 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgAppealExpulsion = "appeal_expulsion"
+
+var _ sdk.Msg = &MsgAppealExpulsion{}
+
+func NewMsgAppealExpulsion(creator string, statement string) *MsgAppealExpulsion {
+	return &MsgAppealExpulsion{
+		Creator:   creator,
+		Statement: statement,
+	}
+}
+
+func (msg *MsgAppealExpulsion) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAppealExpulsion) Type() string {
+	return TypeMsgAppealExpulsion
+}
+
+func (msg *MsgAppealExpulsion) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAppealExpulsion) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAppealExpulsion) ValidateBasic() error {
+	// Validate creator address
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	// Must make a case for reinstatement
+	if len(msg.Statement) == 0 {
+		return errors.Wrap(ErrInvalidExpulsionAppeal, "statement cannot be empty")
+	}
+	if len(msg.Statement) > AppealStatementMaxLength {
+		return errors.Wrapf(ErrInvalidExpulsionAppeal, "statement cannot be longer than %d characters", AppealStatementMaxLength)
+	}
+	return nil
+}
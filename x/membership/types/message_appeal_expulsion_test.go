@@ -0,0 +1,56 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgAppealExpulsion_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgAppealExpulsion
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgAppealExpulsion{
+				Creator:   "invalid_address",
+				Statement: "statement",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty statement",
+			msg: MsgAppealExpulsion{
+				Creator: sample.AccAddress(),
+			},
+			err: ErrInvalidExpulsionAppeal,
+		}, {
+			name: "statement too long",
+			msg: MsgAppealExpulsion{
+				Creator:   sample.AccAddress(),
+				Statement: strings.Repeat("a", AppealStatementMaxLength+1),
+			},
+			err: ErrInvalidExpulsionAppeal,
+		}, {
+			name: "valid message",
+			msg: MsgAppealExpulsion{
+				Creator:   sample.AccAddress(),
+				Statement: "statement",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
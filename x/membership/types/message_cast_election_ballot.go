@@ -0,0 +1,66 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCastElectionBallot = "cast_election_ballot"
+
+var _ sdk.Msg = &MsgCastElectionBallot{}
+
+func NewMsgCastElectionBallot(creator string, electionID uint64, candidates []string) *MsgCastElectionBallot {
+	return &MsgCastElectionBallot{
+		Creator:    creator,
+		ElectionId: electionID,
+		Candidates: candidates,
+	}
+}
+
+func (msg *MsgCastElectionBallot) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCastElectionBallot) Type() string {
+	return TypeMsgCastElectionBallot
+}
+
+func (msg *MsgCastElectionBallot) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCastElectionBallot) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCastElectionBallot) ValidateBasic() error {
+	// Validate creator address
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.ElectionId == 0 {
+		return errors.Wrap(ErrInvalidElectionBallot, "election id cannot be zero")
+	}
+	if len(msg.Candidates) == 0 {
+		return errors.Wrap(ErrInvalidElectionBallot, "ballot must list at least one candidate")
+	}
+
+	// Every candidate must be a valid address, listed once
+	seen := make(map[string]bool, len(msg.Candidates))
+	for _, candidate := range msg.Candidates {
+		if _, err := sdk.AccAddressFromBech32(candidate); err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid candidate address (%s)", err)
+		}
+		if seen[candidate] {
+			return errors.Wrapf(ErrInvalidElectionBallot, "duplicate candidate: %s", candidate)
+		}
+		seen[candidate] = true
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCastElectionBallot_ValidateBasic(t *testing.T) {
+	candidate := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgCastElectionBallot
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCastElectionBallot{
+				Creator:    "invalid_address",
+				ElectionId: 1,
+				Candidates: []string{candidate},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing election id",
+			msg: MsgCastElectionBallot{
+				Creator:    sample.AccAddress(),
+				Candidates: []string{candidate},
+			},
+			err: ErrInvalidElectionBallot,
+		}, {
+			name: "empty ballot",
+			msg: MsgCastElectionBallot{
+				Creator:    sample.AccAddress(),
+				ElectionId: 1,
+			},
+			err: ErrInvalidElectionBallot,
+		}, {
+			name: "invalid candidate address",
+			msg: MsgCastElectionBallot{
+				Creator:    sample.AccAddress(),
+				ElectionId: 1,
+				Candidates: []string{"invalid_address"},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "duplicate candidate",
+			msg: MsgCastElectionBallot{
+				Creator:    sample.AccAddress(),
+				ElectionId: 1,
+				Candidates: []string{candidate, candidate},
+			},
+			err: ErrInvalidElectionBallot,
+		}, {
+			name: "valid message",
+			msg: MsgCastElectionBallot{
+				Creator:    sample.AccAddress(),
+				ElectionId: 1,
+				Candidates: []string{candidate, sample.AccAddress()},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgClaimMemberRewards = "claim_member_rewards"
+
+var _ sdk.Msg = &MsgClaimMemberRewards{}
+
+func NewMsgClaimMemberRewards(creator string) *MsgClaimMemberRewards {
+	return &MsgClaimMemberRewards{
+		Creator: creator,
+	}
+}
+
+func (msg *MsgClaimMemberRewards) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgClaimMemberRewards) Type() string {
+	return TypeMsgClaimMemberRewards
+}
+
+func (msg *MsgClaimMemberRewards) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgClaimMemberRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgClaimMemberRewards) ValidateBasic() error {
+	// Validate creator address
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
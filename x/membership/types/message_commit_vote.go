@@ -0,0 +1,54 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCommitVote = "commit_vote"
+
+var _ sdk.Msg = &MsgCommitVote{}
+
+func NewMsgCommitVote(creator string, proposalID uint64, hash string) *MsgCommitVote {
+	return &MsgCommitVote{
+		Creator:    creator,
+		ProposalId: proposalID,
+		Hash:       hash,
+	}
+}
+
+func (msg *MsgCommitVote) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCommitVote) Type() string {
+	return TypeMsgCommitVote
+}
+
+func (msg *MsgCommitVote) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCommitVote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCommitVote) ValidateBasic() error {
+	// Validate creator address
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.ProposalId == 0 {
+		return errors.Wrap(ErrInvalidVoteCommit, "proposal id cannot be zero")
+	}
+	if err := ValidateVoteCommitHash(msg.Hash); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes_v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCommitVote_ValidateBasic(t *testing.T) {
+	voter := sample.AccAddress()
+	hash := SecretVoteHash(1, voter, govtypes_v1.NewNonSplitVoteOption(govtypes_v1.OptionYes), "salt")
+	tests := []struct {
+		name string
+		msg  MsgCommitVote
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCommitVote{
+				Creator:    "invalid_address",
+				ProposalId: 1,
+				Hash:       hash,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing proposal id",
+			msg: MsgCommitVote{
+				Creator: voter,
+				Hash:    hash,
+			},
+			err: ErrInvalidVoteCommit,
+		}, {
+			name: "invalid hash",
+			msg: MsgCommitVote{
+				Creator:    voter,
+				ProposalId: 1,
+				Hash:       "not a hash",
+			},
+			err: ErrInvalidVoteCommit,
+		}, {
+			name: "short hash",
+			msg: MsgCommitVote{
+				Creator:    voter,
+				ProposalId: 1,
+				Hash:       hash[:32],
+			},
+			err: ErrInvalidVoteCommit,
+		}, {
+			name: "valid message",
+			msg: MsgCommitVote{
+				Creator:    voter,
+				ProposalId: 1,
+				Hash:       hash,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
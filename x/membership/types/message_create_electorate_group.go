@@ -0,0 +1,50 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCreateElectorateGroup = "create_electorate_group"
+
+var _ sdk.Msg = &MsgCreateElectorateGroup{}
+
+func NewMsgCreateElectorateGroup(authority string, groupMetadata string, groupPolicyMetadata string, decisionPolicy ElectorateDecisionPolicy) *MsgCreateElectorateGroup {
+	return &MsgCreateElectorateGroup{
+		Authority:           authority,
+		GroupMetadata:       groupMetadata,
+		GroupPolicyMetadata: groupPolicyMetadata,
+		DecisionPolicy:      decisionPolicy,
+	}
+}
+
+func (msg *MsgCreateElectorateGroup) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateElectorateGroup) Type() string {
+	return TypeMsgCreateElectorateGroup
+}
+
+func (msg *MsgCreateElectorateGroup) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgCreateElectorateGroup) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateElectorateGroup) ValidateBasic() error {
+	// Authority address must be valid
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	// Decision policy must be valid
+	return msg.DecisionPolicy.ValidateBasic()
+}
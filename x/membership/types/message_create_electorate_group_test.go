@@ -0,0 +1,67 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCreateElectorateGroup_ValidateBasic(t *testing.T) {
+	policy := *NewElectorateDecisionPolicy(sdk.NewDecWithPrec(334, 3), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(334, 3), time.Hour, 0)
+	noVotingPeriod := policy
+	noVotingPeriod.Windows = nil
+
+	tests := []struct {
+		name string
+		msg  MsgCreateElectorateGroup
+		err  error
+	}{
+		{
+			name: "invalid authority address",
+			msg: MsgCreateElectorateGroup{
+				Authority:      "invalid_address",
+				DecisionPolicy: policy,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "threshold above 1",
+			msg: MsgCreateElectorateGroup{
+				Authority: sample.AccAddress(),
+				DecisionPolicy: ElectorateDecisionPolicy{
+					Quorum:        policy.Quorum,
+					Threshold:     "1.5",
+					VetoThreshold: policy.VetoThreshold,
+					Windows:       policy.Windows,
+				},
+			},
+			err: ErrInvalidElectorateGroup,
+		}, {
+			name: "no voting period",
+			msg: MsgCreateElectorateGroup{
+				Authority:      sample.AccAddress(),
+				DecisionPolicy: noVotingPeriod,
+			},
+			err: ErrInvalidElectorateGroup,
+		}, {
+			name: "valid message",
+			msg: MsgCreateElectorateGroup{
+				Authority:      sample.AccAddress(),
+				DecisionPolicy: policy,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCreateInvitation = "create_invitation"
+
+var _ sdk.Msg = &MsgCreateInvitation{}
+
+func NewMsgCreateInvitation(creator string, secretHash string, expiresAt time.Time, maxUses uint64) *MsgCreateInvitation {
+	return &MsgCreateInvitation{
+		Creator:    creator,
+		SecretHash: secretHash,
+		ExpiresAt:  expiresAt,
+		MaxUses:    maxUses,
+	}
+}
+
+func (msg *MsgCreateInvitation) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateInvitation) Type() string {
+	return TypeMsgCreateInvitation
+}
+
+func (msg *MsgCreateInvitation) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCreateInvitation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateInvitation) ValidateBasic() error {
+	// Validate creator address
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	// Must be a valid sha256 hash
+	if _, err := ParseInvitationHash(msg.SecretHash); err != nil {
+		return err
+	}
+	// Must admit at least one member
+	if msg.MaxUses == 0 {
+		return errors.Wrap(ErrInvalidInvitation, "max uses must be greater than zero")
+	}
+	// Must have an expiry time
+	if msg.ExpiresAt.IsZero() {
+		return errors.Wrap(ErrInvalidInvitation, "expiry time must be set")
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCreateInvitation_ValidateBasic(t *testing.T) {
+	expiresAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	hash := InvitationHash("secret", sdk.MustAccAddressFromBech32(sample.AccAddress()))
+
+	tests := []struct {
+		name string
+		msg  MsgCreateInvitation
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCreateInvitation{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid hash",
+			msg: MsgCreateInvitation{
+				Creator:    sample.AccAddress(),
+				SecretHash: "not-a-hash",
+				ExpiresAt:  expiresAt,
+				MaxUses:    1,
+			},
+			err: ErrInvalidInvitation,
+		}, {
+			name: "short hash",
+			msg: MsgCreateInvitation{
+				Creator:    sample.AccAddress(),
+				SecretHash: "abcd",
+				ExpiresAt:  expiresAt,
+				MaxUses:    1,
+			},
+			err: ErrInvalidInvitation,
+		}, {
+			name: "zero max uses",
+			msg: MsgCreateInvitation{
+				Creator:    sample.AccAddress(),
+				SecretHash: hash,
+				ExpiresAt:  expiresAt,
+			},
+			err: ErrInvalidInvitation,
+		}, {
+			name: "missing expiry",
+			msg: MsgCreateInvitation{
+				Creator:    sample.AccAddress(),
+				SecretHash: hash,
+				MaxUses:    1,
+			},
+			err: ErrInvalidInvitation,
+		}, {
+			name: "valid message",
+			msg: MsgCreateInvitation{
+				Creator:    sample.AccAddress(),
+				SecretHash: hash,
+				ExpiresAt:  expiresAt,
+				MaxUses:    5,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgDeclareCandidacy = "declare_candidacy"
+
+var _ sdk.Msg = &MsgDeclareCandidacy{}
+
+func NewMsgDeclareCandidacy(creator string, statement string) *MsgDeclareCandidacy {
+	return &MsgDeclareCandidacy{
+		Creator:   creator,
+		Statement: statement,
+	}
+}
+
+func (msg *MsgDeclareCandidacy) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDeclareCandidacy) Type() string {
+	return TypeMsgDeclareCandidacy
+}
+
+func (msg *MsgDeclareCandidacy) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgDeclareCandidacy) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDeclareCandidacy) ValidateBasic() error {
+	// Validate creator address
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.Statement) > CandidacyStatementMaxLength {
+		return errors.Wrapf(ErrInvalidCandidacy, "statement cannot be longer than %d characters", CandidacyStatementMaxLength)
+	}
+	return nil
+}
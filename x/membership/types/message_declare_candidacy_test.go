@@ -0,0 +1,49 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgDeclareCandidacy_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgDeclareCandidacy
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgDeclareCandidacy{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "statement too long",
+			msg: MsgDeclareCandidacy{
+				Creator:   sample.AccAddress(),
+				Statement: strings.Repeat("a", CandidacyStatementMaxLength+1),
+			},
+			err: ErrInvalidCandidacy,
+		}, {
+			name: "valid message",
+			msg: MsgDeclareCandidacy{
+				Creator:   sample.AccAddress(),
+				Statement: "statement",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgDelegateVote = "delegate_vote"
+
+var _ sdk.Msg = &MsgDelegateVote{}
+
+func NewMsgDelegateVote(creator string, delegate string, msgTypeURLs []string) *MsgDelegateVote {
+	return &MsgDelegateVote{
+		Creator:     creator,
+		Delegate:    delegate,
+		MsgTypeUrls: msgTypeURLs,
+	}
+}
+
+func (msg *MsgDelegateVote) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDelegateVote) Type() string {
+	return TypeMsgDelegateVote
+}
+
+func (msg *MsgDelegateVote) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgDelegateVote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDelegateVote) ValidateBasic() error {
+	// Validate creator address
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	// Validate delegate address
+	if _, err := sdk.AccAddressFromBech32(msg.Delegate); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegate address (%s)", err)
+	}
+	if msg.Creator == msg.Delegate {
+		return errors.Wrap(ErrInvalidVoteDelegation, "cannot delegate a vote to oneself")
+	}
+	if err := validateMsgTypeURLs(msg.MsgTypeUrls); err != nil {
+		return errors.Wrap(ErrInvalidVoteDelegation, err.Error())
+	}
+	return nil
+}
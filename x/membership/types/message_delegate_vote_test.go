@@ -0,0 +1,80 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgDelegateVote_ValidateBasic(t *testing.T) {
+	creator := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgDelegateVote
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgDelegateVote{
+				Creator:  "invalid_address",
+				Delegate: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid delegate address",
+			msg: MsgDelegateVote{
+				Creator:  sample.AccAddress(),
+				Delegate: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "delegate to oneself",
+			msg: MsgDelegateVote{
+				Creator:  creator,
+				Delegate: creator,
+			},
+			err: ErrInvalidVoteDelegation,
+		}, {
+			name: "invalid message type url",
+			msg: MsgDelegateVote{
+				Creator:     sample.AccAddress(),
+				Delegate:    sample.AccAddress(),
+				MsgTypeUrls: []string{"cosmos.bank.v1beta1.MsgSend"},
+			},
+			err: ErrInvalidVoteDelegation,
+		}, {
+			name: "duplicate message type url",
+			msg: MsgDelegateVote{
+				Creator:     sample.AccAddress(),
+				Delegate:    sample.AccAddress(),
+				MsgTypeUrls: []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgSend"},
+			},
+			err: ErrInvalidVoteDelegation,
+		}, {
+			name: "valid message",
+			msg: MsgDelegateVote{
+				Creator:  sample.AccAddress(),
+				Delegate: sample.AccAddress(),
+			},
+		}, {
+			name: "valid scoped message",
+			msg: MsgDelegateVote{
+				Creator:     sample.AccAddress(),
+				Delegate:    sample.AccAddress(),
+				MsgTypeUrls: []string{"/cosmos.bank.v1beta1.MsgSend"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
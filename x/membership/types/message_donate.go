@@ -0,0 +1,51 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgDonate = "donate"
+
+var _ sdk.Msg = &MsgDonate{}
+
+func NewMsgDonate(creator string, amount sdk.Coins) *MsgDonate {
+	return &MsgDonate{
+		Creator: creator,
+		Amount:  amount,
+	}
+}
+
+func (msg *MsgDonate) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDonate) Type() string {
+	return TypeMsgDonate
+}
+
+func (msg *MsgDonate) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgDonate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDonate) ValidateBasic() error {
+	// Validate creator address
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	// Must donate something
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid donation amount: %s", msg.Amount)
+	}
+	return nil
+}
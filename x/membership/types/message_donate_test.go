@@ -0,0 +1,56 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgDonate_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgDonate
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgDonate{
+				Creator: "invalid_address",
+				Amount:  sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "no amount",
+			msg: MsgDonate{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "invalid amount",
+			msg: MsgDonate{
+				Creator: sample.AccAddress(),
+				Amount:  sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}},
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "valid message",
+			msg: MsgDonate{
+				Creator: sample.AccAddress(),
+				Amount:  sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
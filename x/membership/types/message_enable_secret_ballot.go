@@ -0,0 +1,47 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgEnableSecretBallot = "enable_secret_ballot"
+
+var _ sdk.Msg = &MsgEnableSecretBallot{}
+
+func NewMsgEnableSecretBallot(creator string, proposalID uint64) *MsgEnableSecretBallot {
+	return &MsgEnableSecretBallot{
+		Creator:    creator,
+		ProposalId: proposalID,
+	}
+}
+
+func (msg *MsgEnableSecretBallot) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgEnableSecretBallot) Type() string {
+	return TypeMsgEnableSecretBallot
+}
+
+func (msg *MsgEnableSecretBallot) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgEnableSecretBallot) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgEnableSecretBallot) ValidateBasic() error {
+	// Validate creator address
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
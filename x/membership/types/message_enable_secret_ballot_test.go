@@ -0,0 +1,47 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgEnableSecretBallot_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgEnableSecretBallot
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgEnableSecretBallot{
+				Creator:    "invalid_address",
+				ProposalId: 1,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "latest proposal",
+			msg: MsgEnableSecretBallot{
+				Creator: sample.AccAddress(),
+			},
+		}, {
+			name: "valid message",
+			msg: MsgEnableSecretBallot{
+				Creator:    sample.AccAddress(),
+				ProposalId: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
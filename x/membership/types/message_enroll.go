@@ -10,10 +10,11 @@ const TypeMsgEnroll = "enroll"
 
 var _ sdk.Msg = &MsgEnroll{}
 
-func NewMsgEnroll(creator string, nickname string) *MsgEnroll {
+func NewMsgEnroll(creator string, nickname string, invitationSecret string) *MsgEnroll {
 	return &MsgEnroll{
-		Creator:  creator,
-		Nickname: nickname,
+		Creator:          creator,
+		Nickname:         nickname,
+		InvitationSecret: invitationSecret,
 	}
 }
 
@@ -48,5 +49,9 @@ func (msg *MsgEnroll) ValidateBasic() error {
 	if len(msg.Nickname) > NicknameMaxLength {
 		return errors.Wrapf(ErrInvalidNickname, "nickname cannot be longer than %d characters", NicknameMaxLength)
 	}
+	// Invitation secret cannot be unreasonably long
+	if len(msg.InvitationSecret) > InvitationSecretMaxLength {
+		return errors.Wrapf(ErrInvalidInvitation, "invitation secret cannot be longer than %d characters", InvitationSecretMaxLength)
+	}
 	return nil
 }
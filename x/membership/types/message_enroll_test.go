@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -20,6 +21,13 @@ func TestMsgEnroll_ValidateBasic(t *testing.T) {
 				Creator: "invalid_address",
 			},
 			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invitation secret too long",
+			msg: MsgEnroll{
+				Creator:          sample.AccAddress(),
+				InvitationSecret: strings.Repeat("a", InvitationSecretMaxLength+1),
+			},
+			err: ErrInvalidInvitation,
 		}, {
 			name: "valid address",
 			msg: MsgEnroll{
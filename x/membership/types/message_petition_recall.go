@@ -0,0 +1,62 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgPetitionRecall = "petition_recall"
+
+var _ sdk.Msg = &MsgPetitionRecall{}
+
+func NewMsgPetitionRecall(creator string, target string, reason string) *MsgPetitionRecall {
+	return &MsgPetitionRecall{
+		Creator: creator,
+		Target:  target,
+		Reason:  reason,
+	}
+}
+
+func (msg *MsgPetitionRecall) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgPetitionRecall) Type() string {
+	return TypeMsgPetitionRecall
+}
+
+func (msg *MsgPetitionRecall) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgPetitionRecall) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgPetitionRecall) ValidateBasic() error {
+	// Creator and target addresses must be valid
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Target); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid target address (%s)", err)
+	}
+	// Members cannot petition to recall themselves
+	if msg.Creator == msg.Target {
+		return errors.Wrap(ErrInvalidRecallPetition, "cannot petition to recall yourself")
+	}
+	// Must explain the recall
+	if len(msg.Reason) == 0 {
+		return errors.Wrap(ErrInvalidRecallPetition, "reason cannot be empty")
+	}
+	if len(msg.Reason) > RecallReasonMaxLength {
+		return errors.Wrapf(ErrInvalidRecallPetition, "reason cannot be longer than %d characters", RecallReasonMaxLength)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgPetitionRecall_ValidateBasic(t *testing.T) {
+	creator := sample.AccAddress()
+	target := sample.AccAddress()
+
+	tests := []struct {
+		name string
+		msg  MsgPetitionRecall
+		err  error
+	}{
+		{
+			name: "invalid creator address",
+			msg: MsgPetitionRecall{
+				Creator: "invalid_address",
+				Target:  target,
+				Reason:  "reason",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid target address",
+			msg: MsgPetitionRecall{
+				Creator: creator,
+				Target:  "invalid_address",
+				Reason:  "reason",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "self recall",
+			msg: MsgPetitionRecall{
+				Creator: creator,
+				Target:  creator,
+				Reason:  "reason",
+			},
+			err: ErrInvalidRecallPetition,
+		}, {
+			name: "empty reason",
+			msg: MsgPetitionRecall{
+				Creator: creator,
+				Target:  target,
+			},
+			err: ErrInvalidRecallPetition,
+		}, {
+			name: "reason too long",
+			msg: MsgPetitionRecall{
+				Creator: creator,
+				Target:  target,
+				Reason:  strings.Repeat("a", RecallReasonMaxLength+1),
+			},
+			err: ErrInvalidRecallPetition,
+		}, {
+			name: "valid message",
+			msg: MsgPetitionRecall{
+				Creator: creator,
+				Target:  target,
+				Reason:  "reason",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
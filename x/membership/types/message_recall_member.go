@@ -0,0 +1,50 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRecallMember = "recall_member"
+
+var _ sdk.Msg = &MsgRecallMember{}
+
+func NewMsgRecallMember(authority string, member string) *MsgRecallMember {
+	return &MsgRecallMember{
+		Authority: authority,
+		Member:    member,
+	}
+}
+
+func (msg *MsgRecallMember) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRecallMember) Type() string {
+	return TypeMsgRecallMember
+}
+
+func (msg *MsgRecallMember) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRecallMember) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRecallMember) ValidateBasic() error {
+	// Authority and member addresses must be valid
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Member); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid member address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgReinstateMember = "reinstate_member"
+
+var _ sdk.Msg = &MsgReinstateMember{}
+
+func NewMsgReinstateMember(authority string, member string) *MsgReinstateMember {
+	return &MsgReinstateMember{
+		Authority: authority,
+		Member:    member,
+	}
+}
+
+func (msg *MsgReinstateMember) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgReinstateMember) Type() string {
+	return TypeMsgReinstateMember
+}
+
+func (msg *MsgReinstateMember) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgReinstateMember) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgReinstateMember) ValidateBasic() error {
+	// Authority and member addresses must be valid
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Member); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid member address (%s)", err)
+	}
+	return nil
+}
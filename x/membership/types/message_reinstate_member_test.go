@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgReinstateMember_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgReinstateMember
+		err  error
+	}{
+		{
+			name: "invalid authority address",
+			msg: MsgReinstateMember{
+				Authority: "invalid_address",
+				Member:    sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid member address",
+			msg: MsgReinstateMember{
+				Authority: sample.AccAddress(),
+				Member:    "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid message",
+			msg: MsgReinstateMember{
+				Authority: sample.AccAddress(),
+				Member:    sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
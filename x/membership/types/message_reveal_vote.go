@@ -0,0 +1,64 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes_v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+const TypeMsgRevealVote = "reveal_vote"
+
+var _ sdk.Msg = &MsgRevealVote{}
+
+func NewMsgRevealVote(creator string, proposalID uint64, options govtypes_v1.WeightedVoteOptions, salt string) *MsgRevealVote {
+	return &MsgRevealVote{
+		Creator:    creator,
+		ProposalId: proposalID,
+		Options:    options,
+		Salt:       salt,
+	}
+}
+
+func (msg *MsgRevealVote) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRevealVote) Type() string {
+	return TypeMsgRevealVote
+}
+
+func (msg *MsgRevealVote) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRevealVote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRevealVote) ValidateBasic() error {
+	// Validate creator address
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.ProposalId == 0 {
+		return errors.Wrap(ErrInvalidVoteCommit, "proposal id cannot be zero")
+	}
+	if len(msg.Options) == 0 {
+		return errors.Wrap(ErrInvalidVoteCommit, "vote must have at least one option")
+	}
+	for _, option := range msg.Options {
+		if option == nil || !govtypes_v1.ValidWeightedVoteOption(*option) {
+			return errors.Wrapf(ErrInvalidVoteCommit, "invalid vote option: %s", option)
+		}
+	}
+	if len(msg.Salt) > SecretVoteSaltMaxLength {
+		return errors.Wrapf(ErrInvalidVoteCommit, "salt cannot be longer than %d characters", SecretVoteSaltMaxLength)
+	}
+	return nil
+}
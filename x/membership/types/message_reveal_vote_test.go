@@ -0,0 +1,80 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes_v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRevealVote_ValidateBasic(t *testing.T) {
+	yes := govtypes_v1.NewNonSplitVoteOption(govtypes_v1.OptionYes)
+	tests := []struct {
+		name string
+		msg  MsgRevealVote
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRevealVote{
+				Creator:    "invalid_address",
+				ProposalId: 1,
+				Options:    yes,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing proposal id",
+			msg: MsgRevealVote{
+				Creator: sample.AccAddress(),
+				Options: yes,
+			},
+			err: ErrInvalidVoteCommit,
+		}, {
+			name: "missing options",
+			msg: MsgRevealVote{
+				Creator:    sample.AccAddress(),
+				ProposalId: 1,
+			},
+			err: ErrInvalidVoteCommit,
+		}, {
+			name: "invalid option",
+			msg: MsgRevealVote{
+				Creator:    sample.AccAddress(),
+				ProposalId: 1,
+				Options:    govtypes_v1.WeightedVoteOptions{govtypes_v1.NewWeightedVoteOption(govtypes_v1.OptionYes, sdk.NewDec(2))},
+			},
+			err: ErrInvalidVoteCommit,
+		}, {
+			name: "salt too long",
+			msg: MsgRevealVote{
+				Creator:    sample.AccAddress(),
+				ProposalId: 1,
+				Options:    yes,
+				Salt:       strings.Repeat("a", SecretVoteSaltMaxLength+1),
+			},
+			err: ErrInvalidVoteCommit,
+		}, {
+			name: "valid message",
+			msg: MsgRevealVote{
+				Creator:    sample.AccAddress(),
+				ProposalId: 1,
+				Options:    yes,
+				Salt:       "salt",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRevokeInvitation = "revoke_invitation"
+
+var _ sdk.Msg = &MsgRevokeInvitation{}
+
+func NewMsgRevokeInvitation(creator string, secretHash string) *MsgRevokeInvitation {
+	return &MsgRevokeInvitation{
+		Creator:    creator,
+		SecretHash: secretHash,
+	}
+}
+
+func (msg *MsgRevokeInvitation) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRevokeInvitation) Type() string {
+	return TypeMsgRevokeInvitation
+}
+
+func (msg *MsgRevokeInvitation) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRevokeInvitation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRevokeInvitation) ValidateBasic() error {
+	// Validate creator address
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	// Must be a valid sha256 hash
+	if _, err := ParseInvitationHash(msg.SecretHash); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRevokeInvitation_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRevokeInvitation
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRevokeInvitation{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid hash",
+			msg: MsgRevokeInvitation{
+				Creator:    sample.AccAddress(),
+				SecretHash: "zz",
+			},
+			err: ErrInvalidInvitation,
+		}, {
+			name: "valid message",
+			msg: MsgRevokeInvitation{
+				Creator:    sample.AccAddress(),
+				SecretHash: InvitationHash("secret", sdk.MustAccAddressFromBech32(sample.AccAddress())),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
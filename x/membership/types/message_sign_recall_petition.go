@@ -0,0 +1,54 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSignRecallPetition = "sign_recall_petition"
+
+var _ sdk.Msg = &MsgSignRecallPetition{}
+
+func NewMsgSignRecallPetition(creator string, target string) *MsgSignRecallPetition {
+	return &MsgSignRecallPetition{
+		Creator: creator,
+		Target:  target,
+	}
+}
+
+func (msg *MsgSignRecallPetition) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSignRecallPetition) Type() string {
+	return TypeMsgSignRecallPetition
+}
+
+func (msg *MsgSignRecallPetition) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSignRecallPetition) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSignRecallPetition) ValidateBasic() error {
+	// Creator and target addresses must be valid
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Target); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid target address (%s)", err)
+	}
+	// Members cannot sign a petition to recall themselves
+	if msg.Creator == msg.Target {
+		return errors.Wrap(ErrInvalidRecallPetition, "cannot sign a petition to recall yourself")
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSignRecallPetition_ValidateBasic(t *testing.T) {
+	creator := sample.AccAddress()
+
+	tests := []struct {
+		name string
+		msg  MsgSignRecallPetition
+		err  error
+	}{
+		{
+			name: "invalid creator address",
+			msg: MsgSignRecallPetition{
+				Creator: "invalid_address",
+				Target:  sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid target address",
+			msg: MsgSignRecallPetition{
+				Creator: creator,
+				Target:  "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "self recall",
+			msg: MsgSignRecallPetition{
+				Creator: creator,
+				Target:  creator,
+			},
+			err: ErrInvalidRecallPetition,
+		}, {
+			name: "valid message",
+			msg: MsgSignRecallPetition{
+				Creator: creator,
+				Target:  sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSuspendMember = "suspend_member"
+
+// SuspensionReasonMaxLength is the maximum number of characters allowed for
+// the reason given for a suspension
+const SuspensionReasonMaxLength = 255
+
+var _ sdk.Msg = &MsgSuspendMember{}
+
+func NewMsgSuspendMember(creator string, member string, reason string, duration time.Duration) *MsgSuspendMember {
+	return &MsgSuspendMember{
+		Creator:  creator,
+		Member:   member,
+		Reason:   reason,
+		Duration: duration,
+	}
+}
+
+func (msg *MsgSuspendMember) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSuspendMember) Type() string {
+	return TypeMsgSuspendMember
+}
+
+func (msg *MsgSuspendMember) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSuspendMember) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSuspendMember) ValidateBasic() error {
+	// Creator and member addresses must be valid
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Member); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid member address (%s)", err)
+	}
+	// Guardians cannot suspend themselves
+	if msg.Creator == msg.Member {
+		return errors.Wrap(ErrInvalidSuspension, "cannot suspend yourself")
+	}
+	// Must explain the suspension
+	if len(msg.Reason) == 0 {
+		return errors.Wrap(ErrInvalidSuspension, "reason cannot be empty")
+	}
+	if len(msg.Reason) > SuspensionReasonMaxLength {
+		return errors.Wrapf(ErrInvalidSuspension, "reason cannot be longer than %d characters", SuspensionReasonMaxLength)
+	}
+	// Must last for some time
+	if msg.Duration <= 0 {
+		return errors.Wrap(ErrInvalidSuspension, "duration must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSuspendMember_ValidateBasic(t *testing.T) {
+	creator := sample.AccAddress()
+	member := sample.AccAddress()
+
+	tests := []struct {
+		name string
+		msg  MsgSuspendMember
+		err  error
+	}{
+		{
+			name: "invalid creator address",
+			msg: MsgSuspendMember{
+				Creator:  "invalid_address",
+				Member:   member,
+				Reason:   "reason",
+				Duration: time.Hour,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid member address",
+			msg: MsgSuspendMember{
+				Creator:  creator,
+				Member:   "invalid_address",
+				Reason:   "reason",
+				Duration: time.Hour,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "self suspension",
+			msg: MsgSuspendMember{
+				Creator:  creator,
+				Member:   creator,
+				Reason:   "reason",
+				Duration: time.Hour,
+			},
+			err: ErrInvalidSuspension,
+		}, {
+			name: "empty reason",
+			msg: MsgSuspendMember{
+				Creator:  creator,
+				Member:   member,
+				Duration: time.Hour,
+			},
+			err: ErrInvalidSuspension,
+		}, {
+			name: "reason too long",
+			msg: MsgSuspendMember{
+				Creator:  creator,
+				Member:   member,
+				Reason:   strings.Repeat("a", SuspensionReasonMaxLength+1),
+				Duration: time.Hour,
+			},
+			err: ErrInvalidSuspension,
+		}, {
+			name: "zero duration",
+			msg: MsgSuspendMember{
+				Creator: creator,
+				Member:  member,
+				Reason:  "reason",
+			},
+			err: ErrInvalidSuspension,
+		}, {
+			name: "valid message",
+			msg: MsgSuspendMember{
+				Creator:  creator,
+				Member:   member,
+				Reason:   "reason",
+				Duration: time.Hour,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgTreasurySpend = "treasury_spend"
+
+var _ sdk.Msg = &MsgTreasurySpend{}
+
+func NewMsgTreasurySpend(authority string, recipient string, amount sdk.Coins, streamDuration time.Duration) *MsgTreasurySpend {
+	return &MsgTreasurySpend{
+		Authority:      authority,
+		Recipient:      recipient,
+		Amount:         amount,
+		StreamDuration: streamDuration,
+	}
+}
+
+func (msg *MsgTreasurySpend) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgTreasurySpend) Type() string {
+	return TypeMsgTreasurySpend
+}
+
+func (msg *MsgTreasurySpend) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgTreasurySpend) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgTreasurySpend) ValidateBasic() error {
+	// Authority address must be valid
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	// Recipient address must be valid
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+	}
+	// Must spend something
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid spend amount: %s", msg.Amount)
+	}
+	// Streams cannot end before they start
+	if msg.StreamDuration < 0 {
+		return errors.Wrapf(ErrInvalidTreasurySpend, "stream duration cannot be negative: %s", msg.StreamDuration)
+	}
+	return nil
+}
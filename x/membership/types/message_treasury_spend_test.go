@@ -0,0 +1,80 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgTreasurySpend_ValidateBasic(t *testing.T) {
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	tests := []struct {
+		name string
+		msg  MsgTreasurySpend
+		err  error
+	}{
+		{
+			name: "invalid authority address",
+			msg: MsgTreasurySpend{
+				Authority: "invalid_address",
+				Recipient: sample.AccAddress(),
+				Amount:    amount,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid recipient address",
+			msg: MsgTreasurySpend{
+				Authority: sample.AccAddress(),
+				Recipient: "invalid_address",
+				Amount:    amount,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "no amount",
+			msg: MsgTreasurySpend{
+				Authority: sample.AccAddress(),
+				Recipient: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "negative stream duration",
+			msg: MsgTreasurySpend{
+				Authority:      sample.AccAddress(),
+				Recipient:      sample.AccAddress(),
+				Amount:         amount,
+				StreamDuration: -time.Hour,
+			},
+			err: ErrInvalidTreasurySpend,
+		}, {
+			name: "valid message",
+			msg: MsgTreasurySpend{
+				Authority: sample.AccAddress(),
+				Recipient: sample.AccAddress(),
+				Amount:    amount,
+			},
+		}, {
+			name: "valid streamed message",
+			msg: MsgTreasurySpend{
+				Authority:      sample.AccAddress(),
+				Recipient:      sample.AccAddress(),
+				Amount:         amount,
+				StreamDuration: time.Hour,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUndelegateVote = "undelegate_vote"
+
+var _ sdk.Msg = &MsgUndelegateVote{}
+
+func NewMsgUndelegateVote(creator string) *MsgUndelegateVote {
+	return &MsgUndelegateVote{
+		Creator: creator,
+	}
+}
+
+func (msg *MsgUndelegateVote) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUndelegateVote) Type() string {
+	return TypeMsgUndelegateVote
+}
+
+func (msg *MsgUndelegateVote) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUndelegateVote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUndelegateVote) ValidateBasic() error {
+	// Validate creator address
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
@@ -1,34 +1,394 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyRecallThreshold = []byte("RecallThreshold")
+	// DefaultRecallThreshold requires a quarter of the electorate to sign a recall petition
+	DefaultRecallThreshold = sdk.NewDecWithPrec(25, 2)
+
+	KeyAppealPeriod = []byte("AppealPeriod")
+	// DefaultAppealPeriod gives expelled members two weeks to appeal
+	DefaultAppealPeriod = 14 * 24 * time.Hour
+
+	KeyElectionPeriod = []byte("ElectionPeriod")
+	// DefaultElectionPeriod disables guardian elections
+	DefaultElectionPeriod uint64 = 0
+
+	KeyElectionDuration = []byte("ElectionDuration")
+	// DefaultElectionDuration keeps elections open for roughly a week of 6 second blocks
+	DefaultElectionDuration uint64 = 100800
+
+	KeyGuardianSeats = []byte("GuardianSeats")
+	// DefaultGuardianSeats elects five guardians
+	DefaultGuardianSeats uint64 = 5
+
+	KeyElectionMethod = []byte("ElectionMethod")
+	// DefaultElectionMethod elects the candidates with the most approvals
+	DefaultElectionMethod = ElectionMethod_ElectionMethodApproval
+
+	KeyGuardianTermLength = []byte("GuardianTermLength")
+	// DefaultGuardianTermLength disables guardian term limits
+	DefaultGuardianTermLength time.Duration = 0
+
+	KeyMaxConsecutiveTerms = []byte("MaxConsecutiveTerms")
+	// DefaultMaxConsecutiveTerms allows guardians to serve any number of terms
+	DefaultMaxConsecutiveTerms uint64 = 0
+
+	KeyBicameralMsgTypes = []byte("BicameralMsgTypes")
+	// DefaultBicameralMsgTypes tallies every proposal across both chambers combined
+	DefaultBicameralMsgTypes []string
+
+	KeyTallyRules = []byte("TallyRules")
+	// DefaultTallyRules tallies every proposal by the gov params
+	DefaultTallyRules []TallyRule
+
+	KeyAllowSplitVotes = []byte("AllowSplitVotes")
+	// DefaultAllowSplitVotes only counts votes for a single option
+	DefaultAllowSplitVotes = false
+
+	KeyMaxDelegationDepth = []byte("MaxDelegationDepth")
+	// DefaultMaxDelegationDepth follows a vote delegation through at most three members
+	DefaultMaxDelegationDepth uint64 = 3
+
+	KeyRevealPeriod = []byte("RevealPeriod")
+	// DefaultRevealPeriod gives members a day to reveal their secret votes
+	DefaultRevealPeriod = 24 * time.Hour
+
+	KeyCountUnrevealedVotes = []byte("CountUnrevealedVotes")
+	// DefaultCountUnrevealedVotes counts unrevealed secret votes towards quorum
+	DefaultCountUnrevealedVotes = true
+
+	KeyRestrictGovMessages = []byte("RestrictGovMessages")
+	// DefaultRestrictGovMessages accepts proposals and votes from anyone, leaving
+	// the tally to discard those from outside the electorate
+	DefaultRestrictGovMessages = false
+
+	KeyFeeWaiverMsgTypes = []byte("FeeWaiverMsgTypes")
+	// DefaultFeeWaiverMsgTypes waives the fees on votes, and on the membership
+	// messages sent by ordinary electorate members
+	DefaultFeeWaiverMsgTypes = []string{
+		"/cosmos.gov.v1.MsgVote",
+		"/cosmos.gov.v1.MsgVoteWeighted",
+		"/cosmos.gov.v1beta1.MsgVote",
+		"/cosmos.gov.v1beta1.MsgVoteWeighted",
+		"/membershipmodule.membership.MsgSignRecallPetition",
+		"/membershipmodule.membership.MsgDeclareCandidacy",
+		"/membershipmodule.membership.MsgCastElectionBallot",
+		"/membershipmodule.membership.MsgDelegateVote",
+		"/membershipmodule.membership.MsgUndelegateVote",
+		"/membershipmodule.membership.MsgCommitVote",
+		"/membershipmodule.membership.MsgRevealVote",
+		"/membershipmodule.membership.MsgClaimMemberRewards",
+	}
+
+	KeyFeeWaiverLimit = []byte("FeeWaiverLimit")
+	// DefaultFeeWaiverLimit disables fee waivers
+	DefaultFeeWaiverLimit uint64 = 0
+
+	KeyFeeWaiverEpoch = []byte("FeeWaiverEpoch")
+	// DefaultFeeWaiverEpoch renews fee waivers roughly every day of 6 second blocks
+	DefaultFeeWaiverEpoch uint64 = 14400
+
+	KeyWasmAccessRole = []byte("WasmAccessRole")
+	// DefaultWasmAccessRole lets anyone upload and instantiate contracts, as the
+	// wasm module does on its own
+	DefaultWasmAccessRole = WasmAccessRole_WasmAccessRoleAnyone
+
+	KeyRestrictValidators = []byte("RestrictValidators")
+	// DefaultRestrictValidators lets anyone operate a validator
+	DefaultRestrictValidators = false
+
+	KeyEnrollmentFee = []byte("EnrollmentFee")
+	// DefaultEnrollmentFee lets anyone enroll for free
+	DefaultEnrollmentFee sdk.Coins
+
+	KeyDividendEpoch = []byte("DividendEpoch")
+	// DefaultDividendEpoch disables the member dividend
+	DefaultDividendEpoch uint64 = 0
+
+	KeyDividendTreasuryShare = []byte("DividendTreasuryShare")
+	// DefaultDividendTreasuryShare distributes a hundredth of the treasury's available funds each epoch
+	DefaultDividendTreasuryShare = sdk.NewDecWithPrec(1, 2)
+
+	KeyRecallPetitionPeriod = []byte("RecallPetitionPeriod")
+	// DefaultRecallPetitionPeriod gives recall petitions thirty days to collect signatures
+	DefaultRecallPetitionPeriod = 30 * 24 * time.Hour
+
+	KeyMaxSuspensionDuration = []byte("MaxSuspensionDuration")
+	// DefaultMaxSuspensionDuration caps suspensions at thirty days
+	DefaultMaxSuspensionDuration = 30 * 24 * time.Hour
+
+	KeyMinElectionTurnout = []byte("MinElectionTurnout")
+	// DefaultMinElectionTurnout requires a tenth of the electorate to vote in a guardian election
+	DefaultMinElectionTurnout = sdk.NewDecWithPrec(10, 2)
+
+	KeyMaxCandidacies = []byte("MaxCandidacies")
+	// DefaultMaxCandidacies bounds guardian elections to a hundred candidates
+	DefaultMaxCandidacies uint64 = 100
+
+	KeyGuardianChamberRule = []byte("GuardianChamberRule")
+	// DefaultGuardianChamberRule leaves the guardian chamber with the gov params
+	DefaultGuardianChamberRule = NewUnsetChamberRule()
+
+	KeyMemberChamberRule = []byte("MemberChamberRule")
+	// DefaultMemberChamberRule leaves the member chamber with the gov params
+	DefaultMemberChamberRule = NewUnsetChamberRule()
+
+	KeyFeeWaiverMaxGas = []byte("FeeWaiverMaxGas")
+	// DefaultFeeWaiverMaxGas covers a vote or a simple membership message
+	DefaultFeeWaiverMaxGas uint64 = 200000
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	recallThreshold sdk.Dec,
+	appealPeriod time.Duration,
+	electionPeriod uint64,
+	electionDuration uint64,
+	guardianSeats uint64,
+	electionMethod ElectionMethod,
+	guardianTermLength time.Duration,
+	maxConsecutiveTerms uint64,
+	bicameralMsgTypes []string,
+	tallyRules []TallyRule,
+	allowSplitVotes bool,
+	maxDelegationDepth uint64,
+	revealPeriod time.Duration,
+	countUnrevealedVotes bool,
+	restrictGovMessages bool,
+	feeWaiverMsgTypes []string,
+	feeWaiverLimit uint64,
+	feeWaiverEpoch uint64,
+	wasmAccessRole WasmAccessRole,
+	restrictValidators bool,
+	enrollmentFee sdk.Coins,
+	dividendEpoch uint64,
+	dividendTreasuryShare sdk.Dec,
+	recallPetitionPeriod time.Duration,
+	maxSuspensionDuration time.Duration,
+	minElectionTurnout sdk.Dec,
+	maxCandidacies uint64,
+	guardianChamberRule ChamberRule,
+	memberChamberRule ChamberRule,
+	feeWaiverMaxGas uint64,
+) Params {
+	return Params{
+		RecallThreshold:       recallThreshold,
+		AppealPeriod:          appealPeriod,
+		ElectionPeriod:        electionPeriod,
+		ElectionDuration:      electionDuration,
+		GuardianSeats:         guardianSeats,
+		ElectionMethod:        electionMethod,
+		GuardianTermLength:    guardianTermLength,
+		MaxConsecutiveTerms:   maxConsecutiveTerms,
+		BicameralMsgTypes:     bicameralMsgTypes,
+		TallyRules:            tallyRules,
+		AllowSplitVotes:       allowSplitVotes,
+		MaxDelegationDepth:    maxDelegationDepth,
+		RevealPeriod:          revealPeriod,
+		CountUnrevealedVotes:  countUnrevealedVotes,
+		RestrictGovMessages:   restrictGovMessages,
+		FeeWaiverMsgTypes:     feeWaiverMsgTypes,
+		FeeWaiverLimit:        feeWaiverLimit,
+		FeeWaiverEpoch:        feeWaiverEpoch,
+		WasmAccessRole:        wasmAccessRole,
+		RestrictValidators:    restrictValidators,
+		EnrollmentFee:         enrollmentFee,
+		DividendEpoch:         dividendEpoch,
+		DividendTreasuryShare: dividendTreasuryShare,
+		RecallPetitionPeriod:  recallPetitionPeriod,
+		MaxSuspensionDuration: maxSuspensionDuration,
+		MinElectionTurnout:    minElectionTurnout,
+		MaxCandidacies:        maxCandidacies,
+		GuardianChamberRule:   guardianChamberRule,
+		MemberChamberRule:     memberChamberRule,
+		FeeWaiverMaxGas:       feeWaiverMaxGas,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultRecallThreshold,
+		DefaultAppealPeriod,
+		DefaultElectionPeriod,
+		DefaultElectionDuration,
+		DefaultGuardianSeats,
+		DefaultElectionMethod,
+		DefaultGuardianTermLength,
+		DefaultMaxConsecutiveTerms,
+		DefaultBicameralMsgTypes,
+		DefaultTallyRules,
+		DefaultAllowSplitVotes,
+		DefaultMaxDelegationDepth,
+		DefaultRevealPeriod,
+		DefaultCountUnrevealedVotes,
+		DefaultRestrictGovMessages,
+		DefaultFeeWaiverMsgTypes,
+		DefaultFeeWaiverLimit,
+		DefaultFeeWaiverEpoch,
+		DefaultWasmAccessRole,
+		DefaultRestrictValidators,
+		DefaultEnrollmentFee,
+		DefaultDividendEpoch,
+		DefaultDividendTreasuryShare,
+		DefaultRecallPetitionPeriod,
+		DefaultMaxSuspensionDuration,
+		DefaultMinElectionTurnout,
+		DefaultMaxCandidacies,
+		DefaultGuardianChamberRule,
+		DefaultMemberChamberRule,
+		DefaultFeeWaiverMaxGas,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyRecallThreshold, &p.RecallThreshold, validateRecallThreshold),
+		paramtypes.NewParamSetPair(KeyAppealPeriod, &p.AppealPeriod, validateAppealPeriod),
+		paramtypes.NewParamSetPair(KeyElectionPeriod, &p.ElectionPeriod, validateElectionPeriod),
+		paramtypes.NewParamSetPair(KeyElectionDuration, &p.ElectionDuration, validateElectionDuration),
+		paramtypes.NewParamSetPair(KeyGuardianSeats, &p.GuardianSeats, validateGuardianSeats),
+		paramtypes.NewParamSetPair(KeyElectionMethod, &p.ElectionMethod, validateElectionMethod),
+		paramtypes.NewParamSetPair(KeyGuardianTermLength, &p.GuardianTermLength, validateGuardianTermLength),
+		paramtypes.NewParamSetPair(KeyMaxConsecutiveTerms, &p.MaxConsecutiveTerms, validateMaxConsecutiveTerms),
+		paramtypes.NewParamSetPair(KeyBicameralMsgTypes, &p.BicameralMsgTypes, validateBicameralMsgTypes),
+		paramtypes.NewParamSetPair(KeyTallyRules, &p.TallyRules, validateTallyRules),
+		paramtypes.NewParamSetPair(KeyAllowSplitVotes, &p.AllowSplitVotes, validateAllowSplitVotes),
+		paramtypes.NewParamSetPair(KeyMaxDelegationDepth, &p.MaxDelegationDepth, validateMaxDelegationDepth),
+		paramtypes.NewParamSetPair(KeyRevealPeriod, &p.RevealPeriod, validateRevealPeriod),
+		paramtypes.NewParamSetPair(KeyCountUnrevealedVotes, &p.CountUnrevealedVotes, validateCountUnrevealedVotes),
+		paramtypes.NewParamSetPair(KeyRestrictGovMessages, &p.RestrictGovMessages, validateRestrictGovMessages),
+		paramtypes.NewParamSetPair(KeyFeeWaiverMsgTypes, &p.FeeWaiverMsgTypes, validateFeeWaiverMsgTypes),
+		paramtypes.NewParamSetPair(KeyFeeWaiverLimit, &p.FeeWaiverLimit, validateFeeWaiverLimit),
+		paramtypes.NewParamSetPair(KeyFeeWaiverEpoch, &p.FeeWaiverEpoch, validateFeeWaiverEpoch),
+		paramtypes.NewParamSetPair(KeyWasmAccessRole, &p.WasmAccessRole, validateWasmAccessRole),
+		paramtypes.NewParamSetPair(KeyRestrictValidators, &p.RestrictValidators, validateRestrictValidators),
+		paramtypes.NewParamSetPair(KeyEnrollmentFee, &p.EnrollmentFee, validateEnrollmentFee),
+		paramtypes.NewParamSetPair(KeyDividendEpoch, &p.DividendEpoch, validateDividendEpoch),
+		paramtypes.NewParamSetPair(KeyDividendTreasuryShare, &p.DividendTreasuryShare, validateDividendTreasuryShare),
+		paramtypes.NewParamSetPair(KeyRecallPetitionPeriod, &p.RecallPetitionPeriod, validateRecallPetitionPeriod),
+		paramtypes.NewParamSetPair(KeyMaxSuspensionDuration, &p.MaxSuspensionDuration, validateMaxSuspensionDuration),
+		paramtypes.NewParamSetPair(KeyMinElectionTurnout, &p.MinElectionTurnout, validateMinElectionTurnout),
+		paramtypes.NewParamSetPair(KeyMaxCandidacies, &p.MaxCandidacies, validateMaxCandidacies),
+		paramtypes.NewParamSetPair(KeyGuardianChamberRule, &p.GuardianChamberRule, validateGuardianChamberRule),
+		paramtypes.NewParamSetPair(KeyMemberChamberRule, &p.MemberChamberRule, validateMemberChamberRule),
+		paramtypes.NewParamSetPair(KeyFeeWaiverMaxGas, &p.FeeWaiverMaxGas, validateFeeWaiverMaxGas),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validateRecallThreshold(p.RecallThreshold); err != nil {
+		return err
+	}
+	if err := validateAppealPeriod(p.AppealPeriod); err != nil {
+		return err
+	}
+	if err := validateElectionPeriod(p.ElectionPeriod); err != nil {
+		return err
+	}
+	if err := validateElectionDuration(p.ElectionDuration); err != nil {
+		return err
+	}
+	if p.ElectionPeriod > 0 && p.ElectionDuration >= p.ElectionPeriod {
+		return fmt.Errorf("election duration must be shorter than the election period: %d >= %d", p.ElectionDuration, p.ElectionPeriod)
+	}
+	if err := validateGuardianSeats(p.GuardianSeats); err != nil {
+		return err
+	}
+	if err := validateElectionMethod(p.ElectionMethod); err != nil {
+		return err
+	}
+	if err := validateGuardianTermLength(p.GuardianTermLength); err != nil {
+		return err
+	}
+	if err := validateMaxConsecutiveTerms(p.MaxConsecutiveTerms); err != nil {
+		return err
+	}
+	if err := validateBicameralMsgTypes(p.BicameralMsgTypes); err != nil {
+		return err
+	}
+	if err := validateTallyRules(p.TallyRules); err != nil {
+		return err
+	}
+	if err := validateAllowSplitVotes(p.AllowSplitVotes); err != nil {
+		return err
+	}
+	if err := validateMaxDelegationDepth(p.MaxDelegationDepth); err != nil {
+		return err
+	}
+	if err := validateRevealPeriod(p.RevealPeriod); err != nil {
+		return err
+	}
+	if err := validateCountUnrevealedVotes(p.CountUnrevealedVotes); err != nil {
+		return err
+	}
+	if err := validateRestrictGovMessages(p.RestrictGovMessages); err != nil {
+		return err
+	}
+	if err := validateFeeWaiverMsgTypes(p.FeeWaiverMsgTypes); err != nil {
+		return err
+	}
+	if err := validateFeeWaiverLimit(p.FeeWaiverLimit); err != nil {
+		return err
+	}
+	if err := validateFeeWaiverEpoch(p.FeeWaiverEpoch); err != nil {
+		return err
+	}
+	if err := validateWasmAccessRole(p.WasmAccessRole); err != nil {
+		return err
+	}
+	if err := validateRestrictValidators(p.RestrictValidators); err != nil {
+		return err
+	}
+	if err := validateEnrollmentFee(p.EnrollmentFee); err != nil {
+		return err
+	}
+	if err := validateDividendEpoch(p.DividendEpoch); err != nil {
+		return err
+	}
+	if err := validateDividendTreasuryShare(p.DividendTreasuryShare); err != nil {
+		return err
+	}
+	if err := validateRecallPetitionPeriod(p.RecallPetitionPeriod); err != nil {
+		return err
+	}
+	if err := validateMaxSuspensionDuration(p.MaxSuspensionDuration); err != nil {
+		return err
+	}
+	if err := validateMinElectionTurnout(p.MinElectionTurnout); err != nil {
+		return err
+	}
+	if err := validateMaxCandidacies(p.MaxCandidacies); err != nil {
+		return err
+	}
+	if err := validateGuardianChamberRule(p.GuardianChamberRule); err != nil {
+		return err
+	}
+	if err := validateMemberChamberRule(p.MemberChamberRule); err != nil {
+		return err
+	}
+	if err := validateFeeWaiverMaxGas(p.FeeWaiverMaxGas); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -37,3 +397,337 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+// validateRecallThreshold ensures the threshold is a fraction in (0, 1]
+func validateRecallThreshold(v interface{}) error {
+	threshold, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if threshold.IsNil() || !threshold.IsPositive() || threshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("recall threshold must be greater than 0 and at most 1: %s", threshold)
+	}
+	return nil
+}
+
+// validateAppealPeriod ensures the appeal period is positive
+func validateAppealPeriod(v interface{}) error {
+	period, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if period <= 0 {
+		return fmt.Errorf("appeal period must be positive: %s", period)
+	}
+	return nil
+}
+
+// validateElectionPeriod ensures the election period is a block count, where zero disables elections
+func validateElectionPeriod(v interface{}) error {
+	if _, ok := v.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return nil
+}
+
+// validateElectionDuration ensures elections stay open for at least one block
+func validateElectionDuration(v interface{}) error {
+	duration, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if duration == 0 {
+		return fmt.Errorf("election duration must be positive")
+	}
+	return nil
+}
+
+// validateGuardianSeats ensures elections choose at least one guardian
+func validateGuardianSeats(v interface{}) error {
+	seats, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if seats == 0 {
+		return fmt.Errorf("guardian seats must be positive")
+	}
+	return nil
+}
+
+// validateElectionMethod ensures the election method is supported
+func validateElectionMethod(v interface{}) error {
+	method, ok := v.(ElectionMethod)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if method != ElectionMethod_ElectionMethodApproval && method != ElectionMethod_ElectionMethodSTV {
+		return fmt.Errorf("unsupported election method: %s", method)
+	}
+	return nil
+}
+
+// validateGuardianTermLength ensures the term length is not negative, where zero disables term limits
+func validateGuardianTermLength(v interface{}) error {
+	length, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if length < 0 {
+		return fmt.Errorf("guardian term length cannot be negative: %s", length)
+	}
+	return nil
+}
+
+// validateMaxConsecutiveTerms ensures the term limit is a count, where zero allows any number of terms
+func validateMaxConsecutiveTerms(v interface{}) error {
+	if _, ok := v.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return nil
+}
+
+// validateBicameralMsgTypes ensures each message type URL is listed once
+func validateBicameralMsgTypes(v interface{}) error {
+	msgTypes, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return validateMsgTypeURLs(msgTypes)
+}
+
+// validateMsgTypeURLs ensures each message type URL is well formed and listed once
+func validateMsgTypeURLs(msgTypes []string) error {
+	seen := make(map[string]bool, len(msgTypes))
+	for _, msgType := range msgTypes {
+		if !strings.HasPrefix(msgType, "/") || len(msgType) < 2 {
+			return fmt.Errorf("invalid message type url: %q", msgType)
+		}
+		if seen[msgType] {
+			return fmt.Errorf("duplicate message type url: %s", msgType)
+		}
+		seen[msgType] = true
+	}
+	return nil
+}
+
+// validateTallyRules ensures each rule is valid, and that each message type has at most one rule
+func validateTallyRules(v interface{}) error {
+	rules, ok := v.([]TallyRule)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	seen := make(map[string]bool, len(rules))
+	for _, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+		if seen[rule.MsgTypeUrl] {
+			return fmt.Errorf("duplicate tally rule: %s", rule.MsgTypeUrl)
+		}
+		seen[rule.MsgTypeUrl] = true
+	}
+	return nil
+}
+
+// validateAllowSplitVotes ensures the split votes toggle is a bool
+func validateAllowSplitVotes(v interface{}) error {
+	if _, ok := v.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return nil
+}
+
+// validateMaxDelegationDepth ensures the delegation depth is a count, where zero ignores vote delegations
+func validateMaxDelegationDepth(v interface{}) error {
+	if _, ok := v.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return nil
+}
+
+// validateRevealPeriod ensures the reveal period is positive
+func validateRevealPeriod(v interface{}) error {
+	period, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if period <= 0 {
+		return fmt.Errorf("reveal period must be positive: %s", period)
+	}
+	return nil
+}
+
+// validateCountUnrevealedVotes ensures the unrevealed votes toggle is a bool
+func validateCountUnrevealedVotes(v interface{}) error {
+	if _, ok := v.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return nil
+}
+
+// validateRestrictGovMessages ensures the gov message restriction toggle is a bool
+func validateRestrictGovMessages(v interface{}) error {
+	if _, ok := v.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return nil
+}
+
+// validateFeeWaiverMsgTypes ensures each fee-free message type URL is well formed and listed once
+func validateFeeWaiverMsgTypes(v interface{}) error {
+	msgTypes, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return validateMsgTypeURLs(msgTypes)
+}
+
+// validateFeeWaiverLimit ensures the fee waiver limit is a uint64
+func validateFeeWaiverLimit(v interface{}) error {
+	if _, ok := v.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return nil
+}
+
+// validateFeeWaiverEpoch ensures the fee waiver epoch is at least one block
+func validateFeeWaiverEpoch(v interface{}) error {
+	epoch, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if epoch == 0 {
+		return fmt.Errorf("fee waiver epoch must be positive")
+	}
+	return nil
+}
+
+// validateWasmAccessRole ensures the wasm access role is supported
+func validateWasmAccessRole(v interface{}) error {
+	role, ok := v.(WasmAccessRole)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if _, ok := WasmAccessRole_name[int32(role)]; !ok || role == WasmAccessRole_WasmAccessRoleEmpty {
+		return fmt.Errorf("unsupported wasm access role: %s", role)
+	}
+	return nil
+}
+
+// validateRestrictValidators ensures the validator restriction toggle is a bool
+func validateRestrictValidators(v interface{}) error {
+	if _, ok := v.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return nil
+}
+
+// validateEnrollmentFee ensures the enrollment fee is a valid amount of coins
+func validateEnrollmentFee(v interface{}) error {
+	fee, ok := v.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if err := fee.Validate(); err != nil {
+		return fmt.Errorf("invalid enrollment fee: %w", err)
+	}
+	return nil
+}
+
+// validateDividendEpoch ensures the dividend epoch is a number of blocks
+func validateDividendEpoch(v interface{}) error {
+	if _, ok := v.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return nil
+}
+
+// validateDividendTreasuryShare ensures the dividend's share of the treasury is a fraction
+func validateDividendTreasuryShare(v interface{}) error {
+	share, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if share.IsNil() || share.IsNegative() || share.GT(sdk.OneDec()) {
+		return fmt.Errorf("dividend treasury share must be between 0 and 1: %s", share)
+	}
+	return nil
+}
+
+// validateRecallPetitionPeriod ensures the recall petition period is positive
+func validateRecallPetitionPeriod(v interface{}) error {
+	period, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if period <= 0 {
+		return fmt.Errorf("recall petition period must be positive: %s", period)
+	}
+	return nil
+}
+
+// validateMaxSuspensionDuration ensures the maximum suspension duration is positive
+func validateMaxSuspensionDuration(v interface{}) error {
+	duration, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if duration <= 0 {
+		return fmt.Errorf("max suspension duration must be positive: %s", duration)
+	}
+	return nil
+}
+
+// validateMinElectionTurnout ensures the turnout is a fraction in [0, 1]
+func validateMinElectionTurnout(v interface{}) error {
+	turnout, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if turnout.IsNil() || turnout.IsNegative() || turnout.GT(sdk.OneDec()) {
+		return fmt.Errorf("min election turnout must be between 0 and 1: %s", turnout)
+	}
+	return nil
+}
+
+// validateMaxCandidacies ensures at least one candidacy can be declared
+func validateMaxCandidacies(v interface{}) error {
+	max, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if max == 0 {
+		return fmt.Errorf("max candidacies must be positive")
+	}
+	return nil
+}
+
+// validateGuardianChamberRule ensures the guardian chamber rule is either unset or valid
+func validateGuardianChamberRule(v interface{}) error {
+	rule, ok := v.(ChamberRule)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return rule.Validate()
+}
+
+// validateMemberChamberRule ensures the member chamber rule is either unset or valid
+func validateMemberChamberRule(v interface{}) error {
+	rule, ok := v.(ChamberRule)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return rule.Validate()
+}
+
+// validateFeeWaiverMaxGas ensures fee-free transactions can use some gas
+func validateFeeWaiverMaxGas(v interface{}) error {
+	maxGas, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if maxGas == 0 {
+		return fmt.Errorf("fee waiver max gas must be positive")
+	}
+	return nil
+}
@@ -5,17 +5,23 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -23,8 +29,124 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// WasmAccessRole enumerates the members allowed to upload and instantiate
+// CosmWasm contracts
+type WasmAccessRole int32
+
+const (
+	// WASM_ACCESS_ROLE_UNSPECIFIED defines a no-op role
+	WasmAccessRole_WasmAccessRoleEmpty WasmAccessRole = 0
+	// WASM_ACCESS_ROLE_ANYONE leaves contract uploads and instantiations to the wasm module's own permissions
+	WasmAccessRole_WasmAccessRoleAnyone WasmAccessRole = 1
+	// WASM_ACCESS_ROLE_ELECTORATE restricts contract uploads and instantiations to electorate members
+	WasmAccessRole_WasmAccessRoleElectorate WasmAccessRole = 2
+	// WASM_ACCESS_ROLE_GUARDIAN restricts contract uploads and instantiations to guardians
+	WasmAccessRole_WasmAccessRoleGuardian WasmAccessRole = 3
+)
+
+var WasmAccessRole_name = map[int32]string{
+	0: "WASM_ACCESS_ROLE_UNSPECIFIED",
+	1: "WASM_ACCESS_ROLE_ANYONE",
+	2: "WASM_ACCESS_ROLE_ELECTORATE",
+	3: "WASM_ACCESS_ROLE_GUARDIAN",
+}
+
+var WasmAccessRole_value = map[string]int32{
+	"WASM_ACCESS_ROLE_UNSPECIFIED": 0,
+	"WASM_ACCESS_ROLE_ANYONE":      1,
+	"WASM_ACCESS_ROLE_ELECTORATE":  2,
+	"WASM_ACCESS_ROLE_GUARDIAN":    3,
+}
+
+func (x WasmAccessRole) String() string {
+	return proto.EnumName(WasmAccessRole_name, int32(x))
+}
+
+func (WasmAccessRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_430a9023a1773454, []int{0}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
+	// Fraction of the electorate that must sign a recall petition before a
+	// recall proposal is submitted
+	RecallThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=recall_threshold,json=recallThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"recall_threshold,omitempty"`
+	// Length of time an expelled member has to appeal their expulsion
+	AppealPeriod time.Duration `protobuf:"bytes,2,opt,name=appeal_period,json=appealPeriod,proto3,stdduration" json:"appeal_period,omitempty"`
+	// Number of blocks between the opening of guardian elections, where zero
+	// disables elections
+	ElectionPeriod uint64 `protobuf:"varint,3,opt,name=election_period,json=electionPeriod,proto3" json:"election_period,omitempty"`
+	// Number of blocks a guardian election stays open for ballots
+	ElectionDuration uint64 `protobuf:"varint,4,opt,name=election_duration,json=electionDuration,proto3" json:"election_duration,omitempty"`
+	// Number of guardians chosen by each election
+	GuardianSeats uint64 `protobuf:"varint,5,opt,name=guardian_seats,json=guardianSeats,proto3" json:"guardian_seats,omitempty"`
+	// Method used to choose the winners of guardian elections
+	ElectionMethod ElectionMethod `protobuf:"varint,6,opt,name=election_method,json=electionMethod,proto3,enum=membershipmodule.membership.ElectionMethod" json:"election_method,omitempty"`
+	// Length of a guardian's term, where zero disables term limits
+	GuardianTermLength time.Duration `protobuf:"bytes,7,opt,name=guardian_term_length,json=guardianTermLength,proto3,stdduration" json:"guardian_term_length,omitempty"`
+	// Maximum number of consecutive terms a guardian can serve, where zero
+	// allows any number of terms
+	MaxConsecutiveTerms uint64 `protobuf:"varint,8,opt,name=max_consecutive_terms,json=maxConsecutiveTerms,proto3" json:"max_consecutive_terms,omitempty"`
+	// Type URLs of the proposal messages that the guardians and the members
+	// must each approve in their own chamber
+	BicameralMsgTypes []string `protobuf:"bytes,9,rep,name=bicameral_msg_types,json=bicameralMsgTypes,proto3" json:"bicameral_msg_types,omitempty"`
+	// Tally rules that replace the gov quorum, threshold and veto threshold for
+	// proposals containing particular messages
+	TallyRules []TallyRule `protobuf:"bytes,10,rep,name=tally_rules,json=tallyRules,proto3" json:"tally_rules,omitempty"`
+	// Allow split votes spreads each voter's vote across the options they
+	// weight, instead of discarding any vote that is not for a single option
+	AllowSplitVotes bool `protobuf:"varint,11,opt,name=allow_split_votes,json=allowSplitVotes,proto3" json:"allow_split_votes,omitempty"`
+	// Maximum number of delegations followed to find a vote for a member who
+	// did not vote, where zero ignores vote delegations
+	MaxDelegationDepth uint64 `protobuf:"varint,12,opt,name=max_delegation_depth,json=maxDelegationDepth,proto3" json:"max_delegation_depth,omitempty"`
+	// Time after a secret ballot's voting period during which members reveal
+	// their committed votes
+	RevealPeriod time.Duration `protobuf:"bytes,13,opt,name=reveal_period,json=revealPeriod,proto3,stdduration" json:"reveal_period,omitempty"`
+	// Count votes that were committed but never revealed as abstaining, so that
+	// they count towards quorum
+	CountUnrevealedVotes bool `protobuf:"varint,14,opt,name=count_unrevealed_votes,json=countUnrevealedVotes,proto3" json:"count_unrevealed_votes,omitempty"`
+	// Reject governance proposals and votes from signers who are not electorate
+	// members before they pay any fees or deposits
+	RestrictGovMessages bool `protobuf:"varint,15,opt,name=restrict_gov_messages,json=restrictGovMessages,proto3" json:"restrict_gov_messages,omitempty"`
+	// Message types electorate members can send without paying fees
+	FeeWaiverMsgTypes []string `protobuf:"bytes,16,rep,name=fee_waiver_msg_types,json=feeWaiverMsgTypes,proto3" json:"fee_waiver_msg_types,omitempty"`
+	// Number of fee-free transactions each electorate member can send per
+	// epoch, where zero disables fee waivers
+	FeeWaiverLimit uint64 `protobuf:"varint,17,opt,name=fee_waiver_limit,json=feeWaiverLimit,proto3" json:"fee_waiver_limit,omitempty"`
+	// Length of a fee waiver epoch in blocks
+	FeeWaiverEpoch uint64 `protobuf:"varint,18,opt,name=fee_waiver_epoch,json=feeWaiverEpoch,proto3" json:"fee_waiver_epoch,omitempty"`
+	// Role a signer needs to upload or instantiate CosmWasm contracts
+	WasmAccessRole WasmAccessRole `protobuf:"varint,19,opt,name=wasm_access_role,json=wasmAccessRole,proto3,enum=membershipmodule.membership.WasmAccessRole" json:"wasm_access_role,omitempty"`
+	// Only let electorate members operate validators, jailing the validators
+	// of members who leave the electorate
+	RestrictValidators bool `protobuf:"varint,20,opt,name=restrict_validators,json=restrictValidators,proto3" json:"restrict_validators,omitempty"`
+	// Fee paid into the membership treasury by each new enrollee
+	EnrollmentFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,21,rep,name=enrollment_fee,json=enrollmentFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"enrollment_fee,omitempty"`
+	// Number of blocks between member dividends, where zero disables them
+	DividendEpoch uint64 `protobuf:"varint,22,opt,name=dividend_epoch,json=dividendEpoch,proto3" json:"dividend_epoch,omitempty"`
+	// Share of the treasury's available funds distributed equally to the
+	// electorate each dividend epoch
+	DividendTreasuryShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,23,opt,name=dividend_treasury_share,json=dividendTreasuryShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"dividend_treasury_share,omitempty"`
+	// Length of time a recall petition has to meet the recall threshold before
+	// it expires
+	RecallPetitionPeriod time.Duration `protobuf:"bytes,24,opt,name=recall_petition_period,json=recallPetitionPeriod,proto3,stdduration" json:"recall_petition_period,omitempty"`
+	// Longest suspension a guardian can impose
+	MaxSuspensionDuration time.Duration `protobuf:"bytes,25,opt,name=max_suspension_duration,json=maxSuspensionDuration,proto3,stdduration" json:"max_suspension_duration,omitempty"`
+	// Fraction of the electorate that must cast a ballot for a guardian
+	// election to replace the incumbent guardians
+	MinElectionTurnout github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,26,opt,name=min_election_turnout,json=minElectionTurnout,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_election_turnout,omitempty"`
+	// Maximum number of candidacies that can be declared for a guardian
+	// election, which bounds the cost of counting its ballots
+	MaxCandidacies uint64 `protobuf:"varint,27,opt,name=max_candidacies,json=maxCandidacies,proto3" json:"max_candidacies,omitempty"`
+	// Quorum, threshold and veto threshold the guardian chamber applies to
+	// bicameral proposals in place of the gov params, when set
+	GuardianChamberRule ChamberRule `protobuf:"bytes,28,opt,name=guardian_chamber_rule,json=guardianChamberRule,proto3" json:"guardian_chamber_rule,omitempty"`
+	// Quorum, threshold and veto threshold the member chamber applies to
+	// bicameral proposals in place of the gov params, when set
+	MemberChamberRule ChamberRule `protobuf:"bytes,29,opt,name=member_chamber_rule,json=memberChamberRule,proto3" json:"member_chamber_rule,omitempty"`
+	// Highest gas limit a transaction can request and still have its fees
+	// waived
+	FeeWaiverMaxGas uint64 `protobuf:"varint,30,opt,name=fee_waiver_max_gas,json=feeWaiverMaxGas,proto3" json:"fee_waiver_max_gas,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,7 +181,197 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetAppealPeriod() time.Duration {
+	if m != nil {
+		return m.AppealPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetElectionPeriod() uint64 {
+	if m != nil {
+		return m.ElectionPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetElectionDuration() uint64 {
+	if m != nil {
+		return m.ElectionDuration
+	}
+	return 0
+}
+
+func (m *Params) GetGuardianSeats() uint64 {
+	if m != nil {
+		return m.GuardianSeats
+	}
+	return 0
+}
+
+func (m *Params) GetElectionMethod() ElectionMethod {
+	if m != nil {
+		return m.ElectionMethod
+	}
+	return ElectionMethod_ElectionMethodEmpty
+}
+
+func (m *Params) GetGuardianTermLength() time.Duration {
+	if m != nil {
+		return m.GuardianTermLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxConsecutiveTerms() uint64 {
+	if m != nil {
+		return m.MaxConsecutiveTerms
+	}
+	return 0
+}
+
+func (m *Params) GetBicameralMsgTypes() []string {
+	if m != nil {
+		return m.BicameralMsgTypes
+	}
+	return nil
+}
+
+func (m *Params) GetTallyRules() []TallyRule {
+	if m != nil {
+		return m.TallyRules
+	}
+	return nil
+}
+
+func (m *Params) GetAllowSplitVotes() bool {
+	if m != nil {
+		return m.AllowSplitVotes
+	}
+	return false
+}
+
+func (m *Params) GetMaxDelegationDepth() uint64 {
+	if m != nil {
+		return m.MaxDelegationDepth
+	}
+	return 0
+}
+
+func (m *Params) GetRevealPeriod() time.Duration {
+	if m != nil {
+		return m.RevealPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetCountUnrevealedVotes() bool {
+	if m != nil {
+		return m.CountUnrevealedVotes
+	}
+	return false
+}
+
+func (m *Params) GetRestrictGovMessages() bool {
+	if m != nil {
+		return m.RestrictGovMessages
+	}
+	return false
+}
+
+func (m *Params) GetFeeWaiverMsgTypes() []string {
+	if m != nil {
+		return m.FeeWaiverMsgTypes
+	}
+	return nil
+}
+
+func (m *Params) GetFeeWaiverLimit() uint64 {
+	if m != nil {
+		return m.FeeWaiverLimit
+	}
+	return 0
+}
+
+func (m *Params) GetFeeWaiverEpoch() uint64 {
+	if m != nil {
+		return m.FeeWaiverEpoch
+	}
+	return 0
+}
+
+func (m *Params) GetWasmAccessRole() WasmAccessRole {
+	if m != nil {
+		return m.WasmAccessRole
+	}
+	return WasmAccessRole_WasmAccessRoleEmpty
+}
+
+func (m *Params) GetRestrictValidators() bool {
+	if m != nil {
+		return m.RestrictValidators
+	}
+	return false
+}
+
+func (m *Params) GetEnrollmentFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.EnrollmentFee
+	}
+	return nil
+}
+
+func (m *Params) GetDividendEpoch() uint64 {
+	if m != nil {
+		return m.DividendEpoch
+	}
+	return 0
+}
+
+func (m *Params) GetRecallPetitionPeriod() time.Duration {
+	if m != nil {
+		return m.RecallPetitionPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSuspensionDuration() time.Duration {
+	if m != nil {
+		return m.MaxSuspensionDuration
+	}
+	return 0
+}
+
+func (m *Params) GetMaxCandidacies() uint64 {
+	if m != nil {
+		return m.MaxCandidacies
+	}
+	return 0
+}
+
+func (m *Params) GetGuardianChamberRule() ChamberRule {
+	if m != nil {
+		return m.GuardianChamberRule
+	}
+	return ChamberRule{}
+}
+
+func (m *Params) GetMemberChamberRule() ChamberRule {
+	if m != nil {
+		return m.MemberChamberRule
+	}
+	return ChamberRule{}
+}
+
+func (m *Params) GetFeeWaiverMaxGas() uint64 {
+	if m != nil {
+		return m.FeeWaiverMaxGas
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("membershipmodule.membership.WasmAccessRole", WasmAccessRole_name, WasmAccessRole_value)
 	proto.RegisterType((*Params)(nil), "membershipmodule.membership.Params")
 }
 
@@ -68,18 +380,95 @@ func init() {
 }
 
 var fileDescriptor_430a9023a1773454 = []byte{
-	// 161 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xc8, 0x4d, 0xcd, 0x4d,
-	0x4a, 0x2d, 0x2a, 0xce, 0xc8, 0x2c, 0xc8, 0xcd, 0x4f, 0x29, 0xcd, 0x49, 0xd5, 0x47, 0x08, 0xe8,
-	0x17, 0x24, 0x16, 0x25, 0xe6, 0x16, 0xeb, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x49, 0xa3, 0xab,
-	0xd4, 0x43, 0x08, 0x48, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0xd5, 0xe9, 0x83, 0x58, 0x10, 0x2d,
-	0x4a, 0x7c, 0x5c, 0x6c, 0x01, 0x60, 0x23, 0xac, 0x58, 0x66, 0x2c, 0x90, 0x67, 0x70, 0x0a, 0x3e,
-	0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63,
-	0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xcb, 0xf4, 0xcc, 0x92, 0x8c, 0xd2,
-	0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xbc, 0xfc, 0xa2, 0xcc, 0x44, 0xdd, 0xbc, 0xd4, 0x12, 0x7d,
-	0x88, 0x3d, 0xba, 0x48, 0x2e, 0xaa, 0x40, 0x76, 0x5e, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b,
-	0xd8, 0x2e, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5c, 0x43, 0x2f, 0x19, 0xca, 0x00, 0x00,
-	0x00,
+	// 1397 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0xcf, 0x4f, 0x1b, 0x47,
+	0x14, 0x66, 0x43, 0x9a, 0x26, 0x43, 0x20, 0x66, 0x31, 0xb0, 0x18, 0xf0, 0x3a, 0x49, 0x9b, 0x58,
+	0x69, 0xb0, 0x95, 0x54, 0x3d, 0xa4, 0x52, 0x0f, 0xc6, 0x38, 0x34, 0x2a, 0x10, 0x6a, 0x3b, 0x41,
+	0xcd, 0x65, 0x35, 0xec, 0x3e, 0xec, 0x55, 0x77, 0x77, 0xac, 0x9d, 0x59, 0x03, 0x87, 0xaa, 0x55,
+	0xa5, 0x5e, 0xa8, 0x54, 0xf5, 0x98, 0x0b, 0x52, 0xcf, 0xfd, 0x3f, 0x2a, 0xe5, 0x98, 0x63, 0xd5,
+	0x83, 0x53, 0x25, 0x37, 0xff, 0x0d, 0x3d, 0x54, 0x33, 0xfb, 0xc3, 0xb3, 0x66, 0x43, 0x92, 0x13,
+	0x66, 0xbf, 0xef, 0x7d, 0xf3, 0xf6, 0xfd, 0x9c, 0x45, 0x65, 0x17, 0xdc, 0x7d, 0xf0, 0x69, 0xd7,
+	0xee, 0xb9, 0xc4, 0x0a, 0x1c, 0xa8, 0x8e, 0x1e, 0x54, 0x7b, 0xd8, 0xc7, 0x2e, 0xad, 0xf4, 0x7c,
+	0xc2, 0x88, 0xba, 0x3c, 0xce, 0xac, 0x8c, 0x1e, 0x14, 0x8a, 0x26, 0xa1, 0x2e, 0xa1, 0xd5, 0x7d,
+	0x4c, 0xa1, 0xda, 0xbf, 0xb7, 0x0f, 0x0c, 0xdf, 0xab, 0x9a, 0xc4, 0xf6, 0x42, 0xe3, 0x42, 0xbe,
+	0x43, 0x3a, 0x44, 0xfc, 0xac, 0xf2, 0x5f, 0xd1, 0xd3, 0x62, 0x87, 0x90, 0x8e, 0x03, 0x55, 0xf1,
+	0xdf, 0x7e, 0x70, 0x50, 0xb5, 0x02, 0x1f, 0x33, 0x9b, 0xc4, 0x56, 0x77, 0xce, 0x73, 0x0e, 0x1c,
+	0x30, 0x25, 0xee, 0xed, 0xf3, 0xb8, 0x0c, 0x3b, 0xce, 0x71, 0x48, 0xbc, 0xf1, 0xd7, 0x22, 0xba,
+	0xb4, 0x2b, 0x5e, 0x4c, 0x3d, 0x44, 0x39, 0x1f, 0x4c, 0xec, 0x38, 0x06, 0xeb, 0xfa, 0x40, 0xbb,
+	0xc4, 0xb1, 0x34, 0xa5, 0xa4, 0x94, 0xaf, 0xae, 0x6f, 0xbd, 0x18, 0xe8, 0x13, 0xff, 0x0c, 0xf4,
+	0x5b, 0x1d, 0x9b, 0x75, 0x83, 0xfd, 0x8a, 0x49, 0xdc, 0x6a, 0xf4, 0x8a, 0xe1, 0x9f, 0x35, 0x6a,
+	0x7d, 0x5f, 0x65, 0xc7, 0x3d, 0xa0, 0x95, 0x0d, 0x30, 0x87, 0x03, 0xbd, 0x30, 0xae, 0x74, 0x97,
+	0xb8, 0x36, 0x03, 0xb7, 0xc7, 0x8e, 0x9b, 0xd7, 0x42, 0xac, 0x1d, 0x43, 0xaa, 0x89, 0xa6, 0x71,
+	0xaf, 0x07, 0xd8, 0x31, 0x7a, 0xe0, 0xdb, 0xc4, 0xd2, 0x2e, 0x94, 0x94, 0xf2, 0xd4, 0xfd, 0xa5,
+	0x4a, 0x18, 0x90, 0x4a, 0x1c, 0x90, 0xca, 0x46, 0x14, 0x90, 0xf5, 0x9b, 0xdc, 0xa1, 0xe1, 0x40,
+	0x5f, 0x4c, 0xd9, 0x8d, 0xce, 0x78, 0xfe, 0x4a, 0x57, 0x9a, 0x57, 0x43, 0x70, 0x57, 0x60, 0xea,
+	0x43, 0x74, 0x2d, 0x8e, 0x51, 0x7c, 0xcc, 0x64, 0x49, 0x29, 0x5f, 0x5c, 0x5f, 0x1d, 0x0e, 0xf4,
+	0xa5, 0x31, 0x48, 0xf2, 0x76, 0x26, 0x86, 0x22, 0x9d, 0x2d, 0x34, 0x9b, 0x90, 0xe3, 0x04, 0x69,
+	0x17, 0x85, 0x92, 0x3e, 0x1c, 0xe8, 0xcb, 0x67, 0x40, 0x49, 0x2b, 0x17, 0x83, 0xf1, 0x8b, 0xa8,
+	0x75, 0x34, 0xd3, 0x09, 0xb0, 0x6f, 0xd9, 0xd8, 0x33, 0x28, 0x60, 0x46, 0xb5, 0x8f, 0x84, 0xd4,
+	0xca, 0x70, 0xa0, 0x6b, 0x69, 0x44, 0xd2, 0x99, 0x8e, 0x91, 0x16, 0x07, 0x54, 0x2a, 0xbd, 0x9a,
+	0x0b, 0xac, 0x4b, 0x2c, 0xed, 0x52, 0x49, 0x29, 0xcf, 0xdc, 0xff, 0xac, 0x72, 0x4e, 0x95, 0x56,
+	0x1a, 0x91, 0xcd, 0xb6, 0x30, 0x19, 0x8b, 0x43, 0xa8, 0x93, 0x15, 0x87, 0x90, 0xae, 0x1e, 0xa2,
+	0x7c, 0xe2, 0x1f, 0x03, 0xdf, 0x35, 0x1c, 0xf0, 0x3a, 0xac, 0xab, 0x7d, 0xfc, 0xae, 0xdc, 0xdd,
+	0x89, 0x72, 0x57, 0xcc, 0x32, 0x1f, 0x4b, 0xa1, 0x1a, 0x73, 0xda, 0xe0, 0xbb, 0x5b, 0x82, 0xa1,
+	0xee, 0xa1, 0x79, 0x17, 0x1f, 0x19, 0x26, 0xf1, 0x28, 0x98, 0x01, 0xb3, 0xfb, 0x20, 0x04, 0xa8,
+	0x76, 0x59, 0x44, 0xee, 0xe6, 0x70, 0xa0, 0xeb, 0x99, 0x04, 0xe9, 0x65, 0xe6, 0x5c, 0x7c, 0x54,
+	0x1f, 0xe1, 0x5c, 0x9d, 0xaa, 0xdf, 0xa2, 0xb9, 0x7d, 0xdb, 0xc4, 0x2e, 0xf8, 0xd8, 0x31, 0x5c,
+	0xda, 0x31, 0x44, 0x41, 0x6b, 0x57, 0x4a, 0x93, 0xe5, 0x2b, 0xeb, 0xd7, 0x87, 0x03, 0x7d, 0x35,
+	0x03, 0x96, 0x44, 0x67, 0x13, 0x78, 0x9b, 0x76, 0xda, 0x1c, 0x54, 0x0f, 0xd0, 0x94, 0x68, 0x36,
+	0xc3, 0x0f, 0x1c, 0xa0, 0x1a, 0x2a, 0x4d, 0x96, 0xa7, 0xee, 0xdf, 0x3a, 0x37, 0x2b, 0x6d, 0xce,
+	0x6f, 0x06, 0x0e, 0xac, 0xaf, 0x46, 0x81, 0x9a, 0x97, 0x24, 0xa4, 0xe3, 0x10, 0x8b, 0x99, 0x54,
+	0xfd, 0x06, 0xcd, 0x62, 0xc7, 0x21, 0x87, 0x06, 0xed, 0x39, 0x36, 0x33, 0xfa, 0x84, 0x01, 0xd5,
+	0xa6, 0x4a, 0x4a, 0xf9, 0x72, 0x58, 0x94, 0x67, 0x40, 0xb9, 0x1d, 0x05, 0xd8, 0xe2, 0xd8, 0x53,
+	0x0e, 0xa9, 0x6d, 0x94, 0xe7, 0xf1, 0xb3, 0xc0, 0x81, 0x0e, 0x0e, 0x4b, 0x19, 0x7a, 0xac, 0xab,
+	0x5d, 0x15, 0xf1, 0xbd, 0xc1, 0x53, 0x97, 0x85, 0x4b, 0x92, 0xaa, 0x8b, 0x8f, 0x36, 0x12, 0x78,
+	0x83, 0xa3, 0xbc, 0xc9, 0x7d, 0xe8, 0x4b, 0x4d, 0x3e, 0xfd, 0xde, 0x4d, 0x9e, 0xb2, 0x1b, 0x6f,
+	0xf2, 0x10, 0x8c, 0x9a, 0xf3, 0x19, 0x5a, 0x30, 0x49, 0xe0, 0x31, 0x23, 0xf0, 0xc2, 0xe7, 0x60,
+	0x45, 0xc1, 0x98, 0x11, 0xc1, 0xf8, 0x64, 0x38, 0xd0, 0x4b, 0xd9, 0x0c, 0xc9, 0xfd, 0xbc, 0x60,
+	0x3c, 0x49, 0x08, 0x61, 0x58, 0xf6, 0xd0, 0xbc, 0x0f, 0x94, 0xf9, 0xb6, 0xc9, 0x8c, 0x0e, 0xe9,
+	0x1b, 0x2e, 0x50, 0x8a, 0x3b, 0x40, 0xb5, 0x6b, 0x42, 0x5a, 0xd4, 0x5d, 0x26, 0x41, 0xae, 0xbb,
+	0x98, 0xb0, 0x49, 0xfa, 0xdb, 0x11, 0xac, 0xb6, 0x50, 0xfe, 0x00, 0xc0, 0x38, 0xc4, 0x76, 0x1f,
+	0x7c, 0xa9, 0xf0, 0x72, 0xa2, 0xf0, 0x44, 0xbc, 0xb3, 0x70, 0xb9, 0xf2, 0x0e, 0x00, 0xf6, 0x04,
+	0x9c, 0x54, 0xde, 0xd7, 0x28, 0x27, 0x19, 0x39, 0xb6, 0x6b, 0x33, 0x6d, 0x56, 0x24, 0xb0, 0xc8,
+	0xc7, 0xf3, 0x38, 0x26, 0x37, 0x7a, 0x22, 0xb6, 0xc5, 0x91, 0x31, 0x25, 0xe8, 0x11, 0xb3, 0xab,
+	0xa9, 0x99, 0x4a, 0x02, 0xcb, 0x54, 0x6a, 0x70, 0x44, 0x0d, 0x50, 0xee, 0x10, 0x53, 0xd7, 0xc0,
+	0xa6, 0x09, 0x94, 0x1a, 0x3e, 0x71, 0x40, 0x9b, 0x7b, 0x8f, 0x41, 0xb5, 0x87, 0xa9, 0x5b, 0x13,
+	0x36, 0x4d, 0xe2, 0x40, 0x78, 0xec, 0xb8, 0x90, 0x7c, 0xec, 0x61, 0x8a, 0xaf, 0x36, 0x51, 0x12,
+	0x76, 0xa3, 0x8f, 0x1d, 0xdb, 0xc2, 0x8c, 0xf8, 0x54, 0xcb, 0x8b, 0xb4, 0x89, 0xbe, 0xce, 0x80,
+	0xe5, 0x6a, 0x8e, 0xe1, 0xa7, 0x09, 0xaa, 0xfe, 0xa6, 0xa0, 0x19, 0xf0, 0x7c, 0xe2, 0x38, 0x2e,
+	0x78, 0xcc, 0x38, 0x00, 0xd0, 0xe6, 0x45, 0x73, 0x2f, 0x55, 0xc2, 0x8d, 0x58, 0xe1, 0xbb, 0xbf,
+	0x12, 0xed, 0xfe, 0x4a, 0x9d, 0xd8, 0x5e, 0xb8, 0x45, 0xf9, 0x5c, 0x4f, 0x1b, 0x8e, 0x4e, 0xfa,
+	0xf3, 0x95, 0x5e, 0x7e, 0x8f, 0x0d, 0xcb, 0xc5, 0x68, 0x73, 0x7a, 0xa4, 0xf2, 0x10, 0x80, 0x2f,
+	0x12, 0xcb, 0xee, 0xdb, 0x16, 0x78, 0x56, 0x94, 0xa3, 0x85, 0xd1, 0x22, 0x49, 0x23, 0xf2, 0x22,
+	0x89, 0x91, 0x30, 0x41, 0xbf, 0x2a, 0x68, 0x31, 0xe1, 0x32, 0x1f, 0x30, 0x0d, 0xfc, 0x63, 0x83,
+	0x76, 0xb1, 0x0f, 0xda, 0xa2, 0xb8, 0x09, 0xb4, 0x3e, 0xf8, 0x26, 0x70, 0xfd, 0x2d, 0x82, 0x92,
+	0x17, 0xf3, 0x31, 0xa5, 0x1d, 0x31, 0x5a, 0x9c, 0xa0, 0xfe, 0x80, 0x16, 0xa2, 0x5b, 0x44, 0x0f,
+	0x98, 0x2d, 0x2f, 0x6e, 0xed, 0x5d, 0xa3, 0xe3, 0x6e, 0x14, 0xea, 0x52, 0xb6, 0xc0, 0xd8, 0x0c,
+	0xc9, 0x87, 0xac, 0xdd, 0x88, 0x14, 0xcd, 0x92, 0x9f, 0x14, 0xb4, 0xc8, 0xe7, 0x1c, 0x0d, 0x68,
+	0x0f, 0x3c, 0x9a, 0xda, 0xf7, 0x4b, 0xef, 0x72, 0x60, 0x2d, 0x72, 0xe0, 0xfa, 0x5b, 0x14, 0xc6,
+	0x3c, 0xe0, 0x1b, 0xad, 0x95, 0xb0, 0x92, 0xdb, 0xc1, 0xcf, 0x0a, 0xca, 0xbb, 0xb6, 0x67, 0x24,
+	0x5b, 0x99, 0x05, 0xbe, 0x47, 0x02, 0xa6, 0x15, 0x44, 0x32, 0x76, 0x3f, 0x38, 0x19, 0xc5, 0x2c,
+	0xb5, 0xd4, 0xe0, 0xb6, 0xbd, 0xf8, 0x5a, 0xd0, 0x0e, 0x51, 0x7e, 0x71, 0x12, 0xeb, 0x14, 0x7b,
+	0x96, 0x6d, 0x61, 0xd3, 0x06, 0xaa, 0x2d, 0x8f, 0x2e, 0x4e, 0x63, 0x90, 0xdc, 0x86, 0x7c, 0xc7,
+	0x8e, 0x10, 0xf5, 0x17, 0x05, 0xcd, 0x27, 0x2b, 0xdf, 0xec, 0x62, 0xde, 0xde, 0x62, 0xa9, 0x69,
+	0x2b, 0x22, 0x9a, 0xe5, 0x73, 0x67, 0x40, 0x3d, 0x34, 0x10, 0x8b, 0xf1, 0x76, 0x14, 0x5c, 0x3d,
+	0x53, 0x4e, 0x1e, 0xb7, 0x31, 0x41, 0xb2, 0x56, 0x7f, 0x44, 0x73, 0xa1, 0x6e, 0xda, 0x89, 0xd5,
+	0x0f, 0x74, 0xe2, 0xd3, 0xc8, 0x89, 0xd5, 0x0c, 0x31, 0x79, 0x34, 0x87, 0xb0, 0xec, 0xc0, 0x36,
+	0x52, 0xe5, 0x79, 0x8e, 0x8f, 0x8c, 0x0e, 0xa6, 0x5a, 0x51, 0xc4, 0xb4, 0x34, 0x1c, 0xe8, 0x2b,
+	0x67, 0x51, 0x79, 0x5d, 0x8f, 0x66, 0x3d, 0x3e, 0xda, 0xc4, 0xf4, 0xcb, 0x8b, 0xcf, 0xff, 0xd0,
+	0x27, 0xee, 0xfc, 0xa7, 0xa0, 0x99, 0xf4, 0x9c, 0x54, 0x1f, 0xa0, 0x95, 0xbd, 0x5a, 0x6b, 0xdb,
+	0xa8, 0xd5, 0xeb, 0x8d, 0x56, 0xcb, 0x68, 0x3e, 0xde, 0x6a, 0x18, 0x4f, 0x76, 0x5a, 0xbb, 0x8d,
+	0xfa, 0xa3, 0x87, 0x8f, 0x1a, 0x1b, 0xb9, 0x89, 0xc2, 0xe2, 0xc9, 0x69, 0x69, 0x2e, 0x6d, 0xd5,
+	0xe0, 0x87, 0xa8, 0x5f, 0xa0, 0xc5, 0x33, 0xa6, 0xb5, 0x9d, 0xef, 0x1e, 0xef, 0x34, 0x72, 0x4a,
+	0x41, 0x3b, 0x39, 0x2d, 0xe5, 0xd3, 0x56, 0x35, 0xef, 0x98, 0x78, 0xa0, 0x7e, 0x85, 0x96, 0xcf,
+	0x98, 0x35, 0xb6, 0x1a, 0xf5, 0xf6, 0xe3, 0x66, 0xad, 0xdd, 0xc8, 0x5d, 0x28, 0xac, 0x9c, 0x9c,
+	0x96, 0xb4, 0xb1, 0x03, 0x79, 0xb9, 0x11, 0x1f, 0x33, 0xee, 0xf0, 0xd2, 0x19, 0xf3, 0xcd, 0x27,
+	0xb5, 0xe6, 0xc6, 0xa3, 0xda, 0x4e, 0x6e, 0xb2, 0x50, 0x38, 0x39, 0x2d, 0x2d, 0xa4, 0x8d, 0x37,
+	0xa3, 0xfc, 0xae, 0xb7, 0x5e, 0xbc, 0x2e, 0x2a, 0x2f, 0x5f, 0x17, 0x95, 0x7f, 0x5f, 0x17, 0x95,
+	0xdf, 0xdf, 0x14, 0x27, 0x5e, 0xbe, 0x29, 0x4e, 0xfc, 0xfd, 0xa6, 0x38, 0xf1, 0xec, 0x81, 0xd4,
+	0x1c, 0x1e, 0xf1, 0x6d, 0xbc, 0xe6, 0x01, 0xab, 0x86, 0xb9, 0x5d, 0x93, 0x3e, 0x8a, 0x8e, 0x52,
+	0x5f, 0x48, 0xbc, 0x67, 0xf6, 0x2f, 0x89, 0xbe, 0xfe, 0xfc, 0xff, 0x01, 0x00, 0xc2, 0x29, 0x77,
+	0x44, 0x16, 0x0e, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -102,6 +491,272 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FeeWaiverMaxGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FeeWaiverMaxGas))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	{
+		size, err := m.MemberChamberRule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xea
+	{
+		size, err := m.GuardianChamberRule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xe2
+	if m.MaxCandidacies != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxCandidacies))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	{
+		size := m.MinElectionTurnout.Size()
+		i -= size
+		if _, err := m.MinElectionTurnout.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd2
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxSuspensionDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxSuspensionDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xca
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RecallPetitionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RecallPetitionPeriod):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintParams(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc2
+	{
+		size := m.DividendTreasuryShare.Size()
+		i -= size
+		if _, err := m.DividendTreasuryShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	if m.DividendEpoch != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DividendEpoch))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if len(m.EnrollmentFee) > 0 {
+		for iNdEx := len(m.EnrollmentFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EnrollmentFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if m.RestrictValidators {
+		i--
+		if m.RestrictValidators {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.WasmAccessRole != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.WasmAccessRole))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.FeeWaiverEpoch != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FeeWaiverEpoch))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.FeeWaiverLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FeeWaiverLimit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.FeeWaiverMsgTypes) > 0 {
+		for iNdEx := len(m.FeeWaiverMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FeeWaiverMsgTypes[iNdEx])
+			copy(dAtA[i:], m.FeeWaiverMsgTypes[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.FeeWaiverMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.RestrictGovMessages {
+		i--
+		if m.RestrictGovMessages {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.CountUnrevealedVotes {
+		i--
+		if m.CountUnrevealedVotes {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RevealPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RevealPeriod):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintParams(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x6a
+	if m.MaxDelegationDepth != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDelegationDepth))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.AllowSplitVotes {
+		i--
+		if m.AllowSplitVotes {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.TallyRules) > 0 {
+		for iNdEx := len(m.TallyRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TallyRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.BicameralMsgTypes) > 0 {
+		for iNdEx := len(m.BicameralMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BicameralMsgTypes[iNdEx])
+			copy(dAtA[i:], m.BicameralMsgTypes[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.BicameralMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.MaxConsecutiveTerms != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxConsecutiveTerms))
+		i--
+		dAtA[i] = 0x40
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.GuardianTermLength, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.GuardianTermLength):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintParams(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x3a
+	if m.ElectionMethod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ElectionMethod))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.GuardianSeats != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GuardianSeats))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ElectionDuration != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ElectionDuration))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ElectionPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ElectionPeriod))
+		i--
+		dAtA[i] = 0x18
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.AppealPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AppealPeriod):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintParams(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.RecallThreshold.Size()
+		i -= size
+		if _, err := m.RecallThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -122,6 +777,98 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = m.RecallThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AppealPeriod)
+	n += 1 + l + sovParams(uint64(l))
+	if m.ElectionPeriod != 0 {
+		n += 1 + sovParams(uint64(m.ElectionPeriod))
+	}
+	if m.ElectionDuration != 0 {
+		n += 1 + sovParams(uint64(m.ElectionDuration))
+	}
+	if m.GuardianSeats != 0 {
+		n += 1 + sovParams(uint64(m.GuardianSeats))
+	}
+	if m.ElectionMethod != 0 {
+		n += 1 + sovParams(uint64(m.ElectionMethod))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.GuardianTermLength)
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxConsecutiveTerms != 0 {
+		n += 1 + sovParams(uint64(m.MaxConsecutiveTerms))
+	}
+	if len(m.BicameralMsgTypes) > 0 {
+		for _, s := range m.BicameralMsgTypes {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.TallyRules) > 0 {
+		for _, e := range m.TallyRules {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.AllowSplitVotes {
+		n += 2
+	}
+	if m.MaxDelegationDepth != 0 {
+		n += 1 + sovParams(uint64(m.MaxDelegationDepth))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RevealPeriod)
+	n += 1 + l + sovParams(uint64(l))
+	if m.CountUnrevealedVotes {
+		n += 2
+	}
+	if m.RestrictGovMessages {
+		n += 2
+	}
+	if len(m.FeeWaiverMsgTypes) > 0 {
+		for _, s := range m.FeeWaiverMsgTypes {
+			l = len(s)
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.FeeWaiverLimit != 0 {
+		n += 2 + sovParams(uint64(m.FeeWaiverLimit))
+	}
+	if m.FeeWaiverEpoch != 0 {
+		n += 2 + sovParams(uint64(m.FeeWaiverEpoch))
+	}
+	if m.WasmAccessRole != 0 {
+		n += 2 + sovParams(uint64(m.WasmAccessRole))
+	}
+	if m.RestrictValidators {
+		n += 3
+	}
+	if len(m.EnrollmentFee) > 0 {
+		for _, e := range m.EnrollmentFee {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.DividendEpoch != 0 {
+		n += 2 + sovParams(uint64(m.DividendEpoch))
+	}
+	l = m.DividendTreasuryShare.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RecallPetitionPeriod)
+	n += 2 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxSuspensionDuration)
+	n += 2 + l + sovParams(uint64(l))
+	l = m.MinElectionTurnout.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.MaxCandidacies != 0 {
+		n += 2 + sovParams(uint64(m.MaxCandidacies))
+	}
+	l = m.GuardianChamberRule.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.MemberChamberRule.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.FeeWaiverMaxGas != 0 {
+		n += 2 + sovParams(uint64(m.FeeWaiverMaxGas))
+	}
 	return n
 }
 
@@ -160,6 +907,776 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecallThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RecallThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppealPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.AppealPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElectionPeriod", wireType)
+			}
+			m.ElectionPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElectionPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElectionDuration", wireType)
+			}
+			m.ElectionDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElectionDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianSeats", wireType)
+			}
+			m.GuardianSeats = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GuardianSeats |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElectionMethod", wireType)
+			}
+			m.ElectionMethod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElectionMethod |= ElectionMethod(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianTermLength", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.GuardianTermLength, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsecutiveTerms", wireType)
+			}
+			m.MaxConsecutiveTerms = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConsecutiveTerms |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BicameralMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BicameralMsgTypes = append(m.BicameralMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TallyRules = append(m.TallyRules, TallyRule{})
+			if err := m.TallyRules[len(m.TallyRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowSplitVotes", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowSplitVotes = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelegationDepth", wireType)
+			}
+			m.MaxDelegationDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDelegationDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RevealPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountUnrevealedVotes", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CountUnrevealedVotes = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestrictGovMessages", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestrictGovMessages = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeWaiverMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeWaiverMsgTypes = append(m.FeeWaiverMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeWaiverLimit", wireType)
+			}
+			m.FeeWaiverLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeWaiverLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeWaiverEpoch", wireType)
+			}
+			m.FeeWaiverEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeWaiverEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmAccessRole", wireType)
+			}
+			m.WasmAccessRole = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WasmAccessRole |= WasmAccessRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestrictValidators", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestrictValidators = bool(v != 0)
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnrollmentFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnrollmentFee = append(m.EnrollmentFee, types.Coin{})
+			if err := m.EnrollmentFee[len(m.EnrollmentFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DividendEpoch", wireType)
+			}
+			m.DividendEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DividendEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DividendTreasuryShare", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DividendTreasuryShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecallPetitionPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RecallPetitionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSuspensionDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxSuspensionDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinElectionTurnout", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinElectionTurnout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCandidacies", wireType)
+			}
+			m.MaxCandidacies = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCandidacies |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianChamberRule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GuardianChamberRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberChamberRule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MemberChamberRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeWaiverMaxGas", wireType)
+			}
+			m.FeeWaiverMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeWaiverMaxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	gov_v1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
@@ -12,6 +13,9 @@ const (
 	ProposalTypeAddGuardians            = "AddGuardians"
 	ProposalTypeRemoveGuardians         = "RemoveGuardians"
 	ProposalTypeUpdateTotalVotingWeight = "UpdateTotalVotingWeight"
+	ProposalTypeSetVotingWeightDecay    = "SetVotingWeightDecaySchedule"
+	ProposalTypePauseVotingWeightDecay  = "PauseVotingWeightDecay"
+	ProposalTypeUpdateGuardianWeights   = "UpdateGuardianWeights"
 )
 
 // Ensure all proposals implement govtypes.Content at compile time
@@ -19,12 +23,18 @@ var (
 	_ gov_v1beta1.Content = &AddGuardiansProposal{}
 	_ gov_v1beta1.Content = &RemoveGuardiansProposal{}
 	_ gov_v1beta1.Content = &UpdateTotalVotingWeightProposal{}
+	_ gov_v1beta1.Content = &SetVotingWeightDecayScheduleProposal{}
+	_ gov_v1beta1.Content = &PauseVotingWeightDecayProposal{}
+	_ gov_v1beta1.Content = &UpdateGuardianWeightsProposal{}
 )
 
 func init() {
 	gov_v1beta1.RegisterProposalType(ProposalTypeAddGuardians)
 	gov_v1beta1.RegisterProposalType(ProposalTypeRemoveGuardians)
 	gov_v1beta1.RegisterProposalType(ProposalTypeUpdateTotalVotingWeight)
+	gov_v1beta1.RegisterProposalType(ProposalTypeSetVotingWeightDecay)
+	gov_v1beta1.RegisterProposalType(ProposalTypePauseVotingWeightDecay)
+	gov_v1beta1.RegisterProposalType(ProposalTypeUpdateGuardianWeights)
 }
 
 ////////
@@ -194,3 +204,176 @@ func (p *UpdateTotalVotingWeightProposal) String() string {
 `, p.Title, p.Description, p.NewTotalVotingWeight))
 	return b.String()
 }
+
+////////
+// Set Voting Weight Decay Schedule Proposal
+////////
+
+// NewSetVotingWeightDecayScheduleProposal creates an empty proposal instance
+func NewSetVotingWeightDecayScheduleProposal(title string, description string, creator string, schedule *VotingWeightDecaySchedule) gov_v1beta1.Content {
+	return &SetVotingWeightDecayScheduleProposal{
+		Title:       title,
+		Description: description,
+		Creator:     creator,
+		Schedule:    schedule,
+	}
+}
+
+// GetTitle returns the title of a set voting weight decay schedule proposal.
+func (p *SetVotingWeightDecayScheduleProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a set voting weight decay schedule proposal.
+func (p *SetVotingWeightDecayScheduleProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute ensures this proposal will be handled by the Membership Module
+func (p *SetVotingWeightDecayScheduleProposal) ProposalRoute() string { return ModuleName }
+
+// ProposalType defines the type for a SetVotingWeightDecayScheduleProposal
+func (p *SetVotingWeightDecayScheduleProposal) ProposalType() string {
+	return ProposalTypeSetVotingWeightDecay
+}
+
+// ValidateBasic performs basic validation on the proposal
+func (p *SetVotingWeightDecayScheduleProposal) ValidateBasic() error {
+	if len(p.Creator) == 0 {
+		return fmt.Errorf("creator address cannot be empty")
+	}
+	// An empty schedule removes the current schedule
+	if p.Schedule != nil {
+		return p.Schedule.Validate()
+	}
+	return nil
+}
+
+// String describes the proposal
+func (p *SetVotingWeightDecayScheduleProposal) String() string {
+	var b strings.Builder
+
+	schedule := "none"
+	if p.Schedule != nil {
+		schedule = p.Schedule.String()
+	}
+
+	b.WriteString(fmt.Sprintf(`Set Voting Weight Decay Schedule Proposal:
+  Title:          %s
+  Description:    %s
+  Decay Schedule: %s
+`, p.Title, p.Description, schedule))
+	return b.String()
+}
+
+////////
+// Pause Voting Weight Decay Proposal
+////////
+
+// NewPauseVotingWeightDecayProposal creates an empty proposal instance
+func NewPauseVotingWeightDecayProposal(title string, description string, creator string, paused bool) gov_v1beta1.Content {
+	return &PauseVotingWeightDecayProposal{
+		Title:       title,
+		Description: description,
+		Creator:     creator,
+		Paused:      paused,
+	}
+}
+
+// GetTitle returns the title of a pause voting weight decay proposal.
+func (p *PauseVotingWeightDecayProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a pause voting weight decay proposal.
+func (p *PauseVotingWeightDecayProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute ensures this proposal will be handled by the Membership Module
+func (p *PauseVotingWeightDecayProposal) ProposalRoute() string { return ModuleName }
+
+// ProposalType defines the type for a PauseVotingWeightDecayProposal
+func (p *PauseVotingWeightDecayProposal) ProposalType() string {
+	return ProposalTypePauseVotingWeightDecay
+}
+
+// ValidateBasic performs basic validation on the proposal
+func (p *PauseVotingWeightDecayProposal) ValidateBasic() error {
+	if len(p.Creator) == 0 {
+		return fmt.Errorf("creator address cannot be empty")
+	}
+	return nil
+}
+
+// String describes the proposal
+func (p *PauseVotingWeightDecayProposal) String() string {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf(`Pause Voting Weight Decay Proposal:
+  Title:       %s
+  Description: %s
+  Paused:      %t
+`, p.Title, p.Description, p.Paused))
+	return b.String()
+}
+
+////////
+// Update Guardian Weights Proposal
+////////
+
+// NewUpdateGuardianWeightsProposal creates an empty proposal instance
+func NewUpdateGuardianWeightsProposal(title string, description string, creator string, guardianWeights []GuardianWeight) gov_v1beta1.Content {
+	return &UpdateGuardianWeightsProposal{
+		Title:           title,
+		Description:     description,
+		Creator:         creator,
+		GuardianWeights: guardianWeights,
+	}
+}
+
+// GetTitle returns the title of an update guardian weights proposal.
+func (p *UpdateGuardianWeightsProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an update guardian weights proposal.
+func (p *UpdateGuardianWeightsProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute ensures this proposal will be handled by the Membership Module
+func (p *UpdateGuardianWeightsProposal) ProposalRoute() string { return ModuleName }
+
+// ProposalType defines the type for an UpdateGuardianWeightsProposal
+func (p *UpdateGuardianWeightsProposal) ProposalType() string {
+	return ProposalTypeUpdateGuardianWeights
+}
+
+// ValidateBasic performs basic validation on the proposal
+func (p *UpdateGuardianWeightsProposal) ValidateBasic() error {
+	if len(p.Creator) == 0 {
+		return fmt.Errorf("creator address cannot be empty")
+	}
+	if len(p.GuardianWeights) == 0 {
+		return fmt.Errorf("no guardian weights to update")
+	}
+
+	seen := make(map[string]bool, len(p.GuardianWeights))
+	for _, gw := range p.GuardianWeights {
+		if _, err := sdk.AccAddressFromBech32(gw.Guardian); err != nil {
+			return fmt.Errorf("invalid guardian address: %s", gw.Guardian)
+		}
+		if seen[gw.Guardian] {
+			return fmt.Errorf("duplicate guardian weight: %s", gw.Guardian)
+		}
+		if gw.Weight == 0 {
+			return fmt.Errorf("guardian weight must be positive: %s", gw.Guardian)
+		}
+		seen[gw.Guardian] = true
+	}
+	return nil
+}
+
+// String describes the proposal
+func (p *UpdateGuardianWeightsProposal) String() string {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf(`Update Guardian Weights Proposal:
+  Title:       %s
+  Description: %s
+  Weights:
+`, p.Title, p.Description))
+	for _, gw := range p.GuardianWeights {
+		b.WriteString(fmt.Sprintf("    %s: %d\n", gw.Guardian, gw.Weight))
+	}
+	return b.String()
+}
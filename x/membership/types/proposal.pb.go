@@ -156,10 +156,145 @@ func (m *UpdateTotalVotingWeightProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateTotalVotingWeightProposal proto.InternalMessageInfo
 
+// SetVotingWeightDecayScheduleProposal replaces the total voting weight's decay schedule
+type SetVotingWeightDecayScheduleProposal struct {
+	// Title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// Description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Creator of this proposal
+	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	// New decay schedule, or none to remove the current schedule
+	Schedule *VotingWeightDecaySchedule `protobuf:"bytes,4,opt,name=schedule,proto3" json:"schedule,omitempty"`
+}
+
+func (m *SetVotingWeightDecayScheduleProposal) Reset()      { *m = SetVotingWeightDecayScheduleProposal{} }
+func (*SetVotingWeightDecayScheduleProposal) ProtoMessage() {}
+func (*SetVotingWeightDecayScheduleProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d31b03cbd2c6725, []int{3}
+}
+func (m *SetVotingWeightDecayScheduleProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetVotingWeightDecayScheduleProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetVotingWeightDecayScheduleProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetVotingWeightDecayScheduleProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetVotingWeightDecayScheduleProposal.Merge(m, src)
+}
+func (m *SetVotingWeightDecayScheduleProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetVotingWeightDecayScheduleProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetVotingWeightDecayScheduleProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetVotingWeightDecayScheduleProposal proto.InternalMessageInfo
+
+// PauseVotingWeightDecayProposal pauses or resumes the total voting weight's decay schedule
+type PauseVotingWeightDecayProposal struct {
+	// Title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// Description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Creator of this proposal
+	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Paused is true to pause the schedule, or false to resume it
+	Paused bool `protobuf:"varint,4,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (m *PauseVotingWeightDecayProposal) Reset()      { *m = PauseVotingWeightDecayProposal{} }
+func (*PauseVotingWeightDecayProposal) ProtoMessage() {}
+func (*PauseVotingWeightDecayProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d31b03cbd2c6725, []int{4}
+}
+func (m *PauseVotingWeightDecayProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseVotingWeightDecayProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseVotingWeightDecayProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PauseVotingWeightDecayProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseVotingWeightDecayProposal.Merge(m, src)
+}
+func (m *PauseVotingWeightDecayProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseVotingWeightDecayProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseVotingWeightDecayProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseVotingWeightDecayProposal proto.InternalMessageInfo
+
+// UpdateGuardianWeightsProposal sets the relative weights of guardians
+type UpdateGuardianWeightsProposal struct {
+	// Title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// Description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Creator of this proposal
+	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	// New weights of the guardians
+	GuardianWeights []GuardianWeight `protobuf:"bytes,4,rep,name=guardian_weights,json=guardianWeights,proto3" json:"guardian_weights,omitempty"`
+}
+
+func (m *UpdateGuardianWeightsProposal) Reset()      { *m = UpdateGuardianWeightsProposal{} }
+func (*UpdateGuardianWeightsProposal) ProtoMessage() {}
+func (*UpdateGuardianWeightsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d31b03cbd2c6725, []int{5}
+}
+func (m *UpdateGuardianWeightsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateGuardianWeightsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateGuardianWeightsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateGuardianWeightsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateGuardianWeightsProposal.Merge(m, src)
+}
+func (m *UpdateGuardianWeightsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateGuardianWeightsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateGuardianWeightsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateGuardianWeightsProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*AddGuardiansProposal)(nil), "membershipmodule.membership.AddGuardiansProposal")
 	proto.RegisterType((*RemoveGuardiansProposal)(nil), "membershipmodule.membership.RemoveGuardiansProposal")
 	proto.RegisterType((*UpdateTotalVotingWeightProposal)(nil), "membershipmodule.membership.UpdateTotalVotingWeightProposal")
+	proto.RegisterType((*SetVotingWeightDecayScheduleProposal)(nil), "membershipmodule.membership.SetVotingWeightDecayScheduleProposal")
+	proto.RegisterType((*PauseVotingWeightDecayProposal)(nil), "membershipmodule.membership.PauseVotingWeightDecayProposal")
+	proto.RegisterType((*UpdateGuardianWeightsProposal)(nil), "membershipmodule.membership.UpdateGuardianWeightsProposal")
 }
 
 func init() {
@@ -167,34 +302,44 @@ func init() {
 }
 
 var fileDescriptor_5d31b03cbd2c6725 = []byte{
-	// 431 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0xbf, 0x6f, 0x13, 0x31,
-	0x14, 0xc7, 0xcf, 0x6d, 0xf9, 0x51, 0x83, 0x10, 0x1c, 0x91, 0x7a, 0x2a, 0xe2, 0x2e, 0x44, 0x02,
-	0x45, 0x88, 0xe4, 0x06, 0x26, 0xd8, 0x1a, 0x21, 0xb1, 0x30, 0xa0, 0xb4, 0x05, 0x89, 0xe5, 0x74,
-	0x39, 0x3f, 0x5d, 0x2c, 0xe2, 0x7b, 0x96, 0xfd, 0xd2, 0xd0, 0xff, 0x00, 0x75, 0x62, 0x64, 0xec,
-	0x9f, 0xc2, 0x98, 0x81, 0xa1, 0x23, 0x62, 0x38, 0xa1, 0x64, 0xeb, 0x5f, 0x81, 0xea, 0xa4, 0xc4,
-	0x14, 0x18, 0xe9, 0x74, 0xe7, 0xf7, 0x7d, 0xf6, 0xf7, 0xfb, 0xb1, 0xfc, 0xf8, 0x63, 0x05, 0x6a,
-	0x00, 0xc6, 0x0e, 0xa5, 0x56, 0x28, 0xc6, 0x23, 0x48, 0x57, 0x85, 0x54, 0x1b, 0xd4, 0x68, 0xf3,
-	0x51, 0x57, 0x1b, 0x24, 0x0c, 0xef, 0x5d, 0xec, 0xed, 0xae, 0x0a, 0xdb, 0x8d, 0x12, 0x4b, 0x74,
-	0x7d, 0xe9, 0xd9, 0xdf, 0x62, 0x4b, 0xeb, 0x0b, 0xe3, 0x8d, 0x1d, 0x21, 0x5e, 0x8e, 0x73, 0x23,
-	0x64, 0x5e, 0xd9, 0xd7, 0xcb, 0x13, 0xc3, 0x06, 0xbf, 0x42, 0x92, 0x46, 0x10, 0xb1, 0x26, 0x6b,
-	0x6f, 0xf6, 0x17, 0x8b, 0xb0, 0xc9, 0x6f, 0x08, 0xb0, 0x85, 0x91, 0x9a, 0x24, 0x56, 0xd1, 0x9a,
-	0xd3, 0xfc, 0x52, 0x18, 0xf1, 0x6b, 0x85, 0x81, 0x9c, 0xd0, 0x44, 0xeb, 0x4e, 0x3d, 0x5f, 0x86,
-	0xaf, 0xf8, 0xed, 0xf2, 0xdc, 0x26, 0x23, 0xcc, 0x72, 0x21, 0xa2, 0x8d, 0xe6, 0x7a, 0x7b, 0xb3,
-	0xd7, 0x9a, 0xd6, 0x09, 0x3b, 0xad, 0x93, 0xed, 0x8b, 0xfa, 0x13, 0x54, 0x92, 0x40, 0x69, 0x3a,
-	0xec, 0xdf, 0xfa, 0xa5, 0xed, 0xe1, 0x8e, 0x10, 0xcf, 0xaf, 0x7f, 0x3c, 0x4e, 0x82, 0xcf, 0xc7,
-	0x49, 0xd0, 0xfa, 0xca, 0xf8, 0x56, 0x1f, 0x14, 0x1e, 0xc0, 0x65, 0x50, 0xec, 0xf3, 0xbb, 0xbf,
-	0xa5, 0x34, 0xce, 0x79, 0x09, 0xf2, 0x70, 0x09, 0x72, 0xff, 0x2f, 0x2d, 0x1e, 0xcb, 0x1d, 0x8f,
-	0x65, 0x91, 0xdc, 0xc3, 0x39, 0x5a, 0xe3, 0xc9, 0xbe, 0x16, 0x39, 0xc1, 0x1e, 0x52, 0x3e, 0x7a,
-	0x83, 0x24, 0xab, 0xf2, 0x2d, 0xc8, 0x72, 0x48, 0xff, 0x11, 0xeb, 0x88, 0xf1, 0xad, 0x0a, 0x26,
-	0x19, 0x9d, 0x79, 0x66, 0x07, 0xce, 0x34, 0x9b, 0x38, 0xd7, 0x68, 0xa3, 0xc9, 0xda, 0x37, 0x7b,
-	0xbb, 0xd3, 0x3a, 0x09, 0xbe, 0xd7, 0xc9, 0xa3, 0x52, 0xd2, 0x70, 0x3c, 0xe8, 0x16, 0xa8, 0xd2,
-	0x02, 0xad, 0x42, 0xbb, 0xfc, 0x74, 0xac, 0x78, 0x9f, 0xd2, 0xa1, 0x06, 0xdb, 0x7d, 0x01, 0xc5,
-	0x69, 0x9d, 0x3c, 0xf8, 0xc7, 0x81, 0xde, 0x4d, 0x34, 0x2a, 0x98, 0xfc, 0x81, 0xb9, 0xba, 0x8c,
-	0xde, 0xee, 0x74, 0x16, 0xb3, 0x93, 0x59, 0xcc, 0x7e, 0xcc, 0x62, 0xf6, 0x69, 0x1e, 0x07, 0x27,
-	0xf3, 0x38, 0xf8, 0x36, 0x8f, 0x83, 0x77, 0xcf, 0xbc, 0x18, 0x15, 0x1a, 0x99, 0x77, 0x2a, 0xa0,
-	0x74, 0xf1, 0xec, 0x3b, 0xde, 0x88, 0x7c, 0xf0, 0xe7, 0xc5, 0xa5, 0x1b, 0x5c, 0x75, 0x4f, 0xff,
-	0xe9, 0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa4, 0x14, 0x18, 0xd1, 0x5b, 0x03, 0x00, 0x00,
+	// 578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x7d, 0x6d, 0x29, 0xed, 0x15, 0x41, 0x31, 0x11, 0xb5, 0x8a, 0x6a, 0x87, 0x08, 0x50,
+	0x04, 0x8d, 0x2d, 0x05, 0x09, 0x09, 0xb6, 0x46, 0x95, 0x58, 0x18, 0xaa, 0xa4, 0x05, 0x89, 0xc5,
+	0x72, 0x7c, 0x4f, 0x8e, 0x21, 0xf6, 0x59, 0x77, 0x97, 0x86, 0xf0, 0x09, 0x50, 0x27, 0x46, 0xc6,
+	0xce, 0x7c, 0x0a, 0xc6, 0x0c, 0x0c, 0x1d, 0x11, 0x83, 0x85, 0x92, 0x2d, 0x03, 0x2b, 0x2b, 0xca,
+	0xd9, 0x69, 0x2e, 0x2d, 0xcd, 0x16, 0xa6, 0xe4, 0xde, 0x7b, 0xf7, 0xde, 0xff, 0xf7, 0x7c, 0xef,
+	0xe1, 0xc7, 0x11, 0x44, 0x4d, 0x60, 0xbc, 0x15, 0x26, 0x11, 0x25, 0x9d, 0x36, 0x38, 0x53, 0x83,
+	0x93, 0x30, 0x9a, 0x50, 0xee, 0xb5, 0xed, 0x84, 0x51, 0x41, 0xf5, 0x7b, 0x17, 0x63, 0xed, 0xa9,
+	0x61, 0xbb, 0x10, 0xd0, 0x80, 0xca, 0x38, 0x67, 0xfc, 0x2f, 0xbb, 0xb2, 0x5d, 0x9d, 0x97, 0x9e,
+	0x84, 0x0c, 0x7c, 0xe1, 0x12, 0x88, 0xa8, 0xcf, 0x3c, 0xbf, 0x97, 0xdd, 0x29, 0x7d, 0x43, 0xb8,
+	0xb0, 0x47, 0xc8, 0xcb, 0x8e, 0xc7, 0x48, 0xe8, 0xc5, 0xfc, 0x20, 0x57, 0xa1, 0x17, 0xf0, 0x35,
+	0x11, 0x8a, 0x36, 0x18, 0xa8, 0x88, 0xca, 0xeb, 0xf5, 0xec, 0xa0, 0x17, 0xf1, 0x06, 0x01, 0xee,
+	0xb3, 0x30, 0x11, 0x21, 0x8d, 0x8d, 0x25, 0xe9, 0x53, 0x4d, 0xba, 0x81, 0xaf, 0xfb, 0x0c, 0x3c,
+	0x41, 0x99, 0xb1, 0x2c, 0xbd, 0x93, 0xa3, 0xfe, 0x0a, 0x6f, 0x06, 0x93, 0x32, 0xae, 0xa0, 0xae,
+	0x47, 0x88, 0xb1, 0x52, 0x5c, 0x2e, 0xaf, 0xd7, 0x4a, 0xfd, 0xd4, 0x42, 0xa3, 0xd4, 0xda, 0xbe,
+	0xe8, 0xdf, 0xa5, 0x51, 0x28, 0x20, 0x4a, 0x44, 0xaf, 0x7e, 0xf3, 0xdc, 0x77, 0x48, 0xf7, 0x08,
+	0x79, 0xb1, 0xf6, 0xe9, 0xd4, 0xd2, 0xbe, 0x9c, 0x5a, 0x5a, 0xe9, 0x3b, 0xc2, 0x5b, 0x75, 0x88,
+	0xe8, 0x31, 0xfc, 0x0f, 0x8a, 0x23, 0x7c, 0x67, 0x46, 0x25, 0x93, 0x95, 0x73, 0x90, 0x87, 0x39,
+	0xc8, 0xce, 0x3f, 0x42, 0x14, 0x96, 0xdb, 0x0a, 0x4b, 0xa6, 0x5c, 0xc1, 0x39, 0x59, 0xc2, 0xd6,
+	0x51, 0x42, 0x3c, 0x01, 0x87, 0x54, 0x78, 0xed, 0xd7, 0x54, 0x84, 0x71, 0xf0, 0x06, 0xc2, 0xa0,
+	0x25, 0x16, 0x88, 0x75, 0x82, 0xf0, 0x56, 0x0c, 0x5d, 0x57, 0x8c, 0x6b, 0xba, 0xc7, 0xb2, 0xa8,
+	0xdb, 0x95, 0x55, 0x8d, 0x95, 0x22, 0x2a, 0xdf, 0xa8, 0x35, 0xfa, 0xa9, 0xa5, 0xfd, 0x4c, 0xad,
+	0x47, 0x41, 0x28, 0x5a, 0x9d, 0xa6, 0xed, 0xd3, 0xc8, 0xf1, 0x29, 0x8f, 0x28, 0xcf, 0x7f, 0x2a,
+	0x9c, 0xbc, 0x77, 0x44, 0x2f, 0x01, 0x6e, 0xef, 0x83, 0x3f, 0x4a, 0xad, 0xfb, 0x57, 0x24, 0x54,
+	0x3a, 0x51, 0x88, 0xa1, 0x7b, 0x09, 0x53, 0x69, 0xc6, 0x6f, 0x84, 0x1f, 0x34, 0x40, 0xa8, 0xde,
+	0x7d, 0xf0, 0xbd, 0x5e, 0xc3, 0x6f, 0xc1, 0xf8, 0x85, 0x2f, 0xb0, 0x23, 0xef, 0xf0, 0x1a, 0xcf,
+	0xab, 0xc8, 0x0e, 0x6c, 0x54, 0x9f, 0xd9, 0x73, 0x66, 0xd2, 0xbe, 0x52, 0x63, 0xed, 0xee, 0x28,
+	0xb5, 0xf4, 0x49, 0x2e, 0x05, 0xfe, 0x3c, 0xbf, 0x02, 0xfc, 0x15, 0x61, 0xf3, 0xc0, 0xeb, 0x70,
+	0xb8, 0x94, 0x6e, 0x81, 0xa8, 0xbb, 0x78, 0x35, 0x19, 0xd7, 0x24, 0x12, 0x74, 0xad, 0x56, 0x18,
+	0xa5, 0xd6, 0x66, 0x66, 0x51, 0xe4, 0xe6, 0x31, 0x8a, 0xd8, 0x3f, 0x08, 0xef, 0x64, 0x4f, 0x75,
+	0x32, 0x79, 0x99, 0xde, 0x45, 0xce, 0xdf, 0xc7, 0xe9, 0x16, 0xc9, 0x9f, 0x13, 0x97, 0xc3, 0xb7,
+	0x51, 0x7d, 0x32, 0xf7, 0xf3, 0xcc, 0x2a, 0x94, 0x2b, 0x47, 0x53, 0x57, 0xce, 0x24, 0x99, 0x02,
+	0x7c, 0x2b, 0x98, 0xa5, 0x9a, 0x92, 0xd7, 0x1a, 0xfd, 0x81, 0x89, 0xce, 0x06, 0x26, 0xfa, 0x35,
+	0x30, 0xd1, 0xe7, 0xa1, 0xa9, 0x9d, 0x0d, 0x4d, 0xed, 0xc7, 0xd0, 0xd4, 0xde, 0x3e, 0x57, 0xc6,
+	0x23, 0xa6, 0x2c, 0xf4, 0x2a, 0x31, 0x08, 0x27, 0xd3, 0x53, 0x51, 0xf6, 0xf1, 0x07, 0x75, 0x39,
+	0xcb, 0xa9, 0x69, 0xae, 0xca, 0x95, 0xfc, 0xf4, 0xef, 0x00, 0xcb, 0x05, 0x1e, 0xf5, 0x27, 0x06,
+	0x00, 0x00,
 }
 
 func (m *AddGuardiansProposal) Marshal() (dAtA []byte, err error) {
@@ -357,6 +502,174 @@ func (m *UpdateTotalVotingWeightProposal) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *SetVotingWeightDecayScheduleProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetVotingWeightDecayScheduleProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetVotingWeightDecayScheduleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Schedule != nil {
+		{
+			size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProposal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseVotingWeightDecayProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseVotingWeightDecayProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseVotingWeightDecayProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateGuardianWeightsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateGuardianWeightsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateGuardianWeightsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GuardianWeights) > 0 {
+		for iNdEx := len(m.GuardianWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GuardianWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
 	offset -= sovProposal(v)
 	base := offset
@@ -419,39 +732,471 @@ func (m *RemoveGuardiansProposal) Size() (n int) {
 			n += 1 + l + sovProposal(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *UpdateTotalVotingWeightProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = m.NewTotalVotingWeight.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	return n
+}
+
+func (m *SetVotingWeightDecayScheduleProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.Schedule != nil {
+		l = m.Schedule.Size()
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *PauseVotingWeightDecayProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *UpdateGuardianWeightsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.GuardianWeights) > 0 {
+		for _, e := range m.GuardianWeights {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovProposal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProposal(x uint64) (n int) {
+	return sovProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AddGuardiansProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddGuardiansProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddGuardiansProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardiansToAdd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GuardiansToAdd = append(m.GuardiansToAdd, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveGuardiansProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveGuardiansProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveGuardiansProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardiansToRemove", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GuardiansToRemove = append(m.GuardiansToRemove, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *UpdateTotalVotingWeightProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.NewTotalVotingWeight.Size()
-	n += 1 + l + sovProposal(uint64(l))
-	return n
-}
-
-func sovProposal(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozProposal(x uint64) (n int) {
-	return sovProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *AddGuardiansProposal) Unmarshal(dAtA []byte) error {
+func (m *UpdateTotalVotingWeightProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -474,10 +1219,10 @@ func (m *AddGuardiansProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddGuardiansProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateTotalVotingWeightProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddGuardiansProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateTotalVotingWeightProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -578,9 +1323,9 @@ func (m *AddGuardiansProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GuardiansToAdd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewTotalVotingWeight", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -590,23 +1335,24 @@ func (m *AddGuardiansProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProposal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GuardiansToAdd = append(m.GuardiansToAdd, string(dAtA[iNdEx:postIndex]))
+			if err := m.NewTotalVotingWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -629,7 +1375,7 @@ func (m *AddGuardiansProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveGuardiansProposal) Unmarshal(dAtA []byte) error {
+func (m *SetVotingWeightDecayScheduleProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -652,10 +1398,10 @@ func (m *RemoveGuardiansProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveGuardiansProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetVotingWeightDecayScheduleProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveGuardiansProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetVotingWeightDecayScheduleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -756,7 +1502,93 @@ func (m *RemoveGuardiansProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GuardiansToRemove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schedule == nil {
+				m.Schedule = &VotingWeightDecaySchedule{}
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseVotingWeightDecayProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseVotingWeightDecayProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseVotingWeightDecayProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -784,8 +1616,92 @@ func (m *RemoveGuardiansProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GuardiansToRemove = append(m.GuardiansToRemove, string(dAtA[iNdEx:postIndex]))
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -807,7 +1723,7 @@ func (m *RemoveGuardiansProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateTotalVotingWeightProposal) Unmarshal(dAtA []byte) error {
+func (m *UpdateGuardianWeightsProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -830,10 +1746,10 @@ func (m *UpdateTotalVotingWeightProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateTotalVotingWeightProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateGuardianWeightsProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateTotalVotingWeightProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateGuardianWeightsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -934,9 +1850,9 @@ func (m *UpdateTotalVotingWeightProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewTotalVotingWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianWeights", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -946,22 +1862,23 @@ func (m *UpdateTotalVotingWeightProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProposal
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NewTotalVotingWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GuardianWeights = append(m.GuardianWeights, GuardianWeight{})
+			if err := m.GuardianWeights[len(m.GuardianWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
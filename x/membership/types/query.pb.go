@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -164,6 +165,8 @@ func (m *QueryMemberRequest) GetAddress() string {
 type QueryMemberResponse struct {
 	// member contains the member details.
 	Member *Member `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	// invitation is the hash of the invitation that admitted the member, if any.
+	Invitation string `protobuf:"bytes,2,opt,name=invitation,proto3" json:"invitation,omitempty"`
 }
 
 func (m *QueryMemberResponse) Reset()         { *m = QueryMemberResponse{} }
@@ -206,6 +209,13 @@ func (m *QueryMemberResponse) GetMember() *Member {
 	return nil
 }
 
+func (m *QueryMemberResponse) GetInvitation() string {
+	if m != nil {
+		return m.Invitation
+	}
+	return ""
+}
+
 // QueryMembersRequest is request type for the Query/Members RPC method.
 type QueryMembersRequest struct {
 	// pagination defines an optional pagination for the request.
@@ -390,661 +400,9900 @@ func (m *QueryGuardiansResponse) GetMembers() []*Member {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "membershipmodule.membership.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "membershipmodule.membership.QueryParamsResponse")
-	proto.RegisterType((*QueryMemberRequest)(nil), "membershipmodule.membership.QueryMemberRequest")
-	proto.RegisterType((*QueryMemberResponse)(nil), "membershipmodule.membership.QueryMemberResponse")
-	proto.RegisterType((*QueryMembersRequest)(nil), "membershipmodule.membership.QueryMembersRequest")
-	proto.RegisterType((*QueryMembersResponse)(nil), "membershipmodule.membership.QueryMembersResponse")
-	proto.RegisterType((*QueryGuardiansRequest)(nil), "membershipmodule.membership.QueryGuardiansRequest")
-	proto.RegisterType((*QueryGuardiansResponse)(nil), "membershipmodule.membership.QueryGuardiansResponse")
+// QueryInvitationRequest specifies the invitation to query.
+type QueryInvitationRequest struct {
+	// hash is the hex-encoded sha256 hash of the invitation secret followed by
+	// the invitee's address.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("membershipmodule/membership/query.proto", fileDescriptor_1119d302a600ad18)
+func (m *QueryInvitationRequest) Reset()         { *m = QueryInvitationRequest{} }
+func (m *QueryInvitationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInvitationRequest) ProtoMessage()    {}
+func (*QueryInvitationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{8}
 }
-
-var fileDescriptor_1119d302a600ad18 = []byte{
-	// 616 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x4f, 0x6b, 0x13, 0x41,
-	0x18, 0xc6, 0x33, 0xd5, 0x26, 0x74, 0xf4, 0xe2, 0x34, 0x6a, 0x88, 0xb2, 0x29, 0x2b, 0xb4, 0x45,
-	0xc8, 0x4c, 0x93, 0x80, 0x5a, 0xbd, 0x68, 0xfc, 0xd3, 0x93, 0x50, 0x57, 0x50, 0x11, 0xa4, 0x4c,
-	0x92, 0x61, 0xb3, 0x98, 0xdd, 0xd9, 0xee, 0xcc, 0x56, 0x8b, 0x78, 0xf1, 0x13, 0x08, 0x7e, 0x04,
-	0x05, 0x4f, 0xe2, 0xd9, 0x6f, 0xd0, 0x63, 0x41, 0x0f, 0xe2, 0x21, 0x48, 0xe2, 0xc9, 0x4f, 0x21,
-	0x99, 0x99, 0xfc, 0xd9, 0x54, 0xd2, 0x0d, 0xf4, 0xd4, 0xe9, 0xbb, 0xf3, 0x3c, 0xf3, 0x9b, 0xf7,
-	0x9d, 0x87, 0xc0, 0x35, 0x9f, 0xf9, 0x0d, 0x16, 0x89, 0xb6, 0x17, 0xfa, 0xbc, 0x15, 0x77, 0x18,
-	0x19, 0x17, 0xc8, 0x6e, 0xcc, 0xa2, 0x7d, 0x1c, 0x46, 0x5c, 0x72, 0x74, 0x69, 0x7a, 0x23, 0x1e,
-	0x17, 0x8a, 0x57, 0x9b, 0x5c, 0xf8, 0x5c, 0x90, 0x06, 0x15, 0x4c, 0xab, 0xc8, 0x5e, 0xa5, 0xc1,
-	0x24, 0xad, 0x90, 0x90, 0xba, 0x5e, 0x40, 0xa5, 0xc7, 0x03, 0x6d, 0x54, 0xcc, 0xbb, 0xdc, 0xe5,
-	0x6a, 0x49, 0x06, 0x2b, 0x53, 0xbd, 0xec, 0x72, 0xee, 0x76, 0x18, 0xa1, 0xa1, 0x47, 0x68, 0x10,
-	0x70, 0xa9, 0x24, 0xc2, 0x7c, 0x5d, 0x9f, 0x45, 0xa9, 0x97, 0x69, 0x76, 0x86, 0x34, 0xa2, 0xbe,
-	0xf1, 0xb4, 0xf3, 0x10, 0x3d, 0x1a, 0x90, 0x6e, 0xab, 0xa2, 0xc3, 0x76, 0x63, 0x26, 0xa4, 0xfd,
-	0x0c, 0x2e, 0x27, 0xaa, 0x22, 0xe4, 0x81, 0x60, 0xe8, 0x0e, 0xcc, 0x6a, 0x71, 0x01, 0xac, 0x80,
-	0xf5, 0x33, 0xd5, 0x2b, 0x78, 0x46, 0x3b, 0xb0, 0x16, 0xd7, 0x4f, 0x1f, 0x74, 0x4b, 0x19, 0xc7,
-	0x08, 0x6d, 0x6c, 0xce, 0x7b, 0xa8, 0xf6, 0x99, 0xf3, 0x50, 0x01, 0xe6, 0x68, 0xab, 0x15, 0x31,
-	0xa1, 0x9d, 0x97, 0x9c, 0xe1, 0xbf, 0xb6, 0x63, 0x48, 0x86, 0xfb, 0x0d, 0xc9, 0x2d, 0x98, 0xd5,
-	0x27, 0xa5, 0x22, 0x31, 0x62, 0x23, 0xb1, 0x5f, 0x24, 0x3c, 0x87, 0x97, 0x46, 0x0f, 0x20, 0x1c,
-	0x8f, 0xc9, 0xf8, 0xae, 0x62, 0x3d, 0x53, 0x3c, 0x98, 0x29, 0xd6, 0x2f, 0xc1, 0xcc, 0x14, 0x6f,
-	0x53, 0x97, 0x19, 0xad, 0x33, 0xa1, 0xb4, 0x3f, 0x01, 0x98, 0x4f, 0xfa, 0x1b, 0xe8, 0xbb, 0x30,
-	0x67, 0xa0, 0x0a, 0x60, 0xe5, 0x54, 0x4a, 0x6a, 0xd5, 0x3f, 0xe0, 0x0c, 0x95, 0x68, 0x2b, 0x41,
-	0xb9, 0xa0, 0x28, 0xd7, 0x8e, 0xa5, 0xd4, 0x04, 0x09, 0xcc, 0x8b, 0xf0, 0xbc, 0xa2, 0xdc, 0x8a,
-	0x69, 0xd4, 0xf2, 0x68, 0x30, 0x1a, 0xfe, 0x0f, 0x00, 0x2f, 0x4c, 0x7f, 0x39, 0xc9, 0x1b, 0xc4,
-	0x70, 0x59, 0x72, 0x49, 0x3b, 0x3b, 0x7b, 0x5c, 0x7a, 0x81, 0xbb, 0xf3, 0x8a, 0x79, 0x6e, 0x5b,
-	0xaa, 0xab, 0x9c, 0xad, 0xdf, 0x1f, 0xec, 0xfd, 0xd5, 0x2d, 0xad, 0xba, 0x9e, 0x6c, 0xc7, 0x0d,
-	0xdc, 0xe4, 0x3e, 0x31, 0xb1, 0xd2, 0x7f, 0xca, 0xa2, 0xf5, 0x92, 0xc8, 0xfd, 0x90, 0x09, 0x7c,
-	0x8f, 0x35, 0xff, 0x76, 0x4b, 0xff, 0x33, 0x73, 0xce, 0xa9, 0xe2, 0x13, 0x55, 0x7b, 0xaa, 0x4a,
-	0xd5, 0x6f, 0x8b, 0x70, 0x51, 0x5d, 0x0b, 0x7d, 0x04, 0x30, 0xab, 0x1f, 0x27, 0x22, 0x33, 0xf9,
-	0x8f, 0x26, 0xa3, 0xb8, 0x91, 0x5e, 0xa0, 0x7b, 0x66, 0x5f, 0x7b, 0xf7, 0xfd, 0xcf, 0x87, 0x85,
-	0x0d, 0x84, 0x49, 0xc0, 0x23, 0x8f, 0x96, 0x03, 0x26, 0x89, 0x56, 0x96, 0x8f, 0xc4, 0x77, 0x22,
-	0x9f, 0xe8, 0x0b, 0x80, 0x59, 0xdd, 0xc0, 0x34, 0x94, 0x89, 0x3c, 0xa5, 0xa1, 0x4c, 0x06, 0xca,
-	0xbe, 0xad, 0x28, 0x6f, 0xa2, 0x1b, 0x69, 0x29, 0xf5, 0x92, 0xbc, 0x31, 0x41, 0x7d, 0x8b, 0x3e,
-	0x03, 0x98, 0x33, 0x2f, 0x1e, 0xa5, 0x3e, 0x7f, 0xd4, 0xd7, 0xca, 0x1c, 0x0a, 0x83, 0x7c, 0x5d,
-	0x21, 0x57, 0x10, 0x99, 0x0f, 0x59, 0xa0, 0xaf, 0x00, 0x2e, 0x8d, 0xde, 0x36, 0xaa, 0x1e, 0x7f,
-	0xf2, 0x74, 0x44, 0x8a, 0xb5, 0xb9, 0x34, 0x86, 0x77, 0x53, 0xf1, 0xd6, 0x50, 0x25, 0x2d, 0xaf,
-	0x3b, 0xb4, 0xa8, 0x3f, 0x3e, 0xe8, 0x59, 0xe0, 0xb0, 0x67, 0x81, 0xdf, 0x3d, 0x0b, 0xbc, 0xef,
-	0x5b, 0x99, 0xc3, 0xbe, 0x95, 0xf9, 0xd9, 0xb7, 0x32, 0xcf, 0x37, 0x27, 0x72, 0x32, 0xcb, 0xf6,
-	0xf5, 0xa4, 0xb1, 0x8a, 0x4f, 0x23, 0xab, 0x7e, 0x01, 0x6a, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0x0a, 0xef, 0x31, 0xca, 0xfd, 0x06, 0x00, 0x00,
+func (m *QueryInvitationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a Member using their wallet address
-	Member(ctx context.Context, in *QueryMemberRequest, opts ...grpc.CallOption) (*QueryMemberResponse, error)
-	// Queries a list of Members items.
-	Members(ctx context.Context, in *QueryMembersRequest, opts ...grpc.CallOption) (*QueryMembersResponse, error)
-	// Queries a list of Guardians items.
-	Guardians(ctx context.Context, in *QueryGuardiansRequest, opts ...grpc.CallOption) (*QueryGuardiansResponse, error)
+func (m *QueryInvitationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInvitationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryInvitationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInvitationRequest.Merge(m, src)
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryInvitationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInvitationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInvitationRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/membershipmodule.membership.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryInvitationRequest proto.InternalMessageInfo
+
+func (m *QueryInvitationRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Member(ctx context.Context, in *QueryMemberRequest, opts ...grpc.CallOption) (*QueryMemberResponse, error) {
-	out := new(QueryMemberResponse)
-	err := c.cc.Invoke(ctx, "/membershipmodule.membership.Query/Member", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryInvitationResponse contains the invitation details.
+type QueryInvitationResponse struct {
+	// invitation contains the invitation details.
+	Invitation *Invitation `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
 }
 
-func (c *queryClient) Members(ctx context.Context, in *QueryMembersRequest, opts ...grpc.CallOption) (*QueryMembersResponse, error) {
-	out := new(QueryMembersResponse)
-	err := c.cc.Invoke(ctx, "/membershipmodule.membership.Query/Members", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryInvitationResponse) Reset()         { *m = QueryInvitationResponse{} }
+func (m *QueryInvitationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInvitationResponse) ProtoMessage()    {}
+func (*QueryInvitationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{9}
+}
+func (m *QueryInvitationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInvitationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInvitationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryInvitationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInvitationResponse.Merge(m, src)
+}
+func (m *QueryInvitationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInvitationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInvitationResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Guardians(ctx context.Context, in *QueryGuardiansRequest, opts ...grpc.CallOption) (*QueryGuardiansResponse, error) {
-	out := new(QueryGuardiansResponse)
-	err := c.cc.Invoke(ctx, "/membershipmodule.membership.Query/Guardians", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryInvitationResponse proto.InternalMessageInfo
+
+func (m *QueryInvitationResponse) GetInvitation() *Invitation {
+	if m != nil {
+		return m.Invitation
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a Member using their wallet address
-	Member(context.Context, *QueryMemberRequest) (*QueryMemberResponse, error)
-	// Queries a list of Members items.
-	Members(context.Context, *QueryMembersRequest) (*QueryMembersResponse, error)
-	// Queries a list of Guardians items.
-	Guardians(context.Context, *QueryGuardiansRequest) (*QueryGuardiansResponse, error)
+// QueryRecallPetitionRequest specifies the member targeted by the petition.
+type QueryRecallPetitionRequest struct {
+	// target is the address of the member targeted by the petition.
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryRecallPetitionRequest) Reset()         { *m = QueryRecallPetitionRequest{} }
+func (m *QueryRecallPetitionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecallPetitionRequest) ProtoMessage()    {}
+func (*QueryRecallPetitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{10}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryRecallPetitionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Member(ctx context.Context, req *QueryMemberRequest) (*QueryMemberResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Member not implemented")
+func (m *QueryRecallPetitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecallPetitionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Members(ctx context.Context, req *QueryMembersRequest) (*QueryMembersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Members not implemented")
+func (m *QueryRecallPetitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecallPetitionRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Guardians(ctx context.Context, req *QueryGuardiansRequest) (*QueryGuardiansResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Guardians not implemented")
+func (m *QueryRecallPetitionRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryRecallPetitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecallPetitionRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/membershipmodule.membership.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_QueryRecallPetitionRequest proto.InternalMessageInfo
 
-func _Query_Member_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMemberRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Member(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/membershipmodule.membership.Query/Member",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Member(ctx, req.(*QueryMemberRequest))
+func (m *QueryRecallPetitionRequest) GetTarget() string {
+	if m != nil {
+		return m.Target
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Members_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMembersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Members(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/membershipmodule.membership.Query/Members",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Members(ctx, req.(*QueryMembersRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryRecallPetitionResponse contains the petition details.
+type QueryRecallPetitionResponse struct {
+	// petition contains the petition details.
+	Petition *RecallPetition `protobuf:"bytes,1,opt,name=petition,proto3" json:"petition,omitempty"`
 }
 
-func _Query_Guardians_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGuardiansRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Guardians(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/membershipmodule.membership.Query/Guardians",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Guardians(ctx, req.(*QueryGuardiansRequest))
+func (m *QueryRecallPetitionResponse) Reset()         { *m = QueryRecallPetitionResponse{} }
+func (m *QueryRecallPetitionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecallPetitionResponse) ProtoMessage()    {}
+func (*QueryRecallPetitionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{11}
+}
+func (m *QueryRecallPetitionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecallPetitionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecallPetitionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "membershipmodule.membership.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Member",
-			Handler:    _Query_Member_Handler,
-		},
-		{
-			MethodName: "Members",
-			Handler:    _Query_Members_Handler,
-		},
-		{
-			MethodName: "Guardians",
-			Handler:    _Query_Guardians_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "membershipmodule/membership/query.proto",
+func (m *QueryRecallPetitionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecallPetitionResponse.Merge(m, src)
+}
+func (m *QueryRecallPetitionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecallPetitionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecallPetitionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRecallPetitionResponse proto.InternalMessageInfo
+
+func (m *QueryRecallPetitionResponse) GetPetition() *RecallPetition {
+	if m != nil {
+		return m.Petition
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryExpulsionAppealRequest specifies the expelled member.
+type QueryExpulsionAppealRequest struct {
+	// address is the address of the expelled member.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryExpulsionAppealRequest) Reset()         { *m = QueryExpulsionAppealRequest{} }
+func (m *QueryExpulsionAppealRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExpulsionAppealRequest) ProtoMessage()    {}
+func (*QueryExpulsionAppealRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{12}
+}
+func (m *QueryExpulsionAppealRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpulsionAppealRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpulsionAppealRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExpulsionAppealRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpulsionAppealRequest.Merge(m, src)
+}
+func (m *QueryExpulsionAppealRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpulsionAppealRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpulsionAppealRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryExpulsionAppealRequest proto.InternalMessageInfo
+
+func (m *QueryExpulsionAppealRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryExpulsionAppealResponse contains the appeal details.
+type QueryExpulsionAppealResponse struct {
+	// appeal contains the appeal details.
+	Appeal *ExpulsionAppeal `protobuf:"bytes,1,opt,name=appeal,proto3" json:"appeal,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryExpulsionAppealResponse) Reset()         { *m = QueryExpulsionAppealResponse{} }
+func (m *QueryExpulsionAppealResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExpulsionAppealResponse) ProtoMessage()    {}
+func (*QueryExpulsionAppealResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{13}
+}
+func (m *QueryExpulsionAppealResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpulsionAppealResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpulsionAppealResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryExpulsionAppealResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpulsionAppealResponse.Merge(m, src)
+}
+func (m *QueryExpulsionAppealResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpulsionAppealResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpulsionAppealResponse.DiscardUnknown(m)
 }
 
-func (m *QueryMemberRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryExpulsionAppealResponse proto.InternalMessageInfo
+
+func (m *QueryExpulsionAppealResponse) GetAppeal() *ExpulsionAppeal {
+	if m != nil {
+		return m.Appeal
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryMemberRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryExpulsionAppealsRequest is request type for the Query/ExpulsionAppeals RPC method.
+type QueryExpulsionAppealsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryMemberRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryExpulsionAppealsRequest) Reset()         { *m = QueryExpulsionAppealsRequest{} }
+func (m *QueryExpulsionAppealsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExpulsionAppealsRequest) ProtoMessage()    {}
+func (*QueryExpulsionAppealsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{14}
+}
+func (m *QueryExpulsionAppealsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpulsionAppealsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpulsionAppealsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryExpulsionAppealsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpulsionAppealsRequest.Merge(m, src)
+}
+func (m *QueryExpulsionAppealsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpulsionAppealsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpulsionAppealsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryMemberResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryExpulsionAppealsRequest proto.InternalMessageInfo
+
+func (m *QueryExpulsionAppealsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryMemberResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryExpulsionAppealsResponse is response type for the Query/ExpulsionAppeals RPC method.
+type QueryExpulsionAppealsResponse struct {
+	Appeals    []ExpulsionAppeal   `protobuf:"bytes,1,rep,name=appeals,proto3" json:"appeals"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Member != nil {
-		{
-			size, err := m.Member.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryExpulsionAppealsResponse) Reset()         { *m = QueryExpulsionAppealsResponse{} }
+func (m *QueryExpulsionAppealsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExpulsionAppealsResponse) ProtoMessage()    {}
+func (*QueryExpulsionAppealsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{15}
+}
+func (m *QueryExpulsionAppealsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpulsionAppealsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpulsionAppealsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryMembersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryExpulsionAppealsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpulsionAppealsResponse.Merge(m, src)
 }
-
-func (m *QueryMembersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryExpulsionAppealsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpulsionAppealsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpulsionAppealsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryMembersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryExpulsionAppealsResponse proto.InternalMessageInfo
+
+func (m *QueryExpulsionAppealsResponse) GetAppeals() []ExpulsionAppeal {
+	if m != nil {
+		return m.Appeals
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryMembersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryExpulsionAppealsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryMembersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySuspensionsRequest specifies the member.
+type QuerySuspensionsRequest struct {
+	// address is the address of the member.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Members) > 0 {
-		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QuerySuspensionsRequest) Reset()         { *m = QuerySuspensionsRequest{} }
+func (m *QuerySuspensionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySuspensionsRequest) ProtoMessage()    {}
+func (*QuerySuspensionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{16}
+}
+func (m *QuerySuspensionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySuspensionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySuspensionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySuspensionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySuspensionsRequest.Merge(m, src)
+}
+func (m *QuerySuspensionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySuspensionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySuspensionsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGuardiansRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySuspensionsRequest proto.InternalMessageInfo
+
+func (m *QuerySuspensionsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGuardiansRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySuspensionsResponse contains the member's suspensions.
+type QuerySuspensionsResponse struct {
+	// current is the member's active suspension, if any.
+	Current *Suspension `protobuf:"bytes,1,opt,name=current,proto3" json:"current,omitempty"`
+	// history contains the member's past suspensions, oldest first.
+	History []Suspension `protobuf:"bytes,2,rep,name=history,proto3" json:"history"`
 }
 
-func (m *QueryGuardiansRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QuerySuspensionsResponse) Reset()         { *m = QuerySuspensionsResponse{} }
+func (m *QuerySuspensionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySuspensionsResponse) ProtoMessage()    {}
+func (*QuerySuspensionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{17}
+}
+func (m *QuerySuspensionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySuspensionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySuspensionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySuspensionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySuspensionsResponse.Merge(m, src)
+}
+func (m *QuerySuspensionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySuspensionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySuspensionsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGuardiansResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySuspensionsResponse proto.InternalMessageInfo
+
+func (m *QuerySuspensionsResponse) GetCurrent() *Suspension {
+	if m != nil {
+		return m.Current
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGuardiansResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySuspensionsResponse) GetHistory() []Suspension {
+	if m != nil {
+		return m.History
+	}
+	return nil
 }
 
-func (m *QueryGuardiansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TotalVotingWeight != nil {
-		{
-			size := m.TotalVotingWeight.Size()
-			i -= size
-			if _, err := m.TotalVotingWeight.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// QueryCandidatesRequest is request type for the Query/Candidates RPC method.
+type QueryCandidatesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCandidatesRequest) Reset()         { *m = QueryCandidatesRequest{} }
+func (m *QueryCandidatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCandidatesRequest) ProtoMessage()    {}
+func (*QueryCandidatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{18}
+}
+func (m *QueryCandidatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCandidatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCandidatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Members) > 0 {
-		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryCandidatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCandidatesRequest.Merge(m, src)
+}
+func (m *QueryCandidatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCandidatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCandidatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCandidatesRequest proto.InternalMessageInfo
+
+func (m *QueryCandidatesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+// QueryCandidatesResponse is response type for the Query/Candidates RPC method.
+type QueryCandidatesResponse struct {
+	Candidates []Candidacy         `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCandidatesResponse) Reset()         { *m = QueryCandidatesResponse{} }
+func (m *QueryCandidatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCandidatesResponse) ProtoMessage()    {}
+func (*QueryCandidatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{19}
+}
+func (m *QueryCandidatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCandidatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCandidatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCandidatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCandidatesResponse.Merge(m, src)
+}
+func (m *QueryCandidatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCandidatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCandidatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCandidatesResponse proto.InternalMessageInfo
+
+func (m *QueryCandidatesResponse) GetCandidates() []Candidacy {
+	if m != nil {
+		return m.Candidates
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCandidatesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryMemberRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryCurrentElectionRequest is request type for the Query/CurrentElection RPC method.
+type QueryCurrentElectionRequest struct {
+}
+
+func (m *QueryCurrentElectionRequest) Reset()         { *m = QueryCurrentElectionRequest{} }
+func (m *QueryCurrentElectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentElectionRequest) ProtoMessage()    {}
+func (*QueryCurrentElectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{20}
+}
+func (m *QueryCurrentElectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentElectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentElectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryCurrentElectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentElectionRequest.Merge(m, src)
+}
+func (m *QueryCurrentElectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentElectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentElectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCurrentElectionRequest proto.InternalMessageInfo
+
+// QueryCurrentElectionResponse contains the open election, if any.
+type QueryCurrentElectionResponse struct {
+	// election contains the election details.
+	Election *Election `protobuf:"bytes,1,opt,name=election,proto3" json:"election,omitempty"`
+}
+
+func (m *QueryCurrentElectionResponse) Reset()         { *m = QueryCurrentElectionResponse{} }
+func (m *QueryCurrentElectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentElectionResponse) ProtoMessage()    {}
+func (*QueryCurrentElectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{21}
+}
+func (m *QueryCurrentElectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentElectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentElectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryCurrentElectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentElectionResponse.Merge(m, src)
+}
+func (m *QueryCurrentElectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentElectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentElectionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryMemberResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryCurrentElectionResponse proto.InternalMessageInfo
+
+func (m *QueryCurrentElectionResponse) GetElection() *Election {
+	if m != nil {
+		return m.Election
 	}
-	var l int
-	_ = l
-	if m.Member != nil {
-		l = m.Member.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryElectionResultRequest specifies the election.
+type QueryElectionResultRequest struct {
+	// election_id is the identifier of the closed election.
+	ElectionId uint64 `protobuf:"varint,1,opt,name=election_id,json=electionId,proto3" json:"election_id,omitempty"`
+}
+
+func (m *QueryElectionResultRequest) Reset()         { *m = QueryElectionResultRequest{} }
+func (m *QueryElectionResultRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryElectionResultRequest) ProtoMessage()    {}
+func (*QueryElectionResultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{22}
+}
+func (m *QueryElectionResultRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryElectionResultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryElectionResultRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryElectionResultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryElectionResultRequest.Merge(m, src)
+}
+func (m *QueryElectionResultRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryElectionResultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryElectionResultRequest.DiscardUnknown(m)
 }
 
-func (m *QueryMembersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryElectionResultRequest proto.InternalMessageInfo
+
+func (m *QueryElectionResultRequest) GetElectionId() uint64 {
+	if m != nil {
+		return m.ElectionId
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+// QueryElectionResultResponse contains the election result.
+type QueryElectionResultResponse struct {
+	// result contains the election result.
+	Result *ElectionResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *QueryElectionResultResponse) Reset()         { *m = QueryElectionResultResponse{} }
+func (m *QueryElectionResultResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryElectionResultResponse) ProtoMessage()    {}
+func (*QueryElectionResultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{23}
+}
+func (m *QueryElectionResultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryElectionResultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryElectionResultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryElectionResultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryElectionResultResponse.Merge(m, src)
+}
+func (m *QueryElectionResultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryElectionResultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryElectionResultResponse.DiscardUnknown(m)
 }
 
-func (m *QueryMembersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryElectionResultResponse proto.InternalMessageInfo
+
+func (m *QueryElectionResultResponse) GetResult() *ElectionResult {
+	if m != nil {
+		return m.Result
 	}
-	var l int
-	_ = l
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryGuardianTermExpirationsRequest is request type for the Query/GuardianTermExpirations RPC method.
+type QueryGuardianTermExpirationsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGuardianTermExpirationsRequest) Reset()         { *m = QueryGuardianTermExpirationsRequest{} }
+func (m *QueryGuardianTermExpirationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGuardianTermExpirationsRequest) ProtoMessage()    {}
+func (*QueryGuardianTermExpirationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{24}
+}
+func (m *QueryGuardianTermExpirationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGuardianTermExpirationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGuardianTermExpirationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGuardianTermExpirationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGuardianTermExpirationsRequest.Merge(m, src)
+}
+func (m *QueryGuardianTermExpirationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGuardianTermExpirationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGuardianTermExpirationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGuardianTermExpirationsRequest proto.InternalMessageInfo
+
+func (m *QueryGuardianTermExpirationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGuardianTermExpirationsResponse is response type for the Query/GuardianTermExpirations RPC method.
+type QueryGuardianTermExpirationsResponse struct {
+	Terms      []GuardianTerm      `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGuardianTermExpirationsResponse) Reset()         { *m = QueryGuardianTermExpirationsResponse{} }
+func (m *QueryGuardianTermExpirationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGuardianTermExpirationsResponse) ProtoMessage()    {}
+func (*QueryGuardianTermExpirationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{25}
+}
+func (m *QueryGuardianTermExpirationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGuardianTermExpirationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGuardianTermExpirationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGuardianTermExpirationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGuardianTermExpirationsResponse.Merge(m, src)
+}
+func (m *QueryGuardianTermExpirationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGuardianTermExpirationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGuardianTermExpirationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGuardianTermExpirationsResponse proto.InternalMessageInfo
+
+func (m *QueryGuardianTermExpirationsResponse) GetTerms() []GuardianTerm {
+	if m != nil {
+		return m.Terms
+	}
+	return nil
+}
+
+func (m *QueryGuardianTermExpirationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTallyBreakdownRequest specifies the proposal.
+type QueryTallyBreakdownRequest struct {
+	// proposal_id is the identifier of the tallied proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryTallyBreakdownRequest) Reset()         { *m = QueryTallyBreakdownRequest{} }
+func (m *QueryTallyBreakdownRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTallyBreakdownRequest) ProtoMessage()    {}
+func (*QueryTallyBreakdownRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{26}
+}
+func (m *QueryTallyBreakdownRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTallyBreakdownRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTallyBreakdownRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTallyBreakdownRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTallyBreakdownRequest.Merge(m, src)
+}
+func (m *QueryTallyBreakdownRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTallyBreakdownRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTallyBreakdownRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTallyBreakdownRequest proto.InternalMessageInfo
+
+func (m *QueryTallyBreakdownRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryTallyBreakdownResponse contains the tally breakdown.
+type QueryTallyBreakdownResponse struct {
+	// breakdown shows how each chamber voted.
+	Breakdown *TallyBreakdown `protobuf:"bytes,1,opt,name=breakdown,proto3" json:"breakdown,omitempty"`
+}
+
+func (m *QueryTallyBreakdownResponse) Reset()         { *m = QueryTallyBreakdownResponse{} }
+func (m *QueryTallyBreakdownResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTallyBreakdownResponse) ProtoMessage()    {}
+func (*QueryTallyBreakdownResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{27}
+}
+func (m *QueryTallyBreakdownResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTallyBreakdownResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTallyBreakdownResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTallyBreakdownResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTallyBreakdownResponse.Merge(m, src)
+}
+func (m *QueryTallyBreakdownResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTallyBreakdownResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTallyBreakdownResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTallyBreakdownResponse proto.InternalMessageInfo
+
+func (m *QueryTallyBreakdownResponse) GetBreakdown() *TallyBreakdown {
+	if m != nil {
+		return m.Breakdown
+	}
+	return nil
+}
+
+// QueryTallyRuleRequest specifies the proposal.
+type QueryTallyRuleRequest struct {
+	// proposal_id is the identifier of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryTallyRuleRequest) Reset()         { *m = QueryTallyRuleRequest{} }
+func (m *QueryTallyRuleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTallyRuleRequest) ProtoMessage()    {}
+func (*QueryTallyRuleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{28}
+}
+func (m *QueryTallyRuleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTallyRuleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTallyRuleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTallyRuleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTallyRuleRequest.Merge(m, src)
+}
+func (m *QueryTallyRuleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTallyRuleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTallyRuleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTallyRuleRequest proto.InternalMessageInfo
+
+func (m *QueryTallyRuleRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryTallyRuleResponse contains the effective tally rule of the proposal.
+type QueryTallyRuleResponse struct {
+	// rule is the strictest rule among the proposal's messages, without a
+	// message type URL.
+	Rule TallyRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule"`
+	// matched_msg_type_urls are the message types with their own tally rule.
+	MatchedMsgTypeUrls []string `protobuf:"bytes,2,rep,name=matched_msg_type_urls,json=matchedMsgTypeUrls,proto3" json:"matched_msg_type_urls,omitempty"`
+}
+
+func (m *QueryTallyRuleResponse) Reset()         { *m = QueryTallyRuleResponse{} }
+func (m *QueryTallyRuleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTallyRuleResponse) ProtoMessage()    {}
+func (*QueryTallyRuleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{29}
+}
+func (m *QueryTallyRuleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTallyRuleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTallyRuleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTallyRuleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTallyRuleResponse.Merge(m, src)
+}
+func (m *QueryTallyRuleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTallyRuleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTallyRuleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTallyRuleResponse proto.InternalMessageInfo
+
+func (m *QueryTallyRuleResponse) GetRule() TallyRule {
+	if m != nil {
+		return m.Rule
+	}
+	return TallyRule{}
+}
+
+func (m *QueryTallyRuleResponse) GetMatchedMsgTypeUrls() []string {
+	if m != nil {
+		return m.MatchedMsgTypeUrls
+	}
+	return nil
+}
+
+// QueryVoteDelegationRequest specifies the delegating member.
+type QueryVoteDelegationRequest struct {
+	// delegator is the address of the delegating member.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+}
+
+func (m *QueryVoteDelegationRequest) Reset()         { *m = QueryVoteDelegationRequest{} }
+func (m *QueryVoteDelegationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteDelegationRequest) ProtoMessage()    {}
+func (*QueryVoteDelegationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{30}
+}
+func (m *QueryVoteDelegationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteDelegationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoteDelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteDelegationRequest.Merge(m, src)
+}
+func (m *QueryVoteDelegationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteDelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteDelegationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoteDelegationRequest proto.InternalMessageInfo
+
+func (m *QueryVoteDelegationRequest) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+// QueryVoteDelegationResponse contains the member's vote delegation.
+type QueryVoteDelegationResponse struct {
+	// delegation contains the delegation details.
+	Delegation *VoteDelegation `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation,omitempty"`
+}
+
+func (m *QueryVoteDelegationResponse) Reset()         { *m = QueryVoteDelegationResponse{} }
+func (m *QueryVoteDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteDelegationResponse) ProtoMessage()    {}
+func (*QueryVoteDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{31}
+}
+func (m *QueryVoteDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoteDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteDelegationResponse.Merge(m, src)
+}
+func (m *QueryVoteDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteDelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoteDelegationResponse proto.InternalMessageInfo
+
+func (m *QueryVoteDelegationResponse) GetDelegation() *VoteDelegation {
+	if m != nil {
+		return m.Delegation
+	}
+	return nil
+}
+
+// QueryVoteDelegationsRequest is request type for the Query/VoteDelegations RPC method.
+type QueryVoteDelegationsRequest struct {
+	// delegate is the address of the member voting on others' behalf.
+	Delegate string `protobuf:"bytes,1,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVoteDelegationsRequest) Reset()         { *m = QueryVoteDelegationsRequest{} }
+func (m *QueryVoteDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteDelegationsRequest) ProtoMessage()    {}
+func (*QueryVoteDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{32}
+}
+func (m *QueryVoteDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoteDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteDelegationsRequest.Merge(m, src)
+}
+func (m *QueryVoteDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteDelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoteDelegationsRequest proto.InternalMessageInfo
+
+func (m *QueryVoteDelegationsRequest) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+func (m *QueryVoteDelegationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryVoteDelegationsResponse is response type for the Query/VoteDelegations RPC method.
+type QueryVoteDelegationsResponse struct {
+	Delegations []VoteDelegation    `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVoteDelegationsResponse) Reset()         { *m = QueryVoteDelegationsResponse{} }
+func (m *QueryVoteDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteDelegationsResponse) ProtoMessage()    {}
+func (*QueryVoteDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{33}
+}
+func (m *QueryVoteDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoteDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteDelegationsResponse.Merge(m, src)
+}
+func (m *QueryVoteDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteDelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoteDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryVoteDelegationsResponse) GetDelegations() []VoteDelegation {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+func (m *QueryVoteDelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEffectiveVotePowerRequest specifies the member and the proposal.
+type QueryEffectiveVotePowerRequest struct {
+	// address is the address of the member.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// proposal_id optionally selects the proposal, so that scoped delegations
+	// are counted. Only unscoped delegations are counted when it is zero.
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryEffectiveVotePowerRequest) Reset()         { *m = QueryEffectiveVotePowerRequest{} }
+func (m *QueryEffectiveVotePowerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveVotePowerRequest) ProtoMessage()    {}
+func (*QueryEffectiveVotePowerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{34}
+}
+func (m *QueryEffectiveVotePowerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveVotePowerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveVotePowerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEffectiveVotePowerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveVotePowerRequest.Merge(m, src)
+}
+func (m *QueryEffectiveVotePowerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveVotePowerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveVotePowerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveVotePowerRequest proto.InternalMessageInfo
+
+func (m *QueryEffectiveVotePowerRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryEffectiveVotePowerRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryEffectiveVotePowerResponse contains the member's effective voting power.
+type QueryEffectiveVotePowerResponse struct {
+	// votes is the number of votes the member casts, including their own.
+	Votes uint64 `protobuf:"varint,1,opt,name=votes,proto3" json:"votes,omitempty"`
+	// voting_power is the share of the total voting power those votes carry.
+	VotingPower github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=voting_power,json=votingPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"voting_power"`
+	// delegators are the members whose votes the member casts.
+	Delegators []string `protobuf:"bytes,3,rep,name=delegators,proto3" json:"delegators,omitempty"`
+}
+
+func (m *QueryEffectiveVotePowerResponse) Reset()         { *m = QueryEffectiveVotePowerResponse{} }
+func (m *QueryEffectiveVotePowerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveVotePowerResponse) ProtoMessage()    {}
+func (*QueryEffectiveVotePowerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{35}
+}
+func (m *QueryEffectiveVotePowerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveVotePowerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveVotePowerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEffectiveVotePowerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveVotePowerResponse.Merge(m, src)
+}
+func (m *QueryEffectiveVotePowerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveVotePowerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveVotePowerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveVotePowerResponse proto.InternalMessageInfo
+
+func (m *QueryEffectiveVotePowerResponse) GetVotes() uint64 {
+	if m != nil {
+		return m.Votes
+	}
+	return 0
+}
+
+func (m *QueryEffectiveVotePowerResponse) GetDelegators() []string {
+	if m != nil {
+		return m.Delegators
+	}
+	return nil
+}
+
+// QuerySecretBallotRequest specifies the proposal.
+type QuerySecretBallotRequest struct {
+	// proposal_id is the identifier of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QuerySecretBallotRequest) Reset()         { *m = QuerySecretBallotRequest{} }
+func (m *QuerySecretBallotRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySecretBallotRequest) ProtoMessage()    {}
+func (*QuerySecretBallotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{36}
+}
+func (m *QuerySecretBallotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySecretBallotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySecretBallotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySecretBallotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySecretBallotRequest.Merge(m, src)
+}
+func (m *QuerySecretBallotRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySecretBallotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySecretBallotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySecretBallotRequest proto.InternalMessageInfo
+
+func (m *QuerySecretBallotRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QuerySecretBallotResponse contains the secret ballot.
+type QuerySecretBallotResponse struct {
+	// ballot contains the secret ballot details.
+	Ballot *SecretBallot `protobuf:"bytes,1,opt,name=ballot,proto3" json:"ballot,omitempty"`
+	// commits is the number of votes committed.
+	Commits uint64 `protobuf:"varint,2,opt,name=commits,proto3" json:"commits,omitempty"`
+	// reveals is the number of committed votes revealed.
+	Reveals uint64 `protobuf:"varint,3,opt,name=reveals,proto3" json:"reveals,omitempty"`
+}
+
+func (m *QuerySecretBallotResponse) Reset()         { *m = QuerySecretBallotResponse{} }
+func (m *QuerySecretBallotResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySecretBallotResponse) ProtoMessage()    {}
+func (*QuerySecretBallotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{37}
+}
+func (m *QuerySecretBallotResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySecretBallotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySecretBallotResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySecretBallotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySecretBallotResponse.Merge(m, src)
+}
+func (m *QuerySecretBallotResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySecretBallotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySecretBallotResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySecretBallotResponse proto.InternalMessageInfo
+
+func (m *QuerySecretBallotResponse) GetBallot() *SecretBallot {
+	if m != nil {
+		return m.Ballot
+	}
+	return nil
+}
+
+func (m *QuerySecretBallotResponse) GetCommits() uint64 {
+	if m != nil {
+		return m.Commits
+	}
+	return 0
+}
+
+func (m *QuerySecretBallotResponse) GetReveals() uint64 {
+	if m != nil {
+		return m.Reveals
+	}
+	return 0
+}
+
+// QueryVoteCommitRequest specifies the proposal and the voter.
+type QueryVoteCommitRequest struct {
+	// proposal_id is the identifier of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// voter is the address of the member who committed the vote.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+}
+
+func (m *QueryVoteCommitRequest) Reset()         { *m = QueryVoteCommitRequest{} }
+func (m *QueryVoteCommitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteCommitRequest) ProtoMessage()    {}
+func (*QueryVoteCommitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{38}
+}
+func (m *QueryVoteCommitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteCommitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteCommitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoteCommitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteCommitRequest.Merge(m, src)
+}
+func (m *QueryVoteCommitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteCommitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteCommitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoteCommitRequest proto.InternalMessageInfo
+
+func (m *QueryVoteCommitRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *QueryVoteCommitRequest) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+// QueryVoteCommitResponse contains the vote commitment.
+type QueryVoteCommitResponse struct {
+	// commit contains the vote commitment.
+	Commit *VoteCommit `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+}
+
+func (m *QueryVoteCommitResponse) Reset()         { *m = QueryVoteCommitResponse{} }
+func (m *QueryVoteCommitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteCommitResponse) ProtoMessage()    {}
+func (*QueryVoteCommitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{39}
+}
+func (m *QueryVoteCommitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteCommitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteCommitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoteCommitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteCommitResponse.Merge(m, src)
+}
+func (m *QueryVoteCommitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteCommitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteCommitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoteCommitResponse proto.InternalMessageInfo
+
+func (m *QueryVoteCommitResponse) GetCommit() *VoteCommit {
+	if m != nil {
+		return m.Commit
+	}
+	return nil
+}
+
+// QueryFeeWaiverRequest specifies the member.
+type QueryFeeWaiverRequest struct {
+	// address is the address of the member.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryFeeWaiverRequest) Reset()         { *m = QueryFeeWaiverRequest{} }
+func (m *QueryFeeWaiverRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeWaiverRequest) ProtoMessage()    {}
+func (*QueryFeeWaiverRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{40}
+}
+func (m *QueryFeeWaiverRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeWaiverRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeWaiverRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeWaiverRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeWaiverRequest.Merge(m, src)
+}
+func (m *QueryFeeWaiverRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeWaiverRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeWaiverRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeWaiverRequest proto.InternalMessageInfo
+
+func (m *QueryFeeWaiverRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryFeeWaiverResponse contains the member's fee waiver allowance.
+type QueryFeeWaiverResponse struct {
+	// eligible is true if the member can send fee-free transactions.
+	Eligible bool `protobuf:"varint,1,opt,name=eligible,proto3" json:"eligible,omitempty"`
+	// usage counts the fee-free transactions sent during the current epoch.
+	Usage FeeWaiverUsage `protobuf:"bytes,2,opt,name=usage,proto3" json:"usage"`
+	// remaining is the number of fee-free transactions left in the current epoch.
+	Remaining uint64 `protobuf:"varint,3,opt,name=remaining,proto3" json:"remaining,omitempty"`
+}
+
+func (m *QueryFeeWaiverResponse) Reset()         { *m = QueryFeeWaiverResponse{} }
+func (m *QueryFeeWaiverResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeWaiverResponse) ProtoMessage()    {}
+func (*QueryFeeWaiverResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{41}
+}
+func (m *QueryFeeWaiverResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeWaiverResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeWaiverResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeWaiverResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeWaiverResponse.Merge(m, src)
+}
+func (m *QueryFeeWaiverResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeWaiverResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeWaiverResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeWaiverResponse proto.InternalMessageInfo
+
+func (m *QueryFeeWaiverResponse) GetEligible() bool {
+	if m != nil {
+		return m.Eligible
+	}
+	return false
+}
+
+func (m *QueryFeeWaiverResponse) GetUsage() FeeWaiverUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return FeeWaiverUsage{}
+}
+
+func (m *QueryFeeWaiverResponse) GetRemaining() uint64 {
+	if m != nil {
+		return m.Remaining
+	}
+	return 0
+}
+
+// QueryElectorateGroupRequest is request type for the Query/ElectorateGroup RPC method.
+type QueryElectorateGroupRequest struct {
+}
+
+func (m *QueryElectorateGroupRequest) Reset()         { *m = QueryElectorateGroupRequest{} }
+func (m *QueryElectorateGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryElectorateGroupRequest) ProtoMessage()    {}
+func (*QueryElectorateGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{42}
+}
+func (m *QueryElectorateGroupRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryElectorateGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryElectorateGroupRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryElectorateGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryElectorateGroupRequest.Merge(m, src)
+}
+func (m *QueryElectorateGroupRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryElectorateGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryElectorateGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryElectorateGroupRequest proto.InternalMessageInfo
+
+// QueryElectorateGroupResponse contains the electorate group.
+type QueryElectorateGroupResponse struct {
+	ElectorateGroup ElectorateGroup `protobuf:"bytes,1,opt,name=electorate_group,json=electorateGroup,proto3" json:"electorate_group"`
+}
+
+func (m *QueryElectorateGroupResponse) Reset()         { *m = QueryElectorateGroupResponse{} }
+func (m *QueryElectorateGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryElectorateGroupResponse) ProtoMessage()    {}
+func (*QueryElectorateGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{43}
+}
+func (m *QueryElectorateGroupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryElectorateGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryElectorateGroupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryElectorateGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryElectorateGroupResponse.Merge(m, src)
+}
+func (m *QueryElectorateGroupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryElectorateGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryElectorateGroupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryElectorateGroupResponse proto.InternalMessageInfo
+
+func (m *QueryElectorateGroupResponse) GetElectorateGroup() ElectorateGroup {
+	if m != nil {
+		return m.ElectorateGroup
+	}
+	return ElectorateGroup{}
+}
+
+// QueryTreasuryRequest is request type for the Query/Treasury RPC method.
+type QueryTreasuryRequest struct {
+}
+
+func (m *QueryTreasuryRequest) Reset()         { *m = QueryTreasuryRequest{} }
+func (m *QueryTreasuryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryRequest) ProtoMessage()    {}
+func (*QueryTreasuryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{44}
+}
+func (m *QueryTreasuryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTreasuryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryRequest.Merge(m, src)
+}
+func (m *QueryTreasuryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasuryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTreasuryRequest proto.InternalMessageInfo
+
+// QueryTreasuryResponse contains the balance of the treasury, and the part of
+// it still owed to the recipients of streamed spends and to members' unclaimed
+// dividends
+type QueryTreasuryResponse struct {
+	Address   string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Balance   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+	Committed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=committed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"committed"`
+}
+
+func (m *QueryTreasuryResponse) Reset()         { *m = QueryTreasuryResponse{} }
+func (m *QueryTreasuryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryResponse) ProtoMessage()    {}
+func (*QueryTreasuryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{45}
+}
+func (m *QueryTreasuryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTreasuryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryResponse.Merge(m, src)
+}
+func (m *QueryTreasuryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasuryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTreasuryResponse proto.InternalMessageInfo
+
+func (m *QueryTreasuryResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryTreasuryResponse) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+func (m *QueryTreasuryResponse) GetCommitted() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Committed
+	}
+	return nil
+}
+
+// QueryTreasurySpendsRequest is request type for the Query/TreasurySpends RPC method.
+type QueryTreasurySpendsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTreasurySpendsRequest) Reset()         { *m = QueryTreasurySpendsRequest{} }
+func (m *QueryTreasurySpendsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasurySpendsRequest) ProtoMessage()    {}
+func (*QueryTreasurySpendsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{46}
+}
+func (m *QueryTreasurySpendsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasurySpendsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasurySpendsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTreasurySpendsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasurySpendsRequest.Merge(m, src)
+}
+func (m *QueryTreasurySpendsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasurySpendsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasurySpendsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTreasurySpendsRequest proto.InternalMessageInfo
+
+func (m *QueryTreasurySpendsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTreasurySpendsResponse contains the treasury's spends, oldest first
+type QueryTreasurySpendsResponse struct {
+	Spends     []TreasurySpend     `protobuf:"bytes,1,rep,name=spends,proto3" json:"spends"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTreasurySpendsResponse) Reset()         { *m = QueryTreasurySpendsResponse{} }
+func (m *QueryTreasurySpendsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasurySpendsResponse) ProtoMessage()    {}
+func (*QueryTreasurySpendsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{47}
+}
+func (m *QueryTreasurySpendsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasurySpendsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasurySpendsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTreasurySpendsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasurySpendsResponse.Merge(m, src)
+}
+func (m *QueryTreasurySpendsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasurySpendsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasurySpendsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTreasurySpendsResponse proto.InternalMessageInfo
+
+func (m *QueryTreasurySpendsResponse) GetSpends() []TreasurySpend {
+	if m != nil {
+		return m.Spends
+	}
+	return nil
+}
+
+func (m *QueryTreasurySpendsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDividendPoolRequest is request type for the Query/DividendPool RPC method.
+type QueryDividendPoolRequest struct {
+}
+
+func (m *QueryDividendPoolRequest) Reset()         { *m = QueryDividendPoolRequest{} }
+func (m *QueryDividendPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDividendPoolRequest) ProtoMessage()    {}
+func (*QueryDividendPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{48}
+}
+func (m *QueryDividendPoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDividendPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDividendPoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDividendPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDividendPoolRequest.Merge(m, src)
+}
+func (m *QueryDividendPoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDividendPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDividendPoolRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDividendPoolRequest proto.InternalMessageInfo
+
+// QueryDividendPoolResponse contains the member dividend's pool
+type QueryDividendPoolResponse struct {
+	DividendPool DividendPool `protobuf:"bytes,1,opt,name=dividend_pool,json=dividendPool,proto3" json:"dividend_pool"`
+}
+
+func (m *QueryDividendPoolResponse) Reset()         { *m = QueryDividendPoolResponse{} }
+func (m *QueryDividendPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDividendPoolResponse) ProtoMessage()    {}
+func (*QueryDividendPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{49}
+}
+func (m *QueryDividendPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDividendPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDividendPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDividendPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDividendPoolResponse.Merge(m, src)
+}
+func (m *QueryDividendPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDividendPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDividendPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDividendPoolResponse proto.InternalMessageInfo
+
+func (m *QueryDividendPoolResponse) GetDividendPool() DividendPool {
+	if m != nil {
+		return m.DividendPool
+	}
+	return DividendPool{}
+}
+
+// QueryMemberRewardsRequest is request type for the Query/MemberRewards RPC method.
+type QueryMemberRewardsRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryMemberRewardsRequest) Reset()         { *m = QueryMemberRewardsRequest{} }
+func (m *QueryMemberRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMemberRewardsRequest) ProtoMessage()    {}
+func (*QueryMemberRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{50}
+}
+func (m *QueryMemberRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMemberRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMemberRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMemberRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMemberRewardsRequest.Merge(m, src)
+}
+func (m *QueryMemberRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMemberRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMemberRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMemberRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryMemberRewardsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryMemberRewardsResponse contains the member dividend owed to the member
+type QueryMemberRewardsResponse struct {
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *QueryMemberRewardsResponse) Reset()         { *m = QueryMemberRewardsResponse{} }
+func (m *QueryMemberRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMemberRewardsResponse) ProtoMessage()    {}
+func (*QueryMemberRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1119d302a600ad18, []int{51}
+}
+func (m *QueryMemberRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMemberRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMemberRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMemberRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMemberRewardsResponse.Merge(m, src)
+}
+func (m *QueryMemberRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMemberRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMemberRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMemberRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryMemberRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "membershipmodule.membership.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "membershipmodule.membership.QueryParamsResponse")
+	proto.RegisterType((*QueryMemberRequest)(nil), "membershipmodule.membership.QueryMemberRequest")
+	proto.RegisterType((*QueryMemberResponse)(nil), "membershipmodule.membership.QueryMemberResponse")
+	proto.RegisterType((*QueryMembersRequest)(nil), "membershipmodule.membership.QueryMembersRequest")
+	proto.RegisterType((*QueryMembersResponse)(nil), "membershipmodule.membership.QueryMembersResponse")
+	proto.RegisterType((*QueryGuardiansRequest)(nil), "membershipmodule.membership.QueryGuardiansRequest")
+	proto.RegisterType((*QueryGuardiansResponse)(nil), "membershipmodule.membership.QueryGuardiansResponse")
+	proto.RegisterType((*QueryInvitationRequest)(nil), "membershipmodule.membership.QueryInvitationRequest")
+	proto.RegisterType((*QueryInvitationResponse)(nil), "membershipmodule.membership.QueryInvitationResponse")
+	proto.RegisterType((*QueryRecallPetitionRequest)(nil), "membershipmodule.membership.QueryRecallPetitionRequest")
+	proto.RegisterType((*QueryRecallPetitionResponse)(nil), "membershipmodule.membership.QueryRecallPetitionResponse")
+	proto.RegisterType((*QueryExpulsionAppealRequest)(nil), "membershipmodule.membership.QueryExpulsionAppealRequest")
+	proto.RegisterType((*QueryExpulsionAppealResponse)(nil), "membershipmodule.membership.QueryExpulsionAppealResponse")
+	proto.RegisterType((*QueryExpulsionAppealsRequest)(nil), "membershipmodule.membership.QueryExpulsionAppealsRequest")
+	proto.RegisterType((*QueryExpulsionAppealsResponse)(nil), "membershipmodule.membership.QueryExpulsionAppealsResponse")
+	proto.RegisterType((*QuerySuspensionsRequest)(nil), "membershipmodule.membership.QuerySuspensionsRequest")
+	proto.RegisterType((*QuerySuspensionsResponse)(nil), "membershipmodule.membership.QuerySuspensionsResponse")
+	proto.RegisterType((*QueryCandidatesRequest)(nil), "membershipmodule.membership.QueryCandidatesRequest")
+	proto.RegisterType((*QueryCandidatesResponse)(nil), "membershipmodule.membership.QueryCandidatesResponse")
+	proto.RegisterType((*QueryCurrentElectionRequest)(nil), "membershipmodule.membership.QueryCurrentElectionRequest")
+	proto.RegisterType((*QueryCurrentElectionResponse)(nil), "membershipmodule.membership.QueryCurrentElectionResponse")
+	proto.RegisterType((*QueryElectionResultRequest)(nil), "membershipmodule.membership.QueryElectionResultRequest")
+	proto.RegisterType((*QueryElectionResultResponse)(nil), "membershipmodule.membership.QueryElectionResultResponse")
+	proto.RegisterType((*QueryGuardianTermExpirationsRequest)(nil), "membershipmodule.membership.QueryGuardianTermExpirationsRequest")
+	proto.RegisterType((*QueryGuardianTermExpirationsResponse)(nil), "membershipmodule.membership.QueryGuardianTermExpirationsResponse")
+	proto.RegisterType((*QueryTallyBreakdownRequest)(nil), "membershipmodule.membership.QueryTallyBreakdownRequest")
+	proto.RegisterType((*QueryTallyBreakdownResponse)(nil), "membershipmodule.membership.QueryTallyBreakdownResponse")
+	proto.RegisterType((*QueryTallyRuleRequest)(nil), "membershipmodule.membership.QueryTallyRuleRequest")
+	proto.RegisterType((*QueryTallyRuleResponse)(nil), "membershipmodule.membership.QueryTallyRuleResponse")
+	proto.RegisterType((*QueryVoteDelegationRequest)(nil), "membershipmodule.membership.QueryVoteDelegationRequest")
+	proto.RegisterType((*QueryVoteDelegationResponse)(nil), "membershipmodule.membership.QueryVoteDelegationResponse")
+	proto.RegisterType((*QueryVoteDelegationsRequest)(nil), "membershipmodule.membership.QueryVoteDelegationsRequest")
+	proto.RegisterType((*QueryVoteDelegationsResponse)(nil), "membershipmodule.membership.QueryVoteDelegationsResponse")
+	proto.RegisterType((*QueryEffectiveVotePowerRequest)(nil), "membershipmodule.membership.QueryEffectiveVotePowerRequest")
+	proto.RegisterType((*QueryEffectiveVotePowerResponse)(nil), "membershipmodule.membership.QueryEffectiveVotePowerResponse")
+	proto.RegisterType((*QuerySecretBallotRequest)(nil), "membershipmodule.membership.QuerySecretBallotRequest")
+	proto.RegisterType((*QuerySecretBallotResponse)(nil), "membershipmodule.membership.QuerySecretBallotResponse")
+	proto.RegisterType((*QueryVoteCommitRequest)(nil), "membershipmodule.membership.QueryVoteCommitRequest")
+	proto.RegisterType((*QueryVoteCommitResponse)(nil), "membershipmodule.membership.QueryVoteCommitResponse")
+	proto.RegisterType((*QueryFeeWaiverRequest)(nil), "membershipmodule.membership.QueryFeeWaiverRequest")
+	proto.RegisterType((*QueryFeeWaiverResponse)(nil), "membershipmodule.membership.QueryFeeWaiverResponse")
+	proto.RegisterType((*QueryElectorateGroupRequest)(nil), "membershipmodule.membership.QueryElectorateGroupRequest")
+	proto.RegisterType((*QueryElectorateGroupResponse)(nil), "membershipmodule.membership.QueryElectorateGroupResponse")
+	proto.RegisterType((*QueryTreasuryRequest)(nil), "membershipmodule.membership.QueryTreasuryRequest")
+	proto.RegisterType((*QueryTreasuryResponse)(nil), "membershipmodule.membership.QueryTreasuryResponse")
+	proto.RegisterType((*QueryTreasurySpendsRequest)(nil), "membershipmodule.membership.QueryTreasurySpendsRequest")
+	proto.RegisterType((*QueryTreasurySpendsResponse)(nil), "membershipmodule.membership.QueryTreasurySpendsResponse")
+	proto.RegisterType((*QueryDividendPoolRequest)(nil), "membershipmodule.membership.QueryDividendPoolRequest")
+	proto.RegisterType((*QueryDividendPoolResponse)(nil), "membershipmodule.membership.QueryDividendPoolResponse")
+	proto.RegisterType((*QueryMemberRewardsRequest)(nil), "membershipmodule.membership.QueryMemberRewardsRequest")
+	proto.RegisterType((*QueryMemberRewardsResponse)(nil), "membershipmodule.membership.QueryMemberRewardsResponse")
+}
+
+func init() {
+	proto.RegisterFile("membershipmodule/membership/query.proto", fileDescriptor_1119d302a600ad18)
+}
+
+var fileDescriptor_1119d302a600ad18 = []byte{
+	// 2480 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcd, 0x6f, 0x14, 0xc9,
+	0xf9, 0xa6, 0x0c, 0x8c, 0xf1, 0x0b, 0x0b, 0xfb, 0x2b, 0xbe, 0x4c, 0xe3, 0xb5, 0x51, 0xef, 0x2f,
+	0x2c, 0x21, 0x30, 0x8d, 0x6d, 0xc0, 0x36, 0x2c, 0x01, 0xfc, 0x81, 0x21, 0x2c, 0xc4, 0x0c, 0x5e,
+	0x36, 0x22, 0x5a, 0x4d, 0x7a, 0xa6, 0x8b, 0x71, 0x67, 0x67, 0xa6, 0x9b, 0xee, 0x1e, 0x1b, 0xcb,
+	0xf2, 0x21, 0xd9, 0x73, 0xa4, 0x28, 0x39, 0xe6, 0x98, 0x28, 0x91, 0x36, 0x8a, 0x12, 0x29, 0x52,
+	0x0e, 0xd1, 0x1e, 0x12, 0x29, 0x52, 0x50, 0x94, 0x48, 0x64, 0xc9, 0x21, 0x4a, 0x24, 0x36, 0x82,
+	0x9c, 0xf6, 0xaf, 0x88, 0xba, 0xfa, 0xad, 0xfe, 0x9a, 0x76, 0xbb, 0x7a, 0x98, 0x3d, 0xb9, 0xbb,
+	0xba, 0x9e, 0xb7, 0x9e, 0xa7, 0x3e, 0xde, 0xaa, 0x7a, 0x3c, 0xf0, 0x4e, 0x8b, 0xb5, 0x6a, 0xcc,
+	0x71, 0x57, 0x4c, 0xbb, 0x65, 0x19, 0x9d, 0x26, 0xd3, 0xa2, 0x02, 0xed, 0x71, 0x87, 0x39, 0xeb,
+	0x65, 0xdb, 0xb1, 0x3c, 0x8b, 0x1e, 0x4f, 0x57, 0x2c, 0x47, 0x05, 0xca, 0xe9, 0xba, 0xe5, 0xb6,
+	0x2c, 0x57, 0xab, 0xe9, 0x2e, 0x0b, 0x50, 0xda, 0xea, 0x78, 0x8d, 0x79, 0xfa, 0xb8, 0x66, 0xeb,
+	0x0d, 0xb3, 0xad, 0x7b, 0xa6, 0xd5, 0x0e, 0x02, 0x29, 0xa3, 0xf1, 0xba, 0xa2, 0x56, 0xdd, 0x32,
+	0xc5, 0xf7, 0x43, 0x0d, 0xab, 0x61, 0xf1, 0x47, 0xcd, 0x7f, 0xc2, 0xd2, 0x91, 0x86, 0x65, 0x35,
+	0x9a, 0x4c, 0xd3, 0x6d, 0x53, 0xd3, 0xdb, 0x6d, 0xcb, 0xe3, 0x21, 0x5d, 0xfc, 0x7a, 0x2a, 0x4f,
+	0x85, 0x6e, 0xdb, 0x4c, 0x6f, 0x62, 0xcd, 0x33, 0x79, 0x35, 0x0d, 0xd6, 0x64, 0x8d, 0x38, 0xd7,
+	0xd3, 0xb9, 0xb5, 0xcd, 0x55, 0xd3, 0x60, 0x6d, 0x43, 0xa6, 0x2e, 0x6b, 0xb2, 0x7a, 0x2c, 0xee,
+	0xc4, 0xb6, 0x75, 0x2d, 0x47, 0xf7, 0x58, 0xb5, 0xe1, 0x58, 0x1d, 0x5b, 0x86, 0xf9, 0x23, 0xc6,
+	0xaa, 0x6b, 0xba, 0xb9, 0xca, 0x1c, 0x99, 0xda, 0x66, 0x7b, 0xd5, 0xf4, 0xe2, 0x3a, 0x73, 0xfb,
+	0x2f, 0x78, 0x94, 0xa9, 0xe9, 0xb0, 0xba, 0xde, 0x14, 0x3d, 0xad, 0xe5, 0xd5, 0x74, 0x59, 0xdd,
+	0x61, 0x5e, 0xb5, 0xa6, 0x37, 0x9b, 0x96, 0x27, 0x43, 0xd9, 0xed, 0xb8, 0x36, 0x6b, 0xbb, 0x11,
+	0xe5, 0xdc, 0x89, 0xeb, 0xe9, 0xcd, 0x26, 0x4e, 0x5c, 0xe5, 0x64, 0x6e, 0x45, 0xe6, 0xb4, 0x64,
+	0xc6, 0xcf, 0x73, 0x98, 0xee, 0x76, 0x9c, 0x75, 0x99, 0x5e, 0xb0, 0x75, 0x47, 0x6f, 0xe1, 0xcc,
+	0x54, 0x0f, 0x01, 0xbd, 0xe7, 0xaf, 0x87, 0x25, 0x5e, 0x58, 0x61, 0x8f, 0x3b, 0xcc, 0xf5, 0xd4,
+	0x6f, 0xc1, 0xc1, 0x44, 0xa9, 0x6b, 0x5b, 0x6d, 0x97, 0xd1, 0xeb, 0x50, 0x0a, 0xc0, 0xc3, 0xe4,
+	0x04, 0x39, 0xb5, 0x77, 0xe2, 0xed, 0x72, 0xce, 0xa2, 0x2b, 0x07, 0xe0, 0xd9, 0x5d, 0x4f, 0x5f,
+	0x8c, 0xed, 0xa8, 0x20, 0x50, 0x2d, 0x63, 0x7b, 0x77, 0x78, 0x3d, 0x6c, 0x8f, 0x0e, 0xc3, 0xa0,
+	0x6e, 0x18, 0x0e, 0x73, 0x83, 0xc8, 0x43, 0x15, 0xf1, 0xaa, 0x3a, 0x70, 0x30, 0x51, 0x1f, 0x99,
+	0x5c, 0x86, 0x52, 0xd0, 0x92, 0x14, 0x13, 0x04, 0x23, 0x84, 0x8e, 0x02, 0x44, 0x33, 0x6c, 0x78,
+	0x80, 0x37, 0x18, 0x2b, 0x51, 0x3f, 0x4c, 0xb4, 0x29, 0x3a, 0x85, 0xde, 0x00, 0x88, 0x92, 0x05,
+	0xb6, 0x7b, 0xb2, 0x1c, 0x64, 0x8b, 0xb2, 0x9f, 0x2d, 0xca, 0x41, 0x3e, 0xc2, 0x9c, 0x51, 0x5e,
+	0xd2, 0x1b, 0x0c, 0xb1, 0x95, 0x18, 0x52, 0xfd, 0x19, 0x81, 0x43, 0xc9, 0xf8, 0x28, 0x6a, 0x0e,
+	0x06, 0x91, 0xf4, 0x30, 0x39, 0xb1, 0x53, 0x52, 0x15, 0xef, 0x5f, 0x52, 0x11, 0x48, 0xba, 0x98,
+	0x60, 0x39, 0xc0, 0x59, 0xbe, 0xb3, 0x2d, 0xcb, 0x80, 0x41, 0x82, 0xe6, 0x51, 0x38, 0xcc, 0x59,
+	0x2e, 0x76, 0x74, 0xc7, 0x30, 0xf5, 0x76, 0x38, 0x39, 0xfe, 0x41, 0xe0, 0x48, 0xfa, 0x4b, 0x3f,
+	0x15, 0x74, 0xe0, 0xa0, 0x67, 0x79, 0x7a, 0xb3, 0xba, 0x6a, 0x79, 0x66, 0xbb, 0x51, 0x5d, 0x63,
+	0x66, 0x63, 0xc5, 0xe3, 0x52, 0xf6, 0xcd, 0x2e, 0xf8, 0x75, 0xff, 0xf5, 0x62, 0xec, 0x64, 0xc3,
+	0xf4, 0x56, 0x3a, 0xb5, 0x72, 0xdd, 0x6a, 0x69, 0x98, 0xb0, 0x83, 0x3f, 0x67, 0x5d, 0xe3, 0x23,
+	0xcd, 0x5b, 0xb7, 0x99, 0x5b, 0x9e, 0x67, 0xf5, 0x2f, 0x5e, 0x8c, 0x65, 0x05, 0xab, 0xfc, 0x1f,
+	0x2f, 0x7c, 0xc0, 0xcb, 0x3e, 0xe0, 0x45, 0xea, 0x19, 0x54, 0x75, 0x2b, 0x9c, 0x08, 0x62, 0xe0,
+	0x29, 0xec, 0x5a, 0xd1, 0xdd, 0x15, 0x9c, 0x9a, 0xfc, 0x59, 0xad, 0xc1, 0xd1, 0xae, 0xda, 0xd8,
+	0x09, 0x8b, 0x89, 0xe9, 0x45, 0x70, 0x04, 0xf2, 0xfa, 0x21, 0x16, 0x24, 0x3e, 0x0f, 0xcf, 0x83,
+	0xc2, 0xdb, 0xa8, 0xf0, 0xb4, 0xb5, 0xc4, 0x3c, 0x33, 0xce, 0xea, 0x08, 0x94, 0x3c, 0xdd, 0x69,
+	0x30, 0x0f, 0x79, 0xe1, 0x9b, 0xfa, 0x08, 0x8e, 0x67, 0xa2, 0x42, 0x76, 0x7b, 0x6c, 0x2c, 0x43,
+	0x6e, 0x5f, 0xcb, 0xe5, 0x96, 0x0a, 0x13, 0x82, 0xd5, 0x29, 0x6c, 0x67, 0xe1, 0x89, 0xdd, 0x69,
+	0xfa, 0x89, 0xef, 0x3a, 0xdf, 0xc7, 0xb6, 0x5f, 0xd2, 0x06, 0x8c, 0x64, 0x03, 0x91, 0xe1, 0x3c,
+	0x94, 0x82, 0x2d, 0x11, 0xf9, 0x9d, 0xc9, 0xe5, 0x97, 0x8e, 0x82, 0x58, 0xf5, 0x51, 0x76, 0x2b,
+	0x7d, 0x5f, 0xcd, 0xbf, 0x23, 0xf0, 0xd6, 0x16, 0x0d, 0xa1, 0x9e, 0xf7, 0x60, 0x30, 0xe0, 0x24,
+	0x16, 0x45, 0x21, 0x41, 0x98, 0x3f, 0x45, 0x88, 0xfe, 0xad, 0xef, 0x49, 0x9c, 0xc1, 0xf7, 0xc3,
+	0x9d, 0xcb, 0xdd, 0x7e, 0xec, 0x7e, 0x4e, 0x60, 0xb8, 0x1b, 0x15, 0x6e, 0x0f, 0x83, 0xf5, 0x8e,
+	0xe3, 0xb0, 0xb6, 0x27, 0x35, 0xeb, 0xa3, 0x10, 0x15, 0x81, 0xa3, 0x8b, 0x30, 0xb8, 0x62, 0xba,
+	0x9e, 0xe5, 0xac, 0x0f, 0x0f, 0x9c, 0xd8, 0x59, 0x20, 0x84, 0xe8, 0x26, 0x44, 0xab, 0xdf, 0xc1,
+	0xd5, 0x3c, 0xa7, 0xb7, 0x0d, 0xd3, 0xd0, 0x3d, 0xd6, 0xf7, 0x81, 0xff, 0x0d, 0x81, 0xa3, 0x5d,
+	0x4d, 0x84, 0x43, 0x0e, 0xf5, 0xb0, 0x14, 0x47, 0xfd, 0x64, 0xae, 0x12, 0x0c, 0x52, 0x5f, 0x47,
+	0x21, 0x31, 0x7c, 0xff, 0x86, 0xfc, 0x2d, 0x5c, 0xb2, 0x73, 0x41, 0x6f, 0x2f, 0xe0, 0xa1, 0x4f,
+	0x24, 0x76, 0x1d, 0x46, 0xb2, 0x3f, 0x87, 0xe3, 0xbb, 0x47, 0x9c, 0x13, 0xb1, 0xdf, 0xbe, 0x92,
+	0x3f, 0x93, 0x45, 0x80, 0x10, 0xa6, 0x5e, 0xc1, 0x94, 0x16, 0x8b, 0xdd, 0x69, 0x7a, 0x62, 0x68,
+	0xc6, 0x60, 0xaf, 0xa8, 0x59, 0x35, 0x0d, 0xde, 0xc6, 0xae, 0x0a, 0x88, 0xa2, 0x5b, 0x86, 0x5a,
+	0x13, 0x39, 0x27, 0x05, 0x0f, 0xb7, 0x9f, 0x92, 0xc3, 0x4b, 0xa4, 0x32, 0x5b, 0x2a, 0x08, 0x42,
+	0xd5, 0x16, 0xbc, 0x9d, 0xd8, 0xdd, 0x96, 0x99, 0xd3, 0x5a, 0x78, 0x62, 0x9b, 0x4e, 0x70, 0xa2,
+	0xff, 0x12, 0xf2, 0xc7, 0xff, 0xe7, 0xb7, 0x87, 0xe2, 0x16, 0x60, 0xb7, 0xc7, 0x9c, 0x96, 0x98,
+	0x4e, 0x5f, 0xcd, 0xd5, 0x16, 0x0f, 0x86, 0x33, 0x2a, 0x40, 0xf7, 0x6f, 0x32, 0x89, 0xa1, 0x5c,
+	0xf6, 0xcf, 0xb2, 0xb3, 0x0e, 0xd3, 0x3f, 0x32, 0xac, 0xb5, 0x76, 0x6c, 0x28, 0x6d, 0xc7, 0xb2,
+	0x2d, 0x57, 0x6f, 0xc6, 0x86, 0x52, 0x14, 0xdd, 0x32, 0xd4, 0x15, 0x38, 0x9e, 0x09, 0x47, 0xb5,
+	0xb7, 0x60, 0xa8, 0x26, 0x0a, 0xa5, 0x46, 0x33, 0x15, 0x27, 0x42, 0xab, 0xd3, 0x78, 0x90, 0xe1,
+	0x35, 0x2a, 0x9d, 0x26, 0x93, 0xe6, 0xf8, 0x03, 0x71, 0xd2, 0x89, 0x41, 0x91, 0xdf, 0x35, 0xd8,
+	0xe5, 0x74, 0x9a, 0x2c, 0x1c, 0xf8, 0x6d, 0xa9, 0xf9, 0x68, 0x1c, 0x09, 0x8e, 0xa4, 0xe3, 0x70,
+	0xb8, 0xa5, 0x7b, 0xf5, 0x15, 0x66, 0x54, 0x5b, 0x6e, 0xa3, 0xea, 0x1f, 0x59, 0xaa, 0x1d, 0xa7,
+	0xe9, 0xf2, 0xc4, 0x37, 0x54, 0xa1, 0xf8, 0xf1, 0x8e, 0xdb, 0x58, 0x5e, 0xb7, 0xd9, 0xfb, 0x4e,
+	0xd3, 0x55, 0x2f, 0x61, 0x97, 0x3f, 0xb0, 0x3c, 0x36, 0x1f, 0xde, 0x05, 0x85, 0x9c, 0x11, 0x18,
+	0xc2, 0x0b, 0xa2, 0xe5, 0x60, 0xde, 0x8e, 0x0a, 0xd4, 0xef, 0xc2, 0xf1, 0x4c, 0x2c, 0xea, 0xb9,
+	0x0d, 0x10, 0xdd, 0x2e, 0xa5, 0x3a, 0x3c, 0x15, 0x28, 0x06, 0x57, 0xbf, 0x47, 0x32, 0x1b, 0x0b,
+	0xd7, 0x8e, 0x02, 0x7b, 0xb0, 0x36, 0x43, 0xa2, 0xe1, 0x3b, 0xbd, 0x91, 0x31, 0x3f, 0x7b, 0x59,
+	0x57, 0x9f, 0x12, 0x18, 0xc9, 0xe6, 0x80, 0x8a, 0xef, 0xc3, 0xde, 0x88, 0xb2, 0x58, 0x55, 0x45,
+	0x24, 0xe3, 0x68, 0xc6, 0xa3, 0xf4, 0x6f, 0x75, 0x7d, 0x1b, 0x46, 0x83, 0x4c, 0xf7, 0xe8, 0x91,
+	0x9f, 0xa5, 0x56, 0x99, 0xdf, 0xf6, 0x92, 0xb5, 0x26, 0x71, 0x67, 0x4a, 0xcf, 0xeb, 0x81, 0xae,
+	0x79, 0xfd, 0x09, 0x81, 0xb1, 0x2d, 0xa3, 0x63, 0xf7, 0x1c, 0x82, 0xdd, 0xab, 0x56, 0xb0, 0x7b,
+	0xf9, 0xf0, 0xe0, 0x85, 0xde, 0x83, 0x7d, 0x78, 0x90, 0xb6, 0xfd, 0xda, 0x78, 0x28, 0x2f, 0xfb,
+	0x1d, 0x21, 0x7f, 0x28, 0xaf, 0xec, 0x0d, 0x62, 0xf0, 0x06, 0xfd, 0xdb, 0x58, 0x38, 0x4b, 0xdd,
+	0xe1, 0x9d, 0x7c, 0xf2, 0xc7, 0x4a, 0xd4, 0xcb, 0xe2, 0xc4, 0xc1, 0xaf, 0xe4, 0xb3, 0xfc, 0x46,
+	0x2e, 0xbd, 0x82, 0x7f, 0x44, 0xe0, 0x58, 0x06, 0x3a, 0xba, 0xcf, 0x06, 0x37, 0x7c, 0x9c, 0xf0,
+	0xf9, 0x39, 0x35, 0x11, 0x02, 0x81, 0xfe, 0x28, 0xd4, 0xad, 0x56, 0xcb, 0xf4, 0x5c, 0xec, 0x67,
+	0xf1, 0xea, 0x7f, 0x71, 0xd8, 0x2a, 0x3f, 0xf6, 0xed, 0x0c, 0xbe, 0xe0, 0xab, 0xfa, 0x4d, 0xcc,
+	0x2a, 0x7e, 0xa7, 0xcf, 0xf1, 0xda, 0xb2, 0x7a, 0xc4, 0xa8, 0x38, 0x78, 0x6b, 0x0d, 0x5e, 0xd4,
+	0x87, 0x70, 0xb4, 0x2b, 0x20, 0x4a, 0xbc, 0x0a, 0xa5, 0x80, 0x90, 0xd4, 0x91, 0x2c, 0x16, 0x00,
+	0x61, 0xea, 0x38, 0x66, 0xcf, 0x1b, 0x8c, 0x7d, 0xc0, 0x0d, 0x9c, 0xed, 0x0f, 0x89, 0x3f, 0x11,
+	0x69, 0x33, 0x86, 0x41, 0x3a, 0x8a, 0x7f, 0x84, 0x30, 0x1b, 0x66, 0x0d, 0x53, 0xe7, 0x9e, 0x4a,
+	0xf8, 0x4e, 0x17, 0x61, 0x77, 0xc7, 0xd5, 0x1b, 0x0c, 0x97, 0x4d, 0xfe, 0x52, 0x0c, 0x43, 0xbf,
+	0xef, 0x43, 0xc4, 0x16, 0xc7, 0xf1, 0x7e, 0x22, 0x74, 0x58, 0x4b, 0x37, 0xdb, 0x66, 0xbb, 0x81,
+	0x7d, 0x1f, 0x15, 0x84, 0x87, 0xa0, 0x85, 0xd0, 0xc6, 0x5a, 0x74, 0xac, 0x8e, 0x2d, 0x0e, 0x41,
+	0x9b, 0x30, 0x92, 0xfd, 0x19, 0x15, 0x7c, 0x08, 0x6f, 0xa6, 0x0d, 0x30, 0xb9, 0x7b, 0x4a, 0x32,
+	0x1e, 0x32, 0x3e, 0xc0, 0x92, 0xc5, 0xea, 0x11, 0xf4, 0x06, 0x96, 0xd1, 0xd0, 0x11, 0xb4, 0x3e,
+	0x1e, 0x80, 0xc3, 0xa9, 0x0f, 0x48, 0x68, 0xeb, 0x3c, 0xc0, 0x60, 0xb0, 0xa6, 0x37, 0xf5, 0x76,
+	0x9d, 0xe1, 0x61, 0xfa, 0x58, 0x22, 0x13, 0x89, 0x1c, 0x34, 0x67, 0x99, 0xed, 0xd9, 0x73, 0x3e,
+	0x9d, 0x4f, 0x3e, 0x1f, 0x3b, 0x25, 0xb1, 0x84, 0x7d, 0x80, 0x5b, 0x11, 0xb1, 0xa9, 0x09, 0x43,
+	0xc1, 0x5c, 0xf1, 0x98, 0x31, 0xbc, 0xb3, 0xff, 0x0d, 0x45, 0xd1, 0x55, 0x03, 0x94, 0x44, 0x27,
+	0xdc, 0xb7, 0x59, 0xdb, 0xf8, 0x32, 0x4e, 0xf6, 0xc7, 0x33, 0x9b, 0xc1, 0x1e, 0xbf, 0x09, 0x25,
+	0x97, 0x97, 0xe0, 0xa6, 0x71, 0x3a, 0x7f, 0xf7, 0x8f, 0x07, 0x11, 0x6e, 0x58, 0x80, 0xef, 0xdf,
+	0x76, 0xa1, 0x60, 0x92, 0x9c, 0x47, 0xcf, 0x77, 0xc9, 0xb2, 0xc4, 0x4d, 0x5c, 0x7d, 0x0c, 0xc7,
+	0x32, 0xbe, 0xa1, 0x96, 0x65, 0x78, 0x43, 0xf8, 0xc4, 0x55, 0xdb, 0xb2, 0x9a, 0x52, 0x99, 0x30,
+	0x1e, 0x09, 0x15, 0xed, 0x33, 0x62, 0x65, 0xea, 0x05, 0x6c, 0x52, 0xb8, 0x76, 0x6b, 0xba, 0x63,
+	0x48, 0xdc, 0x2e, 0x3f, 0x26, 0xa0, 0x64, 0xe1, 0x90, 0x2b, 0xf3, 0x33, 0x2a, 0x2f, 0x1a, 0x26,
+	0xfd, 0x9f, 0x66, 0x22, 0xf6, 0xc4, 0xdf, 0x4f, 0xc1, 0x6e, 0xce, 0x82, 0xfe, 0x94, 0x40, 0x29,
+	0x70, 0x31, 0xa9, 0x96, 0xdb, 0x21, 0xdd, 0x16, 0xaa, 0x72, 0x4e, 0x1e, 0x10, 0xc8, 0x53, 0x2f,
+	0x7e, 0xff, 0xf9, 0x7f, 0x7f, 0x3c, 0x70, 0x8e, 0x96, 0xb5, 0xb6, 0xe5, 0x98, 0xfa, 0xd9, 0x36,
+	0xf3, 0xb4, 0x00, 0x79, 0xb6, 0xcb, 0xed, 0x8e, 0x19, 0xb9, 0xf4, 0x57, 0x04, 0x4a, 0x41, 0x87,
+	0xc9, 0xb0, 0x4c, 0x18, 0xaf, 0xca, 0x39, 0x79, 0x00, 0xb2, 0xbc, 0xc6, 0x59, 0x5e, 0xa2, 0xd3,
+	0xb2, 0x2c, 0x83, 0x47, 0x6d, 0x03, 0x07, 0x79, 0x93, 0xfe, 0x82, 0xc0, 0x60, 0x10, 0xd4, 0xa5,
+	0xd2, 0xed, 0x87, 0xfd, 0x3a, 0x5e, 0x00, 0x81, 0x94, 0xa7, 0x38, 0xe5, 0x71, 0xaa, 0x15, 0xa3,
+	0xec, 0xd2, 0x5f, 0x13, 0x18, 0x0a, 0x4d, 0x4e, 0x3a, 0xb1, 0x7d, 0xcb, 0x69, 0xaf, 0x54, 0x99,
+	0x2c, 0x84, 0x41, 0xbe, 0x33, 0x9c, 0xef, 0x24, 0x1d, 0x97, 0xe5, 0xdb, 0x08, 0x39, 0xfe, 0x9e,
+	0x00, 0x44, 0x6e, 0x22, 0x95, 0x68, 0xbe, 0xcb, 0xee, 0x54, 0xce, 0x17, 0x03, 0x21, 0xe9, 0xeb,
+	0x9c, 0xf4, 0x65, 0x3a, 0x23, 0x4b, 0x3a, 0x32, 0x3a, 0xb5, 0x0d, 0xdf, 0x52, 0xdd, 0xa4, 0x7f,
+	0x23, 0xb0, 0x3f, 0x69, 0x37, 0xd2, 0xa9, 0xed, 0xb9, 0x64, 0xba, 0xa3, 0xca, 0x74, 0x71, 0x20,
+	0x0a, 0xb9, 0xc9, 0x85, 0xcc, 0xd2, 0x6b, 0xb2, 0x42, 0x82, 0xff, 0x2a, 0x55, 0x85, 0x31, 0xaa,
+	0x6d, 0x04, 0x46, 0xec, 0x26, 0xfd, 0x8c, 0xc0, 0x81, 0x94, 0x9b, 0x47, 0x25, 0x78, 0x65, 0x1b,
+	0xaa, 0xca, 0x4c, 0x0f, 0x48, 0x94, 0xf4, 0x0d, 0x2e, 0x69, 0x9e, 0xce, 0xca, 0x4a, 0x62, 0x22,
+	0x50, 0x35, 0xb0, 0x1d, 0x63, 0xab, 0xf7, 0xaf, 0x04, 0xde, 0x4c, 0xb5, 0xe3, 0xd2, 0xe2, 0xdc,
+	0xc2, 0x15, 0x72, 0xa9, 0x17, 0x68, 0xaf, 0x73, 0x2e, 0xad, 0xcb, 0xa5, 0x7f, 0x24, 0xb0, 0x37,
+	0xe6, 0x65, 0x52, 0x89, 0xc9, 0xdf, 0x6d, 0x98, 0x2a, 0x17, 0x0a, 0xa2, 0x90, 0xff, 0x02, 0xe7,
+	0x7f, 0x95, 0x5e, 0x91, 0xe5, 0x1f, 0xfd, 0x97, 0xd1, 0x8d, 0x0d, 0xc9, 0x6f, 0x09, 0x40, 0x64,
+	0x42, 0xca, 0x2c, 0xfa, 0x2e, 0x57, 0x54, 0x39, 0x5f, 0x0c, 0x84, 0x02, 0x2e, 0x71, 0x01, 0xe7,
+	0xe9, 0x84, 0xac, 0x80, 0x98, 0xab, 0xf9, 0x07, 0x02, 0x07, 0x52, 0x4e, 0xa3, 0xcc, 0xea, 0xc8,
+	0xf6, 0x2e, 0x95, 0x99, 0x1e, 0x90, 0x28, 0x62, 0x9a, 0x8b, 0x98, 0xa0, 0xe7, 0xa4, 0x67, 0x91,
+	0xa0, 0xfb, 0x19, 0x81, 0xfd, 0x49, 0x17, 0x51, 0x26, 0x61, 0x65, 0x7a, 0x9f, 0xca, 0x74, 0x71,
+	0x20, 0xf2, 0xbf, 0xc3, 0xf9, 0x2f, 0xd2, 0x85, 0xa2, 0xfc, 0xb5, 0x8d, 0x98, 0xdb, 0xba, 0xa9,
+	0x05, 0xfe, 0x27, 0xfd, 0x82, 0xc0, 0xd1, 0x2d, 0xbc, 0x48, 0x7a, 0x4d, 0x7e, 0x3b, 0xcb, 0xb6,
+	0x4d, 0x95, 0xeb, 0xaf, 0x11, 0xa1, 0xd7, 0x6c, 0x26, 0xb6, 0xc7, 0x2a, 0x77, 0x40, 0x35, 0x16,
+	0xc5, 0xa4, 0xff, 0x26, 0xb0, 0x3f, 0xe9, 0x1c, 0xca, 0x8c, 0x60, 0xa6, 0xe5, 0xa9, 0x4c, 0x17,
+	0x07, 0xa2, 0xa2, 0x07, 0x5c, 0xd1, 0x12, 0xbd, 0x2b, 0x7d, 0xf2, 0x43, 0x47, 0x40, 0xdb, 0x88,
+	0xd9, 0x05, 0x9b, 0xc1, 0xcf, 0x0a, 0xaa, 0xa1, 0xf3, 0x49, 0xff, 0x4c, 0x60, 0x28, 0x34, 0x1f,
+	0x65, 0xce, 0x2f, 0x69, 0x8b, 0x54, 0x99, 0x2c, 0x84, 0x41, 0x39, 0xf7, 0xb8, 0x9c, 0xdb, 0xf4,
+	0x56, 0x5f, 0xe4, 0x70, 0xb3, 0xf4, 0x19, 0x81, 0xfd, 0x49, 0xf7, 0x4d, 0x66, 0x9c, 0x32, 0x7d,
+	0x52, 0x65, 0xba, 0x38, 0x10, 0x85, 0xdd, 0xe6, 0xc2, 0x16, 0xe8, 0x9c, 0xac, 0x30, 0xdf, 0x9e,
+	0xa9, 0x46, 0xfe, 0xa0, 0xb6, 0x81, 0xcf, 0x96, 0xb3, 0x49, 0x9f, 0x13, 0x38, 0x90, 0x6c, 0xc7,
+	0xa5, 0x85, 0xa9, 0xb9, 0x05, 0xf2, 0xdf, 0x16, 0x46, 0xe8, 0x6b, 0xab, 0x72, 0x43, 0x59, 0x6c,
+	0x93, 0x7e, 0x4e, 0x80, 0x76, 0xbb, 0x8a, 0xf4, 0xb2, 0x44, 0x76, 0xdb, 0xca, 0xe9, 0x54, 0xde,
+	0xed, 0x0d, 0x8c, 0xf2, 0xee, 0x72, 0x79, 0x37, 0xe9, 0x0d, 0x59, 0x79, 0x4c, 0xc4, 0xaa, 0x72,
+	0xa1, 0xdc, 0xe8, 0x8c, 0xed, 0xb6, 0xcf, 0x09, 0xec, 0x8b, 0x5b, 0x81, 0x54, 0x66, 0xf3, 0xef,
+	0xf6, 0x2e, 0x95, 0x8b, 0x45, 0x61, 0xa8, 0x67, 0x99, 0xeb, 0xb9, 0x4b, 0xdf, 0x7b, 0xcd, 0xd5,
+	0x95, 0xf8, 0x89, 0x93, 0xaf, 0x0a, 0x22, 0xf7, 0x4f, 0xe6, 0x0c, 0xd1, 0xe5, 0x5e, 0x2a, 0xe7,
+	0x8b, 0x81, 0x50, 0xcf, 0x43, 0xae, 0x67, 0x99, 0x56, 0x5e, 0x53, 0x0f, 0x1f, 0xac, 0xc0, 0x2a,
+	0xd2, 0x36, 0xfc, 0x17, 0x67, 0xd3, 0xbf, 0x0e, 0x0d, 0x85, 0x4e, 0xa1, 0x4c, 0x02, 0x4c, 0xbb,
+	0x9c, 0xca, 0x64, 0x21, 0x0c, 0x4a, 0x9a, 0xe7, 0x92, 0xbe, 0x4e, 0xdf, 0x95, 0x95, 0x14, 0xfd,
+	0x3c, 0x2e, 0x36, 0xd1, 0x9e, 0xfa, 0xd7, 0x87, 0xa4, 0x3d, 0x48, 0x65, 0x4f, 0x09, 0x5d, 0xbe,
+	0xa6, 0x32, 0xd3, 0x03, 0xb2, 0xd7, 0x2b, 0x7f, 0xda, 0x20, 0xa5, 0xbf, 0x24, 0xb0, 0x47, 0xf8,
+	0x60, 0x54, 0xe2, 0x06, 0x9f, 0x72, 0x3f, 0x95, 0x89, 0x22, 0x90, 0x5e, 0x8f, 0x75, 0xe2, 0x37,
+	0x74, 0xf4, 0x4f, 0xfe, 0xa1, 0x20, 0x61, 0xfd, 0x49, 0x1d, 0x0a, 0xb2, 0x3c, 0x49, 0x65, 0xba,
+	0x38, 0x10, 0xf9, 0x5f, 0xe5, 0xfc, 0x67, 0xe8, 0x54, 0x51, 0xfe, 0x1a, 0x9a, 0x8b, 0x9f, 0x12,
+	0xd8, 0x17, 0x77, 0xea, 0x64, 0x12, 0x55, 0x86, 0x7f, 0xa8, 0x5c, 0x2c, 0x0a, 0x43, 0x01, 0x57,
+	0xb8, 0x80, 0x29, 0x7a, 0x41, 0x56, 0x40, 0xc2, 0x88, 0xa4, 0x7f, 0x21, 0xf0, 0x46, 0xc2, 0x07,
+	0xa4, 0x17, 0xe5, 0xcd, 0xaa, 0xb8, 0xe1, 0xa8, 0x4c, 0x15, 0xc6, 0xf5, 0x6a, 0x05, 0x04, 0x8f,
+	0x55, 0x74, 0x12, 0xa3, 0xb5, 0x3c, 0x7b, 0xff, 0xe9, 0xcb, 0x51, 0xf2, 0xec, 0xe5, 0x28, 0xf9,
+	0xcf, 0xcb, 0x51, 0xf2, 0xc3, 0x57, 0xa3, 0x3b, 0x9e, 0xbd, 0x1a, 0xdd, 0xf1, 0xcf, 0x57, 0xa3,
+	0x3b, 0x1e, 0xce, 0xc4, 0x0c, 0xca, 0xbc, 0x56, 0x9e, 0x24, 0x86, 0x7a, 0xdd, 0x66, 0x6e, 0xad,
+	0xc4, 0x7f, 0xc2, 0x39, 0xf9, 0xbf, 0x01, 0x00, 0x2e, 0x31, 0xd7, 0xe2, 0x24, 0x2d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a Member using their wallet address
+	Member(ctx context.Context, in *QueryMemberRequest, opts ...grpc.CallOption) (*QueryMemberResponse, error)
+	// Queries a list of Members items.
+	Members(ctx context.Context, in *QueryMembersRequest, opts ...grpc.CallOption) (*QueryMembersResponse, error)
+	// Queries a list of Guardians items.
+	Guardians(ctx context.Context, in *QueryGuardiansRequest, opts ...grpc.CallOption) (*QueryGuardiansResponse, error)
+	// Queries an Invitation using the hash of its secret
+	Invitation(ctx context.Context, in *QueryInvitationRequest, opts ...grpc.CallOption) (*QueryInvitationResponse, error)
+	// Queries the open recall petition against a member
+	RecallPetition(ctx context.Context, in *QueryRecallPetitionRequest, opts ...grpc.CallOption) (*QueryRecallPetitionResponse, error)
+	// Queries the expulsion appeal of a member
+	ExpulsionAppeal(ctx context.Context, in *QueryExpulsionAppealRequest, opts ...grpc.CallOption) (*QueryExpulsionAppealResponse, error)
+	// Queries a list of ExpulsionAppeal items.
+	ExpulsionAppeals(ctx context.Context, in *QueryExpulsionAppealsRequest, opts ...grpc.CallOption) (*QueryExpulsionAppealsResponse, error)
+	// Queries the current suspension and suspension history of a member
+	Suspensions(ctx context.Context, in *QuerySuspensionsRequest, opts ...grpc.CallOption) (*QuerySuspensionsResponse, error)
+	// Queries the declared guardian candidates
+	Candidates(ctx context.Context, in *QueryCandidatesRequest, opts ...grpc.CallOption) (*QueryCandidatesResponse, error)
+	// Queries the guardian election that is currently open, if any
+	CurrentElection(ctx context.Context, in *QueryCurrentElectionRequest, opts ...grpc.CallOption) (*QueryCurrentElectionResponse, error)
+	// Queries the result of a closed guardian election
+	ElectionResult(ctx context.Context, in *QueryElectionResultRequest, opts ...grpc.CallOption) (*QueryElectionResultResponse, error)
+	// Queries the guardian terms that expire next, soonest first
+	GuardianTermExpirations(ctx context.Context, in *QueryGuardianTermExpirationsRequest, opts ...grpc.CallOption) (*QueryGuardianTermExpirationsResponse, error)
+	// Queries how each chamber voted on a tallied proposal
+	TallyBreakdown(ctx context.Context, in *QueryTallyBreakdownRequest, opts ...grpc.CallOption) (*QueryTallyBreakdownResponse, error)
+	// Queries the quorum, threshold and veto threshold that apply to a proposal
+	TallyRule(ctx context.Context, in *QueryTallyRuleRequest, opts ...grpc.CallOption) (*QueryTallyRuleResponse, error)
+	// Queries a member's vote delegation
+	VoteDelegation(ctx context.Context, in *QueryVoteDelegationRequest, opts ...grpc.CallOption) (*QueryVoteDelegationResponse, error)
+	// Queries the vote delegations made to a member
+	VoteDelegations(ctx context.Context, in *QueryVoteDelegationsRequest, opts ...grpc.CallOption) (*QueryVoteDelegationsResponse, error)
+	// Queries the votes a member would cast on a proposal, including those
+	// delegated to them
+	EffectiveVotePower(ctx context.Context, in *QueryEffectiveVotePowerRequest, opts ...grpc.CallOption) (*QueryEffectiveVotePowerResponse, error)
+	// Queries a secret ballot proposal's commit and reveal windows
+	SecretBallot(ctx context.Context, in *QuerySecretBallotRequest, opts ...grpc.CallOption) (*QuerySecretBallotResponse, error)
+	// Queries a member's vote commitment on a secret ballot proposal
+	VoteCommit(ctx context.Context, in *QueryVoteCommitRequest, opts ...grpc.CallOption) (*QueryVoteCommitResponse, error)
+	// Queries the fee-free transactions a member has left in the current epoch
+	FeeWaiver(ctx context.Context, in *QueryFeeWaiverRequest, opts ...grpc.CallOption) (*QueryFeeWaiverResponse, error)
+	// Queries the x/group group that mirrors the electorate
+	ElectorateGroup(ctx context.Context, in *QueryElectorateGroupRequest, opts ...grpc.CallOption) (*QueryElectorateGroupResponse, error)
+	// Queries the balance of the membership treasury
+	Treasury(ctx context.Context, in *QueryTreasuryRequest, opts ...grpc.CallOption) (*QueryTreasuryResponse, error)
+	// Queries the history of spends from the membership treasury
+	TreasurySpends(ctx context.Context, in *QueryTreasurySpendsRequest, opts ...grpc.CallOption) (*QueryTreasurySpendsResponse, error)
+	// Queries the member dividend's reward index and unclaimed reserve
+	DividendPool(ctx context.Context, in *QueryDividendPoolRequest, opts ...grpc.CallOption) (*QueryDividendPoolResponse, error)
+	// Queries the member dividend owed to a member
+	MemberRewards(ctx context.Context, in *QueryMemberRewardsRequest, opts ...grpc.CallOption) (*QueryMemberRewardsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/membershipmodule.membership.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Member(ctx context.Context, in *QueryMemberRequest, opts ...grpc.CallOption) (*QueryMemberResponse, error) {
+	out := new(QueryMemberResponse)
+	err := c.cc.Invoke(ctx, "/membershipmodule.membership.Query/Member", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Members(ctx context.Context, in *QueryMembersRequest, opts ...grpc.CallOption) (*QueryMembersResponse, error) {
+	out := new(QueryMembersResponse)
+	err := c.cc.Invoke(ctx, "/membershipmodule.membership.Query/Members", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Guardians(ctx context.Context, in *QueryGuardiansRequest, opts ...grpc.CallOption) (*QueryGuardiansResponse, error) {
+	out := new(QueryGuardiansResponse)
+	err := c.cc.Invoke(ctx, "/membershipmodule.membership.Query/Guardians", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Invitation(ctx context.Context, in *QueryInvitationRequest, opts ...grpc.CallOption) (*QueryInvitationResponse, error) {
+	out := new(QueryInvitationResponse)
+	err := c.cc.Invoke(ctx, "/membershipmodule.membership.Query/Invitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecallPetition(ctx context.Context, in *QueryRecallPetitionRequest, opts ...grpc.CallOption) (*QueryRecallPetitionResponse, error) {
+	out := new(QueryRecallPetitionResponse)
+	err := c.cc.Invoke(ctx, "/membershipmodule.membership.Query/RecallPetition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExpulsionAppeal(ctx context.Context, in *QueryExpulsionAppealRequest, opts ...grpc.CallOption) (*QueryExpulsionAppealResponse, error) {
+	out := new(QueryExpulsionAppealResponse)
+	err := c.cc.Invoke(ctx, "/membershipmodule.membership.Query/ExpulsionAppeal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExpulsionAppeals(ctx context.Context, in *QueryExpulsionAppealsRequest, opts ...grpc.CallOption) (*QueryExpulsionAppealsResponse, error) {
+	out := new(QueryExpulsionAppealsResponse)
+	err := c.cc.Invoke(ctx, "/membershipmodule.membership.Query/ExpulsionAppeals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Suspensions(ctx context.Context, in *QuerySuspensionsRequest, opts ...grpc.CallOption) (*QuerySuspensionsResponse, error) {
+	out := new(QuerySuspensionsResponse)
+	err := c.cc.Invoke(ctx, "/membershipmodule.membership.Query/Suspensions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Candidates(ctx context.Context, in *QueryCandidatesRequest, opts ...grpc.CallOption) (*QueryCandidatesResponse, error) {
+	out := new(QueryCandidatesResponse)
+	err := c.cc.Invoke(ctx, "/membershipmodule.membership.Query/Candidates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CurrentElection(ctx context.Context, in *QueryCurrentElectionRequest, opts ...grpc.CallOption) (*QueryCurrentElectionResponse, error) {
+	out := new(QueryCurrentElectionResponse)
+	err := c.cc.Invoke(ctx, "/membershipmodule.membership.Query/CurrentElection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ElectionResult(ctx context.Context, in *QueryElectionResultRequest, opts ...grpc.CallOption) (*QueryElectionResultResponse, error) {
+	out := new(QueryElectionResultResponse)
+	err := c.cc.Invoke(ctx, "/membershipmodule.membership.Query/ElectionResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GuardianTermExpirations(ctx context.Context, in *QueryGuardianTermExpirationsRequest, opts ...grpc.CallOption) (*QueryGuardianTermExpirationsResponse, error) {
+	out := new(QueryGuardianTermExpirationsResponse)
+	err := c.cc.Invoke(ctx, "/membershipmodule.membership.Query/GuardianTermExpirations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TallyBreakdown(ctx context.Context, in *QueryTallyBreakdownRequest, opts ...grpc.CallOption) (*QueryTallyBreakdownResponse, error) {
+	out := new(QueryTallyBreakdownResponse)
+	err := c.cc.Invoke(ctx, "/membershipmodule.membership.Query/TallyBreakdown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TallyRule(ctx context.Context, in *QueryTallyRuleRequest, opts ...grpc.CallOption) (*QueryTallyRuleResponse, error) {
+	out := new(QueryTallyRuleResponse)
+	err := c.cc.Invoke(ctx, "/membershipmodule.membership.Query/TallyRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VoteDelegation(ctx context.Context, in *QueryVoteDelegationRequest, opts ...grpc.CallOption) (*QueryVoteDelegationResponse, error) {
+	out := new(QueryVoteDelegationResponse)
+	err := c.cc.Invoke(ctx, "/membershipmodule.membership.Query/VoteDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VoteDelegations(ctx context.Context, in *QueryVoteDelegationsRequest, opts ...grpc.CallOption) (*QueryVoteDelegationsResponse, error) {
+	out := new(QueryVoteDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/membershipmodule.membership.Query/VoteDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EffectiveVotePower(ctx context.Context, in *QueryEffectiveVotePowerRequest, opts ...grpc.CallOption) (*QueryEffectiveVotePowerResponse, error) {
+	out := new(QueryEffectiveVotePowerResponse)
+	err := c.cc.Invoke(ctx, "/membershipmodule.membership.Query/EffectiveVotePower", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SecretBallot(ctx context.Context, in *QuerySecretBallotRequest, opts ...grpc.CallOption) (*QuerySecretBallotResponse, error) {
+	out := new(QuerySecretBallotResponse)
+	err := c.cc.Invoke(ctx, "/membershipmodule.membership.Query/SecretBallot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VoteCommit(ctx context.Context, in *QueryVoteCommitRequest, opts ...grpc.CallOption) (*QueryVoteCommitResponse, error) {
+	out := new(QueryVoteCommitResponse)
+	err := c.cc.Invoke(ctx, "/membershipmodule.membership.Query/VoteCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeWaiver(ctx context.Context, in *QueryFeeWaiverRequest, opts ...grpc.CallOption) (*QueryFeeWaiverResponse, error) {
+	out := new(QueryFeeWaiverResponse)
+	err := c.cc.Invoke(ctx, "/membershipmodule.membership.Query/FeeWaiver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ElectorateGroup(ctx context.Context, in *QueryElectorateGroupRequest, opts ...grpc.CallOption) (*QueryElectorateGroupResponse, error) {
+	out := new(QueryElectorateGroupResponse)
+	err := c.cc.Invoke(ctx, "/membershipmodule.membership.Query/ElectorateGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Treasury(ctx context.Context, in *QueryTreasuryRequest, opts ...grpc.CallOption) (*QueryTreasuryResponse, error) {
+	out := new(QueryTreasuryResponse)
+	err := c.cc.Invoke(ctx, "/membershipmodule.membership.Query/Treasury", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TreasurySpends(ctx context.Context, in *QueryTreasurySpendsRequest, opts ...grpc.CallOption) (*QueryTreasurySpendsResponse, error) {
+	out := new(QueryTreasurySpendsResponse)
+	err := c.cc.Invoke(ctx, "/membershipmodule.membership.Query/TreasurySpends", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DividendPool(ctx context.Context, in *QueryDividendPoolRequest, opts ...grpc.CallOption) (*QueryDividendPoolResponse, error) {
+	out := new(QueryDividendPoolResponse)
+	err := c.cc.Invoke(ctx, "/membershipmodule.membership.Query/DividendPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MemberRewards(ctx context.Context, in *QueryMemberRewardsRequest, opts ...grpc.CallOption) (*QueryMemberRewardsResponse, error) {
+	out := new(QueryMemberRewardsResponse)
+	err := c.cc.Invoke(ctx, "/membershipmodule.membership.Query/MemberRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a Member using their wallet address
+	Member(context.Context, *QueryMemberRequest) (*QueryMemberResponse, error)
+	// Queries a list of Members items.
+	Members(context.Context, *QueryMembersRequest) (*QueryMembersResponse, error)
+	// Queries a list of Guardians items.
+	Guardians(context.Context, *QueryGuardiansRequest) (*QueryGuardiansResponse, error)
+	// Queries an Invitation using the hash of its secret
+	Invitation(context.Context, *QueryInvitationRequest) (*QueryInvitationResponse, error)
+	// Queries the open recall petition against a member
+	RecallPetition(context.Context, *QueryRecallPetitionRequest) (*QueryRecallPetitionResponse, error)
+	// Queries the expulsion appeal of a member
+	ExpulsionAppeal(context.Context, *QueryExpulsionAppealRequest) (*QueryExpulsionAppealResponse, error)
+	// Queries a list of ExpulsionAppeal items.
+	ExpulsionAppeals(context.Context, *QueryExpulsionAppealsRequest) (*QueryExpulsionAppealsResponse, error)
+	// Queries the current suspension and suspension history of a member
+	Suspensions(context.Context, *QuerySuspensionsRequest) (*QuerySuspensionsResponse, error)
+	// Queries the declared guardian candidates
+	Candidates(context.Context, *QueryCandidatesRequest) (*QueryCandidatesResponse, error)
+	// Queries the guardian election that is currently open, if any
+	CurrentElection(context.Context, *QueryCurrentElectionRequest) (*QueryCurrentElectionResponse, error)
+	// Queries the result of a closed guardian election
+	ElectionResult(context.Context, *QueryElectionResultRequest) (*QueryElectionResultResponse, error)
+	// Queries the guardian terms that expire next, soonest first
+	GuardianTermExpirations(context.Context, *QueryGuardianTermExpirationsRequest) (*QueryGuardianTermExpirationsResponse, error)
+	// Queries how each chamber voted on a tallied proposal
+	TallyBreakdown(context.Context, *QueryTallyBreakdownRequest) (*QueryTallyBreakdownResponse, error)
+	// Queries the quorum, threshold and veto threshold that apply to a proposal
+	TallyRule(context.Context, *QueryTallyRuleRequest) (*QueryTallyRuleResponse, error)
+	// Queries a member's vote delegation
+	VoteDelegation(context.Context, *QueryVoteDelegationRequest) (*QueryVoteDelegationResponse, error)
+	// Queries the vote delegations made to a member
+	VoteDelegations(context.Context, *QueryVoteDelegationsRequest) (*QueryVoteDelegationsResponse, error)
+	// Queries the votes a member would cast on a proposal, including those
+	// delegated to them
+	EffectiveVotePower(context.Context, *QueryEffectiveVotePowerRequest) (*QueryEffectiveVotePowerResponse, error)
+	// Queries a secret ballot proposal's commit and reveal windows
+	SecretBallot(context.Context, *QuerySecretBallotRequest) (*QuerySecretBallotResponse, error)
+	// Queries a member's vote commitment on a secret ballot proposal
+	VoteCommit(context.Context, *QueryVoteCommitRequest) (*QueryVoteCommitResponse, error)
+	// Queries the fee-free transactions a member has left in the current epoch
+	FeeWaiver(context.Context, *QueryFeeWaiverRequest) (*QueryFeeWaiverResponse, error)
+	// Queries the x/group group that mirrors the electorate
+	ElectorateGroup(context.Context, *QueryElectorateGroupRequest) (*QueryElectorateGroupResponse, error)
+	// Queries the balance of the membership treasury
+	Treasury(context.Context, *QueryTreasuryRequest) (*QueryTreasuryResponse, error)
+	// Queries the history of spends from the membership treasury
+	TreasurySpends(context.Context, *QueryTreasurySpendsRequest) (*QueryTreasurySpendsResponse, error)
+	// Queries the member dividend's reward index and unclaimed reserve
+	DividendPool(context.Context, *QueryDividendPoolRequest) (*QueryDividendPoolResponse, error)
+	// Queries the member dividend owed to a member
+	MemberRewards(context.Context, *QueryMemberRewardsRequest) (*QueryMemberRewardsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Member(ctx context.Context, req *QueryMemberRequest) (*QueryMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Member not implemented")
+}
+func (*UnimplementedQueryServer) Members(ctx context.Context, req *QueryMembersRequest) (*QueryMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Members not implemented")
+}
+func (*UnimplementedQueryServer) Guardians(ctx context.Context, req *QueryGuardiansRequest) (*QueryGuardiansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Guardians not implemented")
+}
+func (*UnimplementedQueryServer) Invitation(ctx context.Context, req *QueryInvitationRequest) (*QueryInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Invitation not implemented")
+}
+func (*UnimplementedQueryServer) RecallPetition(ctx context.Context, req *QueryRecallPetitionRequest) (*QueryRecallPetitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecallPetition not implemented")
+}
+func (*UnimplementedQueryServer) ExpulsionAppeal(ctx context.Context, req *QueryExpulsionAppealRequest) (*QueryExpulsionAppealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpulsionAppeal not implemented")
+}
+func (*UnimplementedQueryServer) ExpulsionAppeals(ctx context.Context, req *QueryExpulsionAppealsRequest) (*QueryExpulsionAppealsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpulsionAppeals not implemented")
+}
+func (*UnimplementedQueryServer) Suspensions(ctx context.Context, req *QuerySuspensionsRequest) (*QuerySuspensionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Suspensions not implemented")
+}
+func (*UnimplementedQueryServer) Candidates(ctx context.Context, req *QueryCandidatesRequest) (*QueryCandidatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Candidates not implemented")
+}
+func (*UnimplementedQueryServer) CurrentElection(ctx context.Context, req *QueryCurrentElectionRequest) (*QueryCurrentElectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentElection not implemented")
+}
+func (*UnimplementedQueryServer) ElectionResult(ctx context.Context, req *QueryElectionResultRequest) (*QueryElectionResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ElectionResult not implemented")
+}
+func (*UnimplementedQueryServer) GuardianTermExpirations(ctx context.Context, req *QueryGuardianTermExpirationsRequest) (*QueryGuardianTermExpirationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GuardianTermExpirations not implemented")
+}
+func (*UnimplementedQueryServer) TallyBreakdown(ctx context.Context, req *QueryTallyBreakdownRequest) (*QueryTallyBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TallyBreakdown not implemented")
+}
+func (*UnimplementedQueryServer) TallyRule(ctx context.Context, req *QueryTallyRuleRequest) (*QueryTallyRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TallyRule not implemented")
+}
+func (*UnimplementedQueryServer) VoteDelegation(ctx context.Context, req *QueryVoteDelegationRequest) (*QueryVoteDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteDelegation not implemented")
+}
+func (*UnimplementedQueryServer) VoteDelegations(ctx context.Context, req *QueryVoteDelegationsRequest) (*QueryVoteDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteDelegations not implemented")
+}
+func (*UnimplementedQueryServer) EffectiveVotePower(ctx context.Context, req *QueryEffectiveVotePowerRequest) (*QueryEffectiveVotePowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EffectiveVotePower not implemented")
+}
+func (*UnimplementedQueryServer) SecretBallot(ctx context.Context, req *QuerySecretBallotRequest) (*QuerySecretBallotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecretBallot not implemented")
+}
+func (*UnimplementedQueryServer) VoteCommit(ctx context.Context, req *QueryVoteCommitRequest) (*QueryVoteCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteCommit not implemented")
+}
+func (*UnimplementedQueryServer) FeeWaiver(ctx context.Context, req *QueryFeeWaiverRequest) (*QueryFeeWaiverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeWaiver not implemented")
+}
+func (*UnimplementedQueryServer) ElectorateGroup(ctx context.Context, req *QueryElectorateGroupRequest) (*QueryElectorateGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ElectorateGroup not implemented")
+}
+func (*UnimplementedQueryServer) Treasury(ctx context.Context, req *QueryTreasuryRequest) (*QueryTreasuryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Treasury not implemented")
+}
+func (*UnimplementedQueryServer) TreasurySpends(ctx context.Context, req *QueryTreasurySpendsRequest) (*QueryTreasurySpendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TreasurySpends not implemented")
+}
+func (*UnimplementedQueryServer) DividendPool(ctx context.Context, req *QueryDividendPoolRequest) (*QueryDividendPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DividendPool not implemented")
+}
+func (*UnimplementedQueryServer) MemberRewards(ctx context.Context, req *QueryMemberRewardsRequest) (*QueryMemberRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MemberRewards not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/membershipmodule.membership.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Member_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Member(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/membershipmodule.membership.Query/Member",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Member(ctx, req.(*QueryMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Members_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Members(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/membershipmodule.membership.Query/Members",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Members(ctx, req.(*QueryMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Guardians_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGuardiansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Guardians(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/membershipmodule.membership.Query/Guardians",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Guardians(ctx, req.(*QueryGuardiansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Invitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Invitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/membershipmodule.membership.Query/Invitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Invitation(ctx, req.(*QueryInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecallPetition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecallPetitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecallPetition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/membershipmodule.membership.Query/RecallPetition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecallPetition(ctx, req.(*QueryRecallPetitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExpulsionAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExpulsionAppealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExpulsionAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/membershipmodule.membership.Query/ExpulsionAppeal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExpulsionAppeal(ctx, req.(*QueryExpulsionAppealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExpulsionAppeals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExpulsionAppealsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExpulsionAppeals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/membershipmodule.membership.Query/ExpulsionAppeals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExpulsionAppeals(ctx, req.(*QueryExpulsionAppealsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Suspensions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySuspensionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Suspensions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/membershipmodule.membership.Query/Suspensions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Suspensions(ctx, req.(*QuerySuspensionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Candidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCandidatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Candidates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/membershipmodule.membership.Query/Candidates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Candidates(ctx, req.(*QueryCandidatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CurrentElection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCurrentElectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CurrentElection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/membershipmodule.membership.Query/CurrentElection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CurrentElection(ctx, req.(*QueryCurrentElectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ElectionResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryElectionResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ElectionResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/membershipmodule.membership.Query/ElectionResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ElectionResult(ctx, req.(*QueryElectionResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GuardianTermExpirations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGuardianTermExpirationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GuardianTermExpirations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/membershipmodule.membership.Query/GuardianTermExpirations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GuardianTermExpirations(ctx, req.(*QueryGuardianTermExpirationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TallyBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTallyBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TallyBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/membershipmodule.membership.Query/TallyBreakdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TallyBreakdown(ctx, req.(*QueryTallyBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TallyRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTallyRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TallyRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/membershipmodule.membership.Query/TallyRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TallyRule(ctx, req.(*QueryTallyRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VoteDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/membershipmodule.membership.Query/VoteDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteDelegation(ctx, req.(*QueryVoteDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VoteDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/membershipmodule.membership.Query/VoteDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteDelegations(ctx, req.(*QueryVoteDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EffectiveVotePower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEffectiveVotePowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EffectiveVotePower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/membershipmodule.membership.Query/EffectiveVotePower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EffectiveVotePower(ctx, req.(*QueryEffectiveVotePowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SecretBallot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySecretBallotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SecretBallot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/membershipmodule.membership.Query/SecretBallot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SecretBallot(ctx, req.(*QuerySecretBallotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VoteCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/membershipmodule.membership.Query/VoteCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteCommit(ctx, req.(*QueryVoteCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeWaiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeWaiverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeWaiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/membershipmodule.membership.Query/FeeWaiver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeWaiver(ctx, req.(*QueryFeeWaiverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ElectorateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryElectorateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ElectorateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/membershipmodule.membership.Query/ElectorateGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ElectorateGroup(ctx, req.(*QueryElectorateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Treasury_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTreasuryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Treasury(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/membershipmodule.membership.Query/Treasury",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Treasury(ctx, req.(*QueryTreasuryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TreasurySpends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTreasurySpendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TreasurySpends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/membershipmodule.membership.Query/TreasurySpends",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TreasurySpends(ctx, req.(*QueryTreasurySpendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DividendPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDividendPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DividendPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/membershipmodule.membership.Query/DividendPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DividendPool(ctx, req.(*QueryDividendPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MemberRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMemberRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MemberRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/membershipmodule.membership.Query/MemberRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MemberRewards(ctx, req.(*QueryMemberRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "membershipmodule.membership.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Member",
+			Handler:    _Query_Member_Handler,
+		},
+		{
+			MethodName: "Members",
+			Handler:    _Query_Members_Handler,
+		},
+		{
+			MethodName: "Guardians",
+			Handler:    _Query_Guardians_Handler,
+		},
+		{
+			MethodName: "Invitation",
+			Handler:    _Query_Invitation_Handler,
+		},
+		{
+			MethodName: "RecallPetition",
+			Handler:    _Query_RecallPetition_Handler,
+		},
+		{
+			MethodName: "ExpulsionAppeal",
+			Handler:    _Query_ExpulsionAppeal_Handler,
+		},
+		{
+			MethodName: "ExpulsionAppeals",
+			Handler:    _Query_ExpulsionAppeals_Handler,
+		},
+		{
+			MethodName: "Suspensions",
+			Handler:    _Query_Suspensions_Handler,
+		},
+		{
+			MethodName: "Candidates",
+			Handler:    _Query_Candidates_Handler,
+		},
+		{
+			MethodName: "CurrentElection",
+			Handler:    _Query_CurrentElection_Handler,
+		},
+		{
+			MethodName: "ElectionResult",
+			Handler:    _Query_ElectionResult_Handler,
+		},
+		{
+			MethodName: "GuardianTermExpirations",
+			Handler:    _Query_GuardianTermExpirations_Handler,
+		},
+		{
+			MethodName: "TallyBreakdown",
+			Handler:    _Query_TallyBreakdown_Handler,
+		},
+		{
+			MethodName: "TallyRule",
+			Handler:    _Query_TallyRule_Handler,
+		},
+		{
+			MethodName: "VoteDelegation",
+			Handler:    _Query_VoteDelegation_Handler,
+		},
+		{
+			MethodName: "VoteDelegations",
+			Handler:    _Query_VoteDelegations_Handler,
+		},
+		{
+			MethodName: "EffectiveVotePower",
+			Handler:    _Query_EffectiveVotePower_Handler,
+		},
+		{
+			MethodName: "SecretBallot",
+			Handler:    _Query_SecretBallot_Handler,
+		},
+		{
+			MethodName: "VoteCommit",
+			Handler:    _Query_VoteCommit_Handler,
+		},
+		{
+			MethodName: "FeeWaiver",
+			Handler:    _Query_FeeWaiver_Handler,
+		},
+		{
+			MethodName: "ElectorateGroup",
+			Handler:    _Query_ElectorateGroup_Handler,
+		},
+		{
+			MethodName: "Treasury",
+			Handler:    _Query_Treasury_Handler,
+		},
+		{
+			MethodName: "TreasurySpends",
+			Handler:    _Query_TreasurySpends_Handler,
+		},
+		{
+			MethodName: "DividendPool",
+			Handler:    _Query_DividendPool_Handler,
+		},
+		{
+			MethodName: "MemberRewards",
+			Handler:    _Query_MemberRewards_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "membershipmodule/membership/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMemberRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMemberRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMemberRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMemberResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMemberResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Invitation) > 0 {
+		i -= len(m.Invitation)
+		copy(dAtA[i:], m.Invitation)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Invitation)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Member != nil {
+		{
+			size, err := m.Member.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMembersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMembersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMembersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMembersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGuardiansRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGuardiansRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGuardiansRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGuardiansResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGuardiansResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGuardiansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalVotingWeight != nil {
+		{
+			size := m.TotalVotingWeight.Size()
+			i -= size
+			if _, err := m.TotalVotingWeight.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInvitationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInvitationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInvitationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInvitationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInvitationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInvitationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Invitation != nil {
+		{
+			size, err := m.Invitation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecallPetitionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecallPetitionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecallPetitionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecallPetitionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecallPetitionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecallPetitionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Petition != nil {
+		{
+			size, err := m.Petition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExpulsionAppealRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpulsionAppealRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpulsionAppealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExpulsionAppealResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpulsionAppealResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpulsionAppealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Appeal != nil {
+		{
+			size, err := m.Appeal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExpulsionAppealsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpulsionAppealsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpulsionAppealsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExpulsionAppealsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpulsionAppealsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpulsionAppealsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Appeals) > 0 {
+		for iNdEx := len(m.Appeals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Appeals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySuspensionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySuspensionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySuspensionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySuspensionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySuspensionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySuspensionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Current != nil {
+		{
+			size, err := m.Current.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCandidatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCandidatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCandidatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCandidatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCandidatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCandidatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Candidates) > 0 {
+		for iNdEx := len(m.Candidates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Candidates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentElectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentElectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentElectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentElectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentElectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentElectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Election != nil {
+		{
+			size, err := m.Election.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryElectionResultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryElectionResultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryElectionResultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ElectionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ElectionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryElectionResultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryElectionResultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryElectionResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGuardianTermExpirationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGuardianTermExpirationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGuardianTermExpirationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGuardianTermExpirationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGuardianTermExpirationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGuardianTermExpirationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Terms) > 0 {
+		for iNdEx := len(m.Terms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Terms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTallyBreakdownRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTallyBreakdownRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTallyBreakdownRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTallyBreakdownResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTallyBreakdownResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTallyBreakdownResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Breakdown != nil {
+		{
+			size, err := m.Breakdown.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTallyRuleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTallyRuleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTallyRuleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTallyRuleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTallyRuleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTallyRuleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MatchedMsgTypeUrls) > 0 {
+		for iNdEx := len(m.MatchedMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MatchedMsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MatchedMsgTypeUrls[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.MatchedMsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Rule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Delegation != nil {
+		{
+			size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveVotePowerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveVotePowerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveVotePowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveVotePowerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveVotePowerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveVotePowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegators) > 0 {
+		for iNdEx := len(m.Delegators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Delegators[iNdEx])
+			copy(dAtA[i:], m.Delegators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegators[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.VotingPower.Size()
+		i -= size
+		if _, err := m.VotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Votes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Votes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySecretBallotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySecretBallotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySecretBallotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySecretBallotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySecretBallotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySecretBallotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reveals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Reveals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Commits != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Commits))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Ballot != nil {
+		{
+			size, err := m.Ballot.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteCommitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteCommitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteCommitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeWaiverRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeWaiverRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeWaiverRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeWaiverResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeWaiverResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeWaiverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remaining != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Remaining))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Eligible {
+		i--
+		if m.Eligible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryElectorateGroupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryElectorateGroupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryElectorateGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryElectorateGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryElectorateGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryElectorateGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ElectorateGroup.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTreasuryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasuryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTreasuryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasuryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Committed) > 0 {
+		for iNdEx := len(m.Committed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Committed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTreasurySpendsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasurySpendsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasurySpendsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTreasurySpendsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasurySpendsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasurySpendsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Spends) > 0 {
+		for iNdEx := len(m.Spends) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spends[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDividendPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDividendPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDividendPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDividendPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDividendPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDividendPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DividendPool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMemberRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMemberRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMemberRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMemberRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMemberRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMemberRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMemberRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMemberResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Member != nil {
+		l = m.Member.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Invitation)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMembersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMembersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGuardiansRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGuardiansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TotalVotingWeight != nil {
+		l = m.TotalVotingWeight.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInvitationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInvitationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Invitation != nil {
+		l = m.Invitation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecallPetitionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecallPetitionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Petition != nil {
+		l = m.Petition.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExpulsionAppealRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExpulsionAppealResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Appeal != nil {
+		l = m.Appeal.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExpulsionAppealsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExpulsionAppealsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Appeals) > 0 {
+		for _, e := range m.Appeals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySuspensionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySuspensionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Current != nil {
+		l = m.Current.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCandidatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCandidatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Candidates) > 0 {
+		for _, e := range m.Candidates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCurrentElectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCurrentElectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Election != nil {
+		l = m.Election.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryElectionResultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ElectionId != 0 {
+		n += 1 + sovQuery(uint64(m.ElectionId))
+	}
+	return n
+}
+
+func (m *QueryElectionResultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGuardianTermExpirationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGuardianTermExpirationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Terms) > 0 {
+		for _, e := range m.Terms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTallyBreakdownRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryTallyBreakdownResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Breakdown != nil {
+		l = m.Breakdown.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTallyRuleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryTallyRuleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rule.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.MatchedMsgTypeUrls) > 0 {
+		for _, s := range m.MatchedMsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVoteDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Delegation != nil {
+		l = m.Delegation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEffectiveVotePowerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryEffectiveVotePowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Votes != 0 {
+		n += 1 + sovQuery(uint64(m.Votes))
+	}
+	l = m.VotingPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Delegators) > 0 {
+		for _, s := range m.Delegators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySecretBallotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QuerySecretBallotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ballot != nil {
+		l = m.Ballot.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Commits != 0 {
+		n += 1 + sovQuery(uint64(m.Commits))
+	}
+	if m.Reveals != 0 {
+		n += 1 + sovQuery(uint64(m.Reveals))
+	}
+	return n
+}
+
+func (m *QueryVoteCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteCommitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeWaiverRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeWaiverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Eligible {
+		n += 2
+	}
+	l = m.Usage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Remaining != 0 {
+		n += 1 + sovQuery(uint64(m.Remaining))
+	}
+	return n
+}
+
+func (m *QueryElectorateGroupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryElectorateGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ElectorateGroup.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTreasuryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTreasuryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Committed) > 0 {
+		for _, e := range m.Committed {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTreasurySpendsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTreasurySpendsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Spends) > 0 {
+		for _, e := range m.Spends {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDividendPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDividendPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DividendPool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMemberRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMemberRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMemberRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMemberRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMemberRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMemberResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMemberResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Member == nil {
+				m.Member = &Member{}
+			}
+			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invitation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Invitation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMembersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMembersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMembersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMembersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, &Member{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGuardiansRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGuardiansRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGuardiansRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGuardiansResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGuardiansResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGuardiansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, &Member{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingWeight", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.TotalVotingWeight = &v
+			if err := m.TotalVotingWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInvitationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInvitationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInvitationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInvitationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInvitationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInvitationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invitation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Invitation == nil {
+				m.Invitation = &Invitation{}
+			}
+			if err := m.Invitation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecallPetitionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecallPetitionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecallPetitionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecallPetitionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecallPetitionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecallPetitionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Petition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Petition == nil {
+				m.Petition = &RecallPetition{}
+			}
+			if err := m.Petition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExpulsionAppealRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExpulsionAppealRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExpulsionAppealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExpulsionAppealResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExpulsionAppealResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExpulsionAppealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Appeal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Appeal == nil {
+				m.Appeal = &ExpulsionAppeal{}
+			}
+			if err := m.Appeal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExpulsionAppealsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExpulsionAppealsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExpulsionAppealsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExpulsionAppealsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExpulsionAppealsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExpulsionAppealsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Appeals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Appeals = append(m.Appeals, ExpulsionAppeal{})
+			if err := m.Appeals[len(m.Appeals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySuspensionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySuspensionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySuspensionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySuspensionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySuspensionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySuspensionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Current == nil {
+				m.Current = &Suspension{}
+			}
+			if err := m.Current.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, Suspension{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCandidatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCandidatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCandidatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCandidatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCandidatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCandidatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candidates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Candidates = append(m.Candidates, Candidacy{})
+			if err := m.Candidates[len(m.Candidates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentElectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentElectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentElectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentElectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentElectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentElectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Election", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Election == nil {
+				m.Election = &Election{}
+			}
+			if err := m.Election.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryElectionResultRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryElectionResultRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryElectionResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElectionId", wireType)
+			}
+			m.ElectionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElectionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryElectionResultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryElectionResultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryElectionResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &ElectionResult{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGuardianTermExpirationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGuardianTermExpirationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGuardianTermExpirationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGuardianTermExpirationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGuardianTermExpirationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGuardianTermExpirationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Terms = append(m.Terms, GuardianTerm{})
+			if err := m.Terms[len(m.Terms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTallyBreakdownRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTallyBreakdownRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTallyBreakdownRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTallyBreakdownResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTallyBreakdownResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTallyBreakdownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Breakdown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Breakdown == nil {
+				m.Breakdown = &TallyBreakdown{}
+			}
+			if err := m.Breakdown.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTallyRuleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTallyRuleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTallyRuleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTallyRuleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTallyRuleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTallyRuleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchedMsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MatchedMsgTypeUrls = append(m.MatchedMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteDelegationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteDelegationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Delegation == nil {
+				m.Delegation = &VoteDelegation{}
+			}
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, VoteDelegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEffectiveVotePowerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectiveVotePowerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectiveVotePowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEffectiveVotePowerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectiveVotePowerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectiveVotePowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			m.Votes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Votes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegators = append(m.Delegators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySecretBallotRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySecretBallotRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySecretBallotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySecretBallotResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySecretBallotResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySecretBallotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ballot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ballot == nil {
+				m.Ballot = &SecretBallot{}
+			}
+			if err := m.Ballot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+			}
+			m.Commits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Commits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reveals", wireType)
+			}
+			m.Reveals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reveals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteCommitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteCommitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteCommitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteCommitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteCommitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &VoteCommit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryGuardiansRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *QueryGuardiansResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryFeeWaiverRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeWaiverRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeWaiverRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.TotalVotingWeight != nil {
-		l = m.TotalVotingWeight.Size()
-		n += 1 + l + sovQuery(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryFeeWaiverResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeWaiverResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeWaiverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eligible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Eligible = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			m.Remaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryElectorateGroupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1067,10 +10316,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryElectorateGroupRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryElectorateGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1094,7 +10343,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryElectorateGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1117,15 +10366,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryElectorateGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryElectorateGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ElectorateGroup", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1149,13 +10398,63 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ElectorateGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTreasuryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTreasuryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTreasuryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1177,7 +10476,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMemberRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTreasuryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1200,10 +10499,10 @@ func (m *QueryMemberRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMemberRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTreasuryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMemberRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTreasuryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1238,59 +10537,43 @@ func (m *QueryMemberRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryMemberResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMemberResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Committed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1317,10 +10600,8 @@ func (m *QueryMemberResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Member == nil {
-				m.Member = &Member{}
-			}
-			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Committed = append(m.Committed, types.Coin{})
+			if err := m.Committed[len(m.Committed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1345,7 +10626,7 @@ func (m *QueryMemberResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMembersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTreasurySpendsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1368,10 +10649,10 @@ func (m *QueryMembersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMembersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTreasurySpendsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTreasurySpendsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1431,7 +10712,7 @@ func (m *QueryMembersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMembersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTreasurySpendsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1454,15 +10735,15 @@ func (m *QueryMembersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMembersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTreasurySpendsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTreasurySpendsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spends", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1489,8 +10770,8 @@ func (m *QueryMembersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, &Member{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Spends = append(m.Spends, TreasurySpend{})
+			if err := m.Spends[len(m.Spends)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1551,7 +10832,7 @@ func (m *QueryMembersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGuardiansRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDividendPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1574,10 +10855,10 @@ func (m *QueryGuardiansRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGuardiansRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDividendPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGuardiansRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDividendPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1601,7 +10882,7 @@ func (m *QueryGuardiansRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGuardiansResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDividendPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1624,15 +10905,15 @@ func (m *QueryGuardiansResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGuardiansResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDividendPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGuardiansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDividendPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DividendPool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1659,16 +10940,65 @@ func (m *QueryGuardiansResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, &Member{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DividendPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMemberRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMemberRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMemberRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1678,24 +11008,105 @@ func (m *QueryGuardiansResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.TotalVotingWeight = &v
-			if err := m.TotalVotingWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMemberRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMemberRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMemberRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,32 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RecallReasonMaxLength is the maximum number of characters allowed for the
+// reason given in a recall petition. It matches the gov module's default
+// metadata length, as the reason is used as the recall proposal's summary.
+const RecallReasonMaxLength = 255
+
+// HasSigned returns true if the address has already signed the petition
+func (p RecallPetition) HasSigned(addr string) bool {
+	for _, signer := range p.Signers {
+		if signer == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSubmitted returns true once a recall proposal has been submitted for the petition
+func (p RecallPetition) IsSubmitted() bool {
+	return p.ProposalId != 0
+}
+
+// MeetsThreshold returns true if the petition has been signed by at least
+// the threshold fraction of the given electorate size
+func (p RecallPetition) MeetsThreshold(threshold sdk.Dec, electorateCount uint64) bool {
+	required := threshold.MulInt64(int64(electorateCount))
+	return sdk.NewDec(int64(len(p.Signers))).GTE(required)
+}
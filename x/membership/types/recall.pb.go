@@ -0,0 +1,583 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: membershipmodule/membership/recall.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RecallPetition collects electorate signatures to recall a member
+type RecallPetition struct {
+	// target is the address of the member to be recalled
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// petitioner is the address of the member who opened the petition
+	Petitioner string `protobuf:"bytes,2,opt,name=petitioner,proto3" json:"petitioner,omitempty"`
+	// reason explains why the member should be recalled
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// signers are the electorate members who have signed, including the petitioner
+	Signers []string `protobuf:"bytes,4,rep,name=signers,proto3" json:"signers,omitempty"`
+	// proposal_id is the recall proposal submitted once enough members have
+	// signed, or zero while the petition is still collecting signatures
+	ProposalId uint64 `protobuf:"varint,5,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// expires_at is the time after which a petition still collecting signatures
+	// is closed
+	ExpiresAt time.Time `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
+}
+
+func (m *RecallPetition) Reset()         { *m = RecallPetition{} }
+func (m *RecallPetition) String() string { return proto.CompactTextString(m) }
+func (*RecallPetition) ProtoMessage()    {}
+func (*RecallPetition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a65c1505c50c0750, []int{0}
+}
+func (m *RecallPetition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecallPetition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecallPetition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecallPetition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecallPetition.Merge(m, src)
+}
+func (m *RecallPetition) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecallPetition) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecallPetition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecallPetition proto.InternalMessageInfo
+
+func (m *RecallPetition) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *RecallPetition) GetPetitioner() string {
+	if m != nil {
+		return m.Petitioner
+	}
+	return ""
+}
+
+func (m *RecallPetition) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *RecallPetition) GetSigners() []string {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+func (m *RecallPetition) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *RecallPetition) GetExpiresAt() time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*RecallPetition)(nil), "membershipmodule.membership.RecallPetition")
+}
+
+func init() {
+	proto.RegisterFile("membershipmodule/membership/recall.proto", fileDescriptor_a65c1505c50c0750)
+}
+
+var fileDescriptor_a65c1505c50c0750 = []byte{
+	// 314 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0x31, 0x6f, 0xea, 0x30,
+	0x14, 0x85, 0xe3, 0x07, 0x8f, 0xf7, 0x30, 0x52, 0x87, 0xa8, 0xaa, 0x2c, 0x2a, 0x39, 0x51, 0xa7,
+	0x2c, 0xc4, 0x52, 0x3b, 0x75, 0x2c, 0x9d, 0xba, 0x55, 0x69, 0xa7, 0x2e, 0xc8, 0x81, 0x5b, 0x63,
+	0x29, 0xc9, 0xb5, 0x6c, 0x23, 0xd1, 0x7f, 0xc1, 0xcf, 0x62, 0x64, 0xec, 0xd4, 0x22, 0xf8, 0x23,
+	0x15, 0x09, 0x11, 0xa8, 0x9b, 0xcf, 0xf1, 0xf9, 0x74, 0x75, 0xee, 0xa5, 0x49, 0x09, 0x65, 0x0e,
+	0xd6, 0xcd, 0xb5, 0x29, 0x71, 0xb6, 0x28, 0x40, 0x9c, 0x0c, 0x61, 0x61, 0x2a, 0x8b, 0x22, 0x35,
+	0x16, 0x3d, 0x86, 0xd7, 0xbf, 0x93, 0xe9, 0xc9, 0x18, 0x5e, 0x2a, 0x54, 0x58, 0xe7, 0xc4, 0xe1,
+	0xd5, 0x20, 0xc3, 0x48, 0x21, 0xaa, 0x02, 0x44, 0xad, 0xf2, 0xc5, 0xbb, 0xf0, 0xba, 0x04, 0xe7,
+	0x65, 0x69, 0x9a, 0xc0, 0xcd, 0x96, 0xd0, 0x8b, 0xac, 0x1e, 0xf2, 0x0c, 0x5e, 0x7b, 0x8d, 0x55,
+	0x78, 0x45, 0x7b, 0x5e, 0x5a, 0x05, 0x9e, 0x91, 0x98, 0x24, 0xfd, 0xec, 0xa8, 0x42, 0x4e, 0xa9,
+	0x39, 0x66, 0xc0, 0xb2, 0x3f, 0xf5, 0xdf, 0x99, 0x73, 0xe0, 0x2c, 0x48, 0x87, 0x15, 0xeb, 0x34,
+	0x5c, 0xa3, 0x42, 0x46, 0xff, 0x39, 0xad, 0x2a, 0xb0, 0x8e, 0x75, 0xe3, 0x4e, 0xd2, 0xcf, 0x5a,
+	0x19, 0x46, 0x74, 0x60, 0x2c, 0x1a, 0x74, 0xb2, 0x98, 0xe8, 0x19, 0xfb, 0x1b, 0x93, 0xa4, 0x9b,
+	0xd1, 0xd6, 0x7a, 0x9a, 0x85, 0x8f, 0x94, 0xc2, 0xd2, 0x68, 0x0b, 0x6e, 0x22, 0x3d, 0xeb, 0xc5,
+	0x24, 0x19, 0xdc, 0x0e, 0xd3, 0xa6, 0x53, 0xda, 0x76, 0x4a, 0x5f, 0xdb, 0x4e, 0xe3, 0xff, 0xeb,
+	0xaf, 0x28, 0x58, 0x7d, 0x47, 0x24, 0xeb, 0x1f, 0xb9, 0x07, 0x3f, 0x7e, 0x59, 0xef, 0x38, 0xd9,
+	0xec, 0x38, 0xd9, 0xee, 0x38, 0x59, 0xed, 0x79, 0xb0, 0xd9, 0xf3, 0xe0, 0x73, 0xcf, 0x83, 0xb7,
+	0x7b, 0xa5, 0xfd, 0x7c, 0x91, 0xa7, 0x53, 0x2c, 0x45, 0x85, 0x56, 0xcb, 0x51, 0x05, 0x5e, 0x34,
+	0xbb, 0x1d, 0x9d, 0x5d, 0x61, 0x79, 0x7e, 0x12, 0xff, 0x61, 0xc0, 0xe5, 0xbd, 0x7a, 0xfa, 0xdd,
+	0xcf, 0x00, 0xc3, 0x15, 0xbf, 0x59, 0xbe, 0x01, 0x00, 0x00,
+}
+
+func (m *RecallPetition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecallPetition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecallPetition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintRecall(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if m.ProposalId != 0 {
+		i = encodeVarintRecall(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintRecall(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintRecall(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Petitioner) > 0 {
+		i -= len(m.Petitioner)
+		copy(dAtA[i:], m.Petitioner)
+		i = encodeVarintRecall(dAtA, i, uint64(len(m.Petitioner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintRecall(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRecall(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRecall(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RecallPetition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovRecall(uint64(l))
+	}
+	l = len(m.Petitioner)
+	if l > 0 {
+		n += 1 + l + sovRecall(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRecall(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovRecall(uint64(l))
+		}
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovRecall(uint64(m.ProposalId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovRecall(uint64(l))
+	return n
+}
+
+func sovRecall(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRecall(x uint64) (n int) {
+	return sovRecall(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RecallPetition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecall
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecallPetition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecallPetition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecall
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecall
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecall
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Petitioner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecall
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecall
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecall
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Petitioner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecall
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecall
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecall
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecall
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecall
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecall
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecall
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecall
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecall
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecall
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecall(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecall
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRecall(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRecall
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRecall
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRecall
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRecall
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRecall
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRecall
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRecall        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRecall          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRecall = fmt.Errorf("proto: unexpected end of group")
+)
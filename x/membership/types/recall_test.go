@@ -0,0 +1,27 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecallPetition_MeetsThreshold(t *testing.T) {
+	threshold := sdk.NewDecWithPrec(25, 2)
+
+	petition := RecallPetition{Signers: []string{"a", "b"}}
+	require.False(t, petition.MeetsThreshold(threshold, 9))
+	require.True(t, petition.MeetsThreshold(threshold, 8))
+	require.True(t, petition.MeetsThreshold(threshold, 7))
+
+	// A unanimous threshold requires every member of the electorate
+	require.False(t, petition.MeetsThreshold(sdk.OneDec(), 3))
+	require.True(t, petition.MeetsThreshold(sdk.OneDec(), 2))
+}
+
+func TestRecallPetition_HasSigned(t *testing.T) {
+	petition := RecallPetition{Signers: []string{"a", "b"}}
+	require.True(t, petition.HasSigned("a"))
+	require.False(t, petition.HasSigned("c"))
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes_v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// SecretVoteSaltMaxLength is the maximum number of characters allowed for the
+// salt of a secret vote
+const SecretVoteSaltMaxLength = 128
+
+// SecretVoteHash returns the hex-encoded sha256 hash that commits the voter to
+// their vote on the proposal. The salt keeps the vote from being guessed.
+func SecretVoteHash(proposalID uint64, voter string, options govtypes_v1.WeightedVoteOptions, salt string) string {
+	choices := make([]string, len(options))
+	for i, option := range options {
+		weight := option.Weight
+		if dec, err := sdk.NewDecFromStr(option.Weight); err == nil {
+			weight = dec.String()
+		}
+		choices[i] = fmt.Sprintf("%s=%s", option.Option, weight)
+	}
+
+	preimage := fmt.Sprintf("%d/%s/%s/%s", proposalID, voter, strings.Join(choices, ","), salt)
+	hash := sha256.Sum256([]byte(preimage))
+	return hex.EncodeToString(hash[:])
+}
+
+// ValidateVoteCommitHash checks that a vote commitment is a hex-encoded sha256 hash
+func ValidateVoteCommitHash(hash string) error {
+	bz, err := hex.DecodeString(hash)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidVoteCommit, "hash is not valid hex (%s)", err)
+	}
+	if len(bz) != sha256.Size {
+		return errors.Wrapf(ErrInvalidVoteCommit, "hash must be %d bytes", sha256.Size)
+	}
+	return nil
+}
@@ -0,0 +1,795 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: membershipmodule/membership/secret_ballot.proto
+
+package types
+
+import (
+	fmt "fmt"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SecretBallot marks a proposal whose votes are committed as hashes during
+// the voting period, and revealed afterwards
+type SecretBallot struct {
+	// proposal_id is the proposal voted on in secret
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// commit_end_time is the end of the proposal's voting period, and is set
+	// once the voting period ends
+	CommitEndTime time.Time `protobuf:"bytes,2,opt,name=commit_end_time,json=commitEndTime,proto3,stdtime" json:"commit_end_time"`
+	// reveal_end_time is the time after which votes can no longer be revealed,
+	// and is set once the voting period ends
+	RevealEndTime time.Time `protobuf:"bytes,3,opt,name=reveal_end_time,json=revealEndTime,proto3,stdtime" json:"reveal_end_time"`
+}
+
+func (m *SecretBallot) Reset()         { *m = SecretBallot{} }
+func (m *SecretBallot) String() string { return proto.CompactTextString(m) }
+func (*SecretBallot) ProtoMessage()    {}
+func (*SecretBallot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c567c3829f4f00b7, []int{0}
+}
+func (m *SecretBallot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecretBallot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SecretBallot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SecretBallot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretBallot.Merge(m, src)
+}
+func (m *SecretBallot) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecretBallot) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretBallot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SecretBallot proto.InternalMessageInfo
+
+func (m *SecretBallot) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *SecretBallot) GetCommitEndTime() time.Time {
+	if m != nil {
+		return m.CommitEndTime
+	}
+	return time.Time{}
+}
+
+func (m *SecretBallot) GetRevealEndTime() time.Time {
+	if m != nil {
+		return m.RevealEndTime
+	}
+	return time.Time{}
+}
+
+// VoteCommit is a member's hidden vote on a secret ballot proposal
+type VoteCommit struct {
+	// proposal_id is the proposal voted on
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// voter is the address of the member who committed the vote
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// hash is the hex-encoded sha256 hash of the vote and its salt
+	Hash string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	// revealed is true once the vote has been revealed
+	Revealed bool `protobuf:"varint,4,opt,name=revealed,proto3" json:"revealed,omitempty"`
+	// options are the revealed vote's options
+	Options []*v1.WeightedVoteOption `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"`
+}
+
+func (m *VoteCommit) Reset()         { *m = VoteCommit{} }
+func (m *VoteCommit) String() string { return proto.CompactTextString(m) }
+func (*VoteCommit) ProtoMessage()    {}
+func (*VoteCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c567c3829f4f00b7, []int{1}
+}
+func (m *VoteCommit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteCommit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteCommit.Merge(m, src)
+}
+func (m *VoteCommit) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteCommit proto.InternalMessageInfo
+
+func (m *VoteCommit) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *VoteCommit) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *VoteCommit) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *VoteCommit) GetRevealed() bool {
+	if m != nil {
+		return m.Revealed
+	}
+	return false
+}
+
+func (m *VoteCommit) GetOptions() []*v1.WeightedVoteOption {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SecretBallot)(nil), "membershipmodule.membership.SecretBallot")
+	proto.RegisterType((*VoteCommit)(nil), "membershipmodule.membership.VoteCommit")
+}
+
+func init() {
+	proto.RegisterFile("membershipmodule/membership/secret_ballot.proto", fileDescriptor_c567c3829f4f00b7)
+}
+
+var fileDescriptor_c567c3829f4f00b7 = []byte{
+	// 395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x8a, 0xd4, 0x40,
+	0x10, 0xc6, 0xd3, 0xee, 0xac, 0xce, 0xf6, 0x28, 0x42, 0x58, 0x30, 0x44, 0x48, 0xe2, 0x9e, 0x72,
+	0xd9, 0x6e, 0x76, 0x3d, 0x89, 0xb7, 0x11, 0x0f, 0x82, 0x20, 0x64, 0x45, 0xc1, 0x4b, 0xc8, 0x9f,
+	0x32, 0x69, 0x48, 0xa7, 0x42, 0xba, 0x27, 0xe8, 0x5b, 0xec, 0xa3, 0xf8, 0x18, 0x03, 0x5e, 0xe6,
+	0xe8, 0x49, 0x65, 0xe6, 0x45, 0x24, 0xdd, 0x13, 0x67, 0xf0, 0x22, 0x7b, 0xea, 0xae, 0xea, 0xfa,
+	0xbe, 0xfa, 0x75, 0x51, 0x94, 0x4b, 0x90, 0x39, 0xf4, 0xaa, 0x16, 0x9d, 0xc4, 0x72, 0xd5, 0xc0,
+	0x51, 0x82, 0x2b, 0x28, 0x7a, 0xd0, 0x69, 0x9e, 0x35, 0x0d, 0x6a, 0xd6, 0xf5, 0xa8, 0xd1, 0x7d,
+	0xfa, 0xaf, 0x80, 0x1d, 0x12, 0xfe, 0x79, 0x85, 0x15, 0x9a, 0x3a, 0x3e, 0xde, 0xac, 0xc4, 0x0f,
+	0x2b, 0xc4, 0xaa, 0x01, 0x6e, 0xa2, 0x7c, 0xf5, 0x99, 0x6b, 0x21, 0x41, 0xe9, 0x4c, 0x76, 0xfb,
+	0x82, 0x27, 0x05, 0x2a, 0x89, 0x8a, 0x57, 0x38, 0xf0, 0xe1, 0x6a, 0x3c, 0xec, 0xc3, 0xc5, 0x77,
+	0x42, 0x1f, 0xde, 0x18, 0x88, 0xa5, 0x61, 0x70, 0x43, 0xba, 0xe8, 0x7a, 0xec, 0x50, 0x65, 0x4d,
+	0x2a, 0x4a, 0x8f, 0x44, 0x24, 0x9e, 0x25, 0x74, 0x4a, 0xbd, 0x29, 0xdd, 0xb7, 0xf4, 0x71, 0x81,
+	0x52, 0x0a, 0x9d, 0x42, 0x5b, 0xa6, 0x63, 0x23, 0xef, 0x5e, 0x44, 0xe2, 0xc5, 0xb5, 0xcf, 0x2c,
+	0x05, 0x9b, 0x28, 0xd8, 0xfb, 0x89, 0x62, 0x39, 0x5f, 0xff, 0x0c, 0x9d, 0xdb, 0x5f, 0x21, 0x49,
+	0x1e, 0x59, 0xf1, 0xeb, 0xb6, 0x1c, 0x5f, 0x47, 0xb7, 0x1e, 0x06, 0xc8, 0x9a, 0x83, 0xdb, 0xc9,
+	0x5d, 0xdc, 0xac, 0x78, 0xef, 0x76, 0xf1, 0x8d, 0x50, 0xfa, 0x01, 0x35, 0xbc, 0x32, 0x3d, 0xfe,
+	0xff, 0x97, 0x73, 0x7a, 0x3a, 0xa0, 0x86, 0xde, 0xfc, 0xe0, 0x2c, 0xb1, 0x81, 0xeb, 0xd2, 0x59,
+	0x9d, 0xa9, 0xda, 0x80, 0x9c, 0x25, 0xe6, 0xee, 0xfa, 0x74, 0x6e, 0x5b, 0x41, 0xe9, 0xcd, 0x22,
+	0x12, 0xcf, 0x93, 0xbf, 0xb1, 0xfb, 0x92, 0x3e, 0xc0, 0x4e, 0x0b, 0x6c, 0x95, 0x77, 0x1a, 0x9d,
+	0xc4, 0x8b, 0xeb, 0x67, 0xcc, 0x8e, 0x9b, 0x8d, 0x73, 0x1e, 0xae, 0xd8, 0x47, 0x10, 0x55, 0xad,
+	0xa1, 0x1c, 0xd1, 0xde, 0x99, 0xca, 0x64, 0x52, 0x2c, 0x6f, 0xd6, 0xdb, 0x80, 0x6c, 0xb6, 0x01,
+	0xf9, 0xbd, 0x0d, 0xc8, 0xed, 0x2e, 0x70, 0x36, 0xbb, 0xc0, 0xf9, 0xb1, 0x0b, 0x9c, 0x4f, 0x2f,
+	0x2a, 0xa1, 0xeb, 0x55, 0xce, 0x0a, 0x94, 0xbc, 0xc5, 0x5e, 0x64, 0x97, 0x2d, 0x68, 0x6e, 0x57,
+	0xe2, 0xf2, 0x68, 0x87, 0xbe, 0x1c, 0x2f, 0x94, 0xfe, 0xda, 0x81, 0xca, 0xef, 0x9b, 0xa1, 0x3d,
+	0xff, 0x33, 0x00, 0xff, 0x0b, 0xfc, 0x32, 0x7c, 0x02, 0x00, 0x00,
+}
+
+func (m *SecretBallot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SecretBallot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecretBallot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RevealEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RevealEndTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSecretBallot(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CommitEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CommitEndTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSecretBallot(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.ProposalId != 0 {
+		i = encodeVarintSecretBallot(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoteCommit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteCommit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSecretBallot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Revealed {
+		i--
+		if m.Revealed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintSecretBallot(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintSecretBallot(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintSecretBallot(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSecretBallot(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSecretBallot(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SecretBallot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovSecretBallot(uint64(m.ProposalId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CommitEndTime)
+	n += 1 + l + sovSecretBallot(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RevealEndTime)
+	n += 1 + l + sovSecretBallot(uint64(l))
+	return n
+}
+
+func (m *VoteCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovSecretBallot(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovSecretBallot(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovSecretBallot(uint64(l))
+	}
+	if m.Revealed {
+		n += 2
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovSecretBallot(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovSecretBallot(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSecretBallot(x uint64) (n int) {
+	return sovSecretBallot(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SecretBallot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecretBallot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SecretBallot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SecretBallot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretBallot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretBallot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSecretBallot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecretBallot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CommitEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretBallot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSecretBallot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecretBallot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RevealEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecretBallot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecretBallot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSecretBallot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretBallot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretBallot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecretBallot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecretBallot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretBallot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSecretBallot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecretBallot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revealed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretBallot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revealed = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSecretBallot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSecretBallot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSecretBallot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, &v1.WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSecretBallot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSecretBallot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSecretBallot(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSecretBallot
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSecretBallot
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSecretBallot
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSecretBallot
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSecretBallot
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSecretBallot
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSecretBallot        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSecretBallot          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSecretBallot = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,35 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes_v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/noria-net/module-membership/testutil/sample"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretVoteHash(t *testing.T) {
+	voter := sample.AccAddress()
+	yes := govtypes_v1.NewNonSplitVoteOption(govtypes_v1.OptionYes)
+	hash := types.SecretVoteHash(1, voter, yes, "salt")
+	require.NoError(t, types.ValidateVoteCommitHash(hash))
+
+	// Equal weights hash the same however they are written
+	written := govtypes_v1.WeightedVoteOptions{{Option: govtypes_v1.OptionYes, Weight: "1"}}
+	require.Equal(t, hash, types.SecretVoteHash(1, voter, written, "salt"))
+
+	// Changing anything changes the hash
+	no := govtypes_v1.NewNonSplitVoteOption(govtypes_v1.OptionNo)
+	require.NotEqual(t, hash, types.SecretVoteHash(2, voter, yes, "salt"))
+	require.NotEqual(t, hash, types.SecretVoteHash(1, sample.AccAddress(), yes, "salt"))
+	require.NotEqual(t, hash, types.SecretVoteHash(1, voter, no, "salt"))
+	require.NotEqual(t, hash, types.SecretVoteHash(1, voter, yes, "pepper"))
+
+	split := govtypes_v1.WeightedVoteOptions{
+		govtypes_v1.NewWeightedVoteOption(govtypes_v1.OptionYes, sdk.MustNewDecFromStr("0.5")),
+		govtypes_v1.NewWeightedVoteOption(govtypes_v1.OptionNo, sdk.MustNewDecFromStr("0.5")),
+	}
+	require.NotEqual(t, hash, types.SecretVoteHash(1, voter, split, "salt"))
+}
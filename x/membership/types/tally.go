@@ -1,31 +1,105 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
-
-// TallyStats is a struct containing the stats of a tally
-type TallyStats struct {
-	// NumVotes is the number of votes cast
-	NumVotes uint64
-	// NumMembers is the number of members who voted
-	NumMembers uint64
-	// NumGuardians is the number of guardians who voted
-	NumGuardians uint64
-	// NumEligibleMembers is the number of members who are eligible to vote
-	NumEligibleMembers uint64
-	// NumEligibleVotes is the number of votes we can include in the tally
-	NumEligibleVotes uint64
-	// TotalVotingPower is the total weight of all votes (including higher-weight individuals, called Guardians)
-	TotalVotingPower sdk.Dec
-}
-
-// NewEmptyTallyStats creates a new TallyStats object with all values set to zero
-func NewEmptyTallyStats() TallyStats {
-	return TallyStats{
-		NumVotes:           0,
-		NumMembers:         0,
-		NumGuardians:       0,
-		NumEligibleMembers: 0,
-		NumEligibleVotes:   0,
-		TotalVotingPower:   sdk.ZeroDec(),
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes_v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// NewTallyRuleFromGovParams creates a tally rule from the gov quorum,
+// threshold and veto threshold
+func NewTallyRuleFromGovParams(params govtypes_v1.Params) TallyRule {
+	return TallyRule{
+		Quorum:        sdk.MustNewDecFromStr(params.Quorum),
+		Threshold:     sdk.MustNewDecFromStr(params.Threshold),
+		VetoThreshold: sdk.MustNewDecFromStr(params.VetoThreshold),
+	}
+}
+
+// Validate ensures the rule names a message type, and that its fractions are
+// within the bounds the gov module allows
+func (r TallyRule) Validate() error {
+	if !strings.HasPrefix(r.MsgTypeUrl, "/") || len(r.MsgTypeUrl) < 2 {
+		return fmt.Errorf("invalid message type url: %q", r.MsgTypeUrl)
+	}
+	return validateTallyFractions(r.Quorum, r.Threshold, r.VetoThreshold)
+}
+
+// validateTallyFractions ensures a quorum, threshold and veto threshold are
+// within the bounds the gov module allows
+func validateTallyFractions(quorum, threshold, vetoThreshold sdk.Dec) error {
+	if quorum.IsNil() || quorum.IsNegative() || quorum.GT(sdk.OneDec()) {
+		return fmt.Errorf("quorum must be between 0 and 1, inclusive: %s", quorum)
+	}
+	if threshold.IsNil() || !threshold.IsPositive() || threshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("threshold must be greater than 0 and at most 1: %s", threshold)
+	}
+	if vetoThreshold.IsNil() || !vetoThreshold.IsPositive() || vetoThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("veto threshold must be greater than 0 and at most 1: %s", vetoThreshold)
+	}
+	return nil
+}
+
+// Strictest combines two rules into one that a proposal only passes if it
+// would pass both: the higher quorum and threshold, and the lower veto
+// threshold
+func (r TallyRule) Strictest(other TallyRule) TallyRule {
+	return TallyRule{
+		Quorum:        sdk.MaxDec(r.Quorum, other.Quorum),
+		Threshold:     sdk.MaxDec(r.Threshold, other.Threshold),
+		VetoThreshold: sdk.MinDec(r.VetoThreshold, other.VetoThreshold),
+	}
+}
+
+// ApplyTo returns the gov params with the rule's quorum, threshold and veto
+// threshold
+func (r TallyRule) ApplyTo(params govtypes_v1.Params) govtypes_v1.Params {
+	params.Quorum = r.Quorum.String()
+	params.Threshold = r.Threshold.String()
+	params.VetoThreshold = r.VetoThreshold.String()
+	return params
+}
+
+// NewUnsetChamberRule creates a chamber rule that leaves the chamber with the
+// gov params
+func NewUnsetChamberRule() ChamberRule {
+	return ChamberRule{
+		Quorum:        sdk.ZeroDec(),
+		Threshold:     sdk.ZeroDec(),
+		VetoThreshold: sdk.ZeroDec(),
+	}
+}
+
+// IsSet returns true if the rule replaces the gov params
+func (r ChamberRule) IsSet() bool {
+	return !r.Threshold.IsNil() && !r.Threshold.IsZero()
+}
+
+// Validate ensures an unset rule is empty, and that a set rule's fractions are
+// within the bounds the gov module allows
+func (r ChamberRule) Validate() error {
+	if r.Quorum.IsNil() || r.Threshold.IsNil() || r.VetoThreshold.IsNil() {
+		return fmt.Errorf("chamber rule must set a quorum, threshold and veto threshold")
+	}
+	if !r.IsSet() {
+		if !r.Quorum.IsZero() || !r.VetoThreshold.IsZero() {
+			return fmt.Errorf("unset chamber rule must have a zero quorum and veto threshold")
+		}
+		return nil
+	}
+	return validateTallyFractions(r.Quorum, r.Threshold, r.VetoThreshold)
+}
+
+// ApplyTo returns the gov params with the rule's quorum, threshold and veto
+// threshold, if the rule is set
+func (r ChamberRule) ApplyTo(params govtypes_v1.Params) govtypes_v1.Params {
+	if !r.IsSet() {
+		return params
 	}
+	params.Quorum = r.Quorum.String()
+	params.Threshold = r.Threshold.String()
+	params.VetoThreshold = r.VetoThreshold.String()
+	return params
 }
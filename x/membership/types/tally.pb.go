@@ -0,0 +1,1543 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: membershipmodule/membership/tally.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ChamberTally is the outcome of a proposal's vote within one chamber of the
+// electorate
+type ChamberTally struct {
+	// Yes count, where guardian votes are counted by weight and split votes
+	// are counted fractionally
+	YesCount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=yes_count,json=yesCount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"yes_count,omitempty"`
+	// Abstain count
+	AbstainCount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=abstain_count,json=abstainCount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"abstain_count,omitempty"`
+	// No count
+	NoCount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=no_count,json=noCount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"no_count,omitempty"`
+	// No with veto count
+	NoWithVetoCount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=no_with_veto_count,json=noWithVetoCount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"no_with_veto_count,omitempty"`
+	// Eligible is the count of the whole chamber, had everyone voted
+	Eligible cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=eligible,proto3,customtype=cosmossdk.io/math.Int" json:"eligible"`
+	// Quorum reached is true if enough of the chamber voted
+	QuorumReached bool `protobuf:"varint,6,opt,name=quorum_reached,json=quorumReached,proto3" json:"quorum_reached,omitempty"`
+	// Vetoed is true if too much of the chamber voted no with veto
+	Vetoed bool `protobuf:"varint,7,opt,name=vetoed,proto3" json:"vetoed,omitempty"`
+	// Passed is true if the chamber approved the proposal
+	Passed bool `protobuf:"varint,8,opt,name=passed,proto3" json:"passed,omitempty"`
+}
+
+func (m *ChamberTally) Reset()         { *m = ChamberTally{} }
+func (m *ChamberTally) String() string { return proto.CompactTextString(m) }
+func (*ChamberTally) ProtoMessage()    {}
+func (*ChamberTally) Descriptor() ([]byte, []int) {
+	return fileDescriptor_063ee5da4cd7d740, []int{0}
+}
+func (m *ChamberTally) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChamberTally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChamberTally.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChamberTally) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChamberTally.Merge(m, src)
+}
+func (m *ChamberTally) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChamberTally) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChamberTally.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChamberTally proto.InternalMessageInfo
+
+func (m *ChamberTally) GetQuorumReached() bool {
+	if m != nil {
+		return m.QuorumReached
+	}
+	return false
+}
+
+func (m *ChamberTally) GetVetoed() bool {
+	if m != nil {
+		return m.Vetoed
+	}
+	return false
+}
+
+func (m *ChamberTally) GetPassed() bool {
+	if m != nil {
+		return m.Passed
+	}
+	return false
+}
+
+// TallyBreakdown shows how each chamber voted on a proposal
+type TallyBreakdown struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// Bicameral is true if both chambers had to approve the proposal
+	Bicameral bool         `protobuf:"varint,2,opt,name=bicameral,proto3" json:"bicameral,omitempty"`
+	Guardians ChamberTally `protobuf:"bytes,3,opt,name=guardians,proto3" json:"guardians"`
+	Members   ChamberTally `protobuf:"bytes,4,opt,name=members,proto3" json:"members"`
+}
+
+func (m *TallyBreakdown) Reset()         { *m = TallyBreakdown{} }
+func (m *TallyBreakdown) String() string { return proto.CompactTextString(m) }
+func (*TallyBreakdown) ProtoMessage()    {}
+func (*TallyBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_063ee5da4cd7d740, []int{1}
+}
+func (m *TallyBreakdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TallyBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TallyBreakdown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TallyBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TallyBreakdown.Merge(m, src)
+}
+func (m *TallyBreakdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *TallyBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_TallyBreakdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TallyBreakdown proto.InternalMessageInfo
+
+func (m *TallyBreakdown) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *TallyBreakdown) GetBicameral() bool {
+	if m != nil {
+		return m.Bicameral
+	}
+	return false
+}
+
+func (m *TallyBreakdown) GetGuardians() ChamberTally {
+	if m != nil {
+		return m.Guardians
+	}
+	return ChamberTally{}
+}
+
+func (m *TallyBreakdown) GetMembers() ChamberTally {
+	if m != nil {
+		return m.Members
+	}
+	return ChamberTally{}
+}
+
+// TallyRule overrides the gov quorum, threshold and veto threshold for
+// proposals containing a message of the given type
+type TallyRule struct {
+	// Type URL of the proposal message the rule applies to
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// Minimum share of the voting power that must vote
+	Quorum github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=quorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quorum,omitempty"`
+	// Share of the non-abstaining votes that must vote yes
+	Threshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=threshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"threshold,omitempty"`
+	// Share of the non-abstaining votes that vetoes the proposal
+	VetoThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=veto_threshold,json=vetoThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"veto_threshold,omitempty"`
+}
+
+func (m *TallyRule) Reset()         { *m = TallyRule{} }
+func (m *TallyRule) String() string { return proto.CompactTextString(m) }
+func (*TallyRule) ProtoMessage()    {}
+func (*TallyRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_063ee5da4cd7d740, []int{2}
+}
+func (m *TallyRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TallyRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TallyRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TallyRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TallyRule.Merge(m, src)
+}
+func (m *TallyRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *TallyRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_TallyRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TallyRule proto.InternalMessageInfo
+
+func (m *TallyRule) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+// ChamberRule replaces the gov quorum, threshold and veto threshold within
+// one chamber of a bicameral proposal. A rule with a zero threshold is unset,
+// and leaves the chamber with the gov params.
+type ChamberRule struct {
+	// Minimum share of the chamber that must vote
+	Quorum github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=quorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quorum,omitempty"`
+	// Share of the chamber's non-abstaining votes that must vote yes
+	Threshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=threshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"threshold,omitempty"`
+	// Share of the chamber's non-abstaining votes that vetoes the proposal
+	VetoThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=veto_threshold,json=vetoThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"veto_threshold,omitempty"`
+}
+
+func (m *ChamberRule) Reset()         { *m = ChamberRule{} }
+func (m *ChamberRule) String() string { return proto.CompactTextString(m) }
+func (*ChamberRule) ProtoMessage()    {}
+func (*ChamberRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_063ee5da4cd7d740, []int{3}
+}
+func (m *ChamberRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChamberRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChamberRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChamberRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChamberRule.Merge(m, src)
+}
+func (m *ChamberRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChamberRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChamberRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChamberRule proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ChamberTally)(nil), "membershipmodule.membership.ChamberTally")
+	proto.RegisterType((*TallyBreakdown)(nil), "membershipmodule.membership.TallyBreakdown")
+	proto.RegisterType((*TallyRule)(nil), "membershipmodule.membership.TallyRule")
+	proto.RegisterType((*ChamberRule)(nil), "membershipmodule.membership.ChamberRule")
+}
+
+func init() {
+	proto.RegisterFile("membershipmodule/membership/tally.proto", fileDescriptor_063ee5da4cd7d740)
+}
+
+var fileDescriptor_063ee5da4cd7d740 = []byte{
+	// 631 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0x4f, 0x6b, 0xd4, 0x40,
+	0x18, 0xc6, 0x37, 0xdb, 0x75, 0xbb, 0x99, 0x6e, 0xab, 0x8c, 0x5a, 0x43, 0xad, 0xbb, 0x65, 0x41,
+	0xad, 0x60, 0x13, 0xd0, 0x53, 0xc1, 0x53, 0x5a, 0x0f, 0x2b, 0xe8, 0x21, 0x56, 0x85, 0x5e, 0xc2,
+	0x24, 0x19, 0x92, 0xa1, 0x49, 0x26, 0x9d, 0x99, 0x58, 0x83, 0x5f, 0xc2, 0xaf, 0xe4, 0xad, 0xc7,
+	0xe2, 0x49, 0x3c, 0x2c, 0xd2, 0x9e, 0xec, 0x77, 0x10, 0x24, 0x33, 0xd9, 0x26, 0x6b, 0x41, 0xd8,
+	0x42, 0x3d, 0xed, 0xe6, 0x79, 0xdf, 0x79, 0x7e, 0xc9, 0xfb, 0x87, 0x01, 0x8f, 0x13, 0x9c, 0x78,
+	0x98, 0xf1, 0x88, 0x64, 0x09, 0x0d, 0xf2, 0x18, 0x5b, 0xb5, 0x60, 0x09, 0x14, 0xc7, 0x85, 0x99,
+	0x31, 0x2a, 0x28, 0xbc, 0xff, 0x77, 0xa2, 0x59, 0x0b, 0x6b, 0x77, 0x42, 0x1a, 0x52, 0x99, 0x67,
+	0x95, 0xff, 0xd4, 0x91, 0xd1, 0xb7, 0x0e, 0xe8, 0xef, 0x44, 0xa8, 0xcc, 0xda, 0x2b, 0x9d, 0xa0,
+	0x07, 0xf4, 0x02, 0x73, 0xd7, 0xa7, 0x79, 0x2a, 0x0c, 0x6d, 0x43, 0xdb, 0xec, 0xdb, 0x2f, 0x8f,
+	0x27, 0xc3, 0xd6, 0x8f, 0xc9, 0xf0, 0x51, 0x48, 0x44, 0x94, 0x7b, 0xa6, 0x4f, 0x13, 0xcb, 0xa7,
+	0x3c, 0xa1, 0xbc, 0xfa, 0xd9, 0xe2, 0xc1, 0x81, 0x25, 0x8a, 0x0c, 0x73, 0x73, 0x17, 0xfb, 0xe7,
+	0x93, 0xe1, 0xed, 0x0b, 0x8b, 0xa7, 0x34, 0x21, 0x02, 0x27, 0x99, 0x28, 0x9c, 0x5e, 0x81, 0xf9,
+	0x4e, 0xa9, 0xc1, 0x14, 0x2c, 0x23, 0x8f, 0x0b, 0x44, 0xd2, 0x8a, 0xd3, 0x96, 0x9c, 0xf1, 0xdc,
+	0x9c, 0x7b, 0x33, 0x36, 0x0d, 0x56, 0xbf, 0x0a, 0x28, 0x9e, 0x0b, 0x7a, 0x29, 0xad, 0x50, 0x0b,
+	0x12, 0xb5, 0x3b, 0x37, 0x0a, 0xa6, 0xf4, 0x12, 0x65, 0x31, 0xa5, 0x0a, 0xf0, 0x19, 0x94, 0xe1,
+	0x23, 0x22, 0x22, 0xf7, 0x23, 0x16, 0x53, 0x54, 0x47, 0xa2, 0xde, 0xcc, 0x8d, 0x5a, 0xbf, 0xec,
+	0xd5, 0x80, 0xde, 0x4c, 0xe9, 0x07, 0x22, 0xa2, 0xf7, 0x58, 0x54, 0xf0, 0x6d, 0xd0, 0xc3, 0x31,
+	0x09, 0x89, 0x17, 0x63, 0xe3, 0xc6, 0x86, 0xb6, 0xa9, 0xdb, 0x0f, 0x2a, 0xe4, 0x5d, 0x05, 0xe0,
+	0xc1, 0x81, 0x49, 0xa8, 0x95, 0x20, 0x11, 0x99, 0xe3, 0x54, 0x38, 0x17, 0xe9, 0xf0, 0x21, 0x58,
+	0x39, 0xcc, 0x29, 0xcb, 0x13, 0x97, 0x61, 0xe4, 0x47, 0x38, 0x30, 0xba, 0x1b, 0xda, 0x66, 0xcf,
+	0x59, 0x56, 0xaa, 0xa3, 0x44, 0xb8, 0x0a, 0xba, 0xe5, 0xab, 0xe0, 0xc0, 0x58, 0x94, 0xe1, 0xea,
+	0xa9, 0xd4, 0x33, 0xc4, 0x39, 0x0e, 0x8c, 0x9e, 0xd2, 0xd5, 0xd3, 0xe8, 0x97, 0x06, 0x56, 0xe4,
+	0x34, 0xd9, 0x0c, 0xa3, 0x83, 0x80, 0x1e, 0xa5, 0x70, 0x08, 0x96, 0x32, 0x46, 0x33, 0xca, 0x51,
+	0xec, 0x92, 0x40, 0x0e, 0x56, 0xc7, 0x01, 0x53, 0x69, 0x1c, 0xc0, 0x75, 0xa0, 0x7b, 0xc4, 0x47,
+	0x09, 0x66, 0x28, 0x96, 0xf3, 0xd0, 0x73, 0x6a, 0x01, 0xbe, 0x06, 0x7a, 0x98, 0x23, 0x16, 0x10,
+	0x94, 0x72, 0xd9, 0xc2, 0xa5, 0x67, 0x4f, 0xcc, 0x7f, 0x4c, 0xbb, 0xd9, 0x9c, 0x69, 0xbb, 0x53,
+	0xd6, 0xc3, 0xa9, 0x1d, 0xe0, 0x18, 0x2c, 0x56, 0xb9, 0x46, 0xe7, 0x6a, 0x66, 0xd3, 0xf3, 0xa3,
+	0xdf, 0x6d, 0xa0, 0xcb, 0x80, 0x93, 0xc7, 0x18, 0xbe, 0x00, 0xfd, 0x84, 0x87, 0x6e, 0xd9, 0x4d,
+	0x37, 0x67, 0xb1, 0xfc, 0x4e, 0xdd, 0x5e, 0x3b, 0x9f, 0x0c, 0x57, 0x9b, 0x7a, 0xa3, 0x9d, 0x20,
+	0xe1, 0xe1, 0x5e, 0x91, 0xe1, 0x77, 0x2c, 0x86, 0xfb, 0xa0, 0xab, 0x0a, 0x5f, 0x2d, 0x84, 0x3d,
+	0xf7, 0xe8, 0xdc, 0x52, 0xe7, 0x1b, 0xfe, 0x95, 0x23, 0xf4, 0x81, 0x2e, 0x22, 0x86, 0x79, 0x44,
+	0xe3, 0xc0, 0x58, 0xb8, 0xea, 0x5e, 0x5f, 0x58, 0x34, 0x08, 0xb5, 0x2f, 0x3c, 0x04, 0x2b, 0x72,
+	0x66, 0x6b, 0x92, 0xda, 0x81, 0x57, 0x73, 0x93, 0x8c, 0x59, 0x9f, 0x06, 0x6e, 0xb9, 0x8c, 0xec,
+	0x4d, 0x03, 0xa3, 0xaf, 0x6d, 0xb0, 0x54, 0xf5, 0x47, 0x76, 0xa0, 0xae, 0xa1, 0x76, 0xbd, 0x35,
+	0x6c, 0xff, 0xb7, 0x1a, 0x2e, 0x5c, 0x73, 0x0d, 0xed, 0xb7, 0xc7, 0xa7, 0x03, 0xed, 0xe4, 0x74,
+	0xa0, 0xfd, 0x3c, 0x1d, 0x68, 0x5f, 0xce, 0x06, 0xad, 0x93, 0xb3, 0x41, 0xeb, 0xfb, 0xd9, 0xa0,
+	0xb5, 0xbf, 0xdd, 0x80, 0xa5, 0x94, 0x11, 0xb4, 0x95, 0x62, 0x61, 0xa9, 0x0d, 0xd9, 0x6a, 0xdc,
+	0x42, 0x9f, 0x66, 0xae, 0xa4, 0xf2, 0x1d, 0xbc, 0xae, 0xbc, 0x60, 0x9e, 0xff, 0x19, 0x00, 0xc9,
+	0xce, 0x1b, 0x98, 0xbe, 0x06, 0x00, 0x00,
+}
+
+func (m *ChamberTally) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChamberTally) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChamberTally) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Passed {
+		i--
+		if m.Passed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Vetoed {
+		i--
+		if m.Vetoed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.QuorumReached {
+		i--
+		if m.QuorumReached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Eligible.Size()
+		i -= size
+		if _, err := m.Eligible.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTally(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.NoWithVetoCount.Size()
+		i -= size
+		if _, err := m.NoWithVetoCount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTally(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.NoCount.Size()
+		i -= size
+		if _, err := m.NoCount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTally(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.AbstainCount.Size()
+		i -= size
+		if _, err := m.AbstainCount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTally(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.YesCount.Size()
+		i -= size
+		if _, err := m.YesCount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTally(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TallyBreakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TallyBreakdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallyBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Members.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTally(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Guardians.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTally(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Bicameral {
+		i--
+		if m.Bicameral {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTally(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TallyRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TallyRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallyRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.VetoThreshold.Size()
+		i -= size
+		if _, err := m.VetoThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTally(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTally(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Quorum.Size()
+		i -= size
+		if _, err := m.Quorum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTally(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTally(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChamberRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChamberRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChamberRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.VetoThreshold.Size()
+		i -= size
+		if _, err := m.VetoThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTally(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTally(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Quorum.Size()
+		i -= size
+		if _, err := m.Quorum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTally(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTally(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTally(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ChamberTally) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.YesCount.Size()
+	n += 1 + l + sovTally(uint64(l))
+	l = m.AbstainCount.Size()
+	n += 1 + l + sovTally(uint64(l))
+	l = m.NoCount.Size()
+	n += 1 + l + sovTally(uint64(l))
+	l = m.NoWithVetoCount.Size()
+	n += 1 + l + sovTally(uint64(l))
+	l = m.Eligible.Size()
+	n += 1 + l + sovTally(uint64(l))
+	if m.QuorumReached {
+		n += 2
+	}
+	if m.Vetoed {
+		n += 2
+	}
+	if m.Passed {
+		n += 2
+	}
+	return n
+}
+
+func (m *TallyBreakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTally(uint64(m.ProposalId))
+	}
+	if m.Bicameral {
+		n += 2
+	}
+	l = m.Guardians.Size()
+	n += 1 + l + sovTally(uint64(l))
+	l = m.Members.Size()
+	n += 1 + l + sovTally(uint64(l))
+	return n
+}
+
+func (m *TallyRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTally(uint64(l))
+	}
+	l = m.Quorum.Size()
+	n += 1 + l + sovTally(uint64(l))
+	l = m.Threshold.Size()
+	n += 1 + l + sovTally(uint64(l))
+	l = m.VetoThreshold.Size()
+	n += 1 + l + sovTally(uint64(l))
+	return n
+}
+
+func (m *ChamberRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Quorum.Size()
+	n += 1 + l + sovTally(uint64(l))
+	l = m.Threshold.Size()
+	n += 1 + l + sovTally(uint64(l))
+	l = m.VetoThreshold.Size()
+	n += 1 + l + sovTally(uint64(l))
+	return n
+}
+
+func sovTally(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTally(x uint64) (n int) {
+	return sovTally(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ChamberTally) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTally
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChamberTally: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChamberTally: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTally
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTally
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.YesCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTally
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTally
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AbstainCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTally
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTally
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NoCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVetoCount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTally
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTally
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NoWithVetoCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eligible", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTally
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTally
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Eligible.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumReached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QuorumReached = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vetoed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Vetoed = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Passed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTally(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTally
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyBreakdown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTally
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyBreakdown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bicameral", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Bicameral = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardians", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTally
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTally
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Guardians.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTally
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTally
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Members.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTally(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTally
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTally
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTally
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTally
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTally
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTally
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTally
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTally
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTally
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTally
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VetoThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTally(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTally
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChamberRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTally
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChamberRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChamberRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTally
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTally
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTally
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTally
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTally
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTally
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTally
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VetoThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTally(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTally
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTally(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTally
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTally
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTally
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTally
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTally
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTally
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTally        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTally          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTally = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTallyRule_Strictest(t *testing.T) {
+	majority := TallyRule{
+		Quorum:        sdk.MustNewDecFromStr("0.334"),
+		Threshold:     sdk.MustNewDecFromStr("0.5"),
+		VetoThreshold: sdk.MustNewDecFromStr("0.334"),
+	}
+	supermajority := TallyRule{
+		Quorum:        sdk.MustNewDecFromStr("0.2"),
+		Threshold:     sdk.MustNewDecFromStr("0.667"),
+		VetoThreshold: sdk.MustNewDecFromStr("0.5"),
+	}
+
+	strictest := majority.Strictest(supermajority)
+	require.Equal(t, sdk.MustNewDecFromStr("0.334"), strictest.Quorum)
+	require.Equal(t, sdk.MustNewDecFromStr("0.667"), strictest.Threshold)
+	require.Equal(t, sdk.MustNewDecFromStr("0.334"), strictest.VetoThreshold)
+	require.Equal(t, strictest, supermajority.Strictest(majority))
+}
+
+func TestTallyRule_Validate(t *testing.T) {
+	valid := func() TallyRule {
+		return TallyRule{
+			MsgTypeUrl:    "/cosmos.bank.v1beta1.MsgSend",
+			Quorum:        sdk.MustNewDecFromStr("0.334"),
+			Threshold:     sdk.MustNewDecFromStr("0.5"),
+			VetoThreshold: sdk.MustNewDecFromStr("0.334"),
+		}
+	}
+
+	tests := []struct {
+		name   string
+		change func(r *TallyRule)
+		valid  bool
+	}{
+		{name: "valid rule", change: func(r *TallyRule) {}, valid: true},
+		{name: "zero quorum", change: func(r *TallyRule) { r.Quorum = sdk.ZeroDec() }, valid: true},
+		{name: "missing type url", change: func(r *TallyRule) { r.MsgTypeUrl = "" }},
+		{name: "type url without a leading slash", change: func(r *TallyRule) { r.MsgTypeUrl = "cosmos.bank.v1beta1.MsgSend" }},
+		{name: "quorum above 1", change: func(r *TallyRule) { r.Quorum = sdk.NewDec(2) }},
+		{name: "zero threshold", change: func(r *TallyRule) { r.Threshold = sdk.ZeroDec() }},
+		{name: "missing veto threshold", change: func(r *TallyRule) { r.VetoThreshold = sdk.Dec{} }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := valid()
+			tt.change(&rule)
+			err := rule.Validate()
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestChamberRule_Validate(t *testing.T) {
+	valid := func() ChamberRule {
+		return ChamberRule{
+			Quorum:        sdk.MustNewDecFromStr("0.2"),
+			Threshold:     sdk.MustNewDecFromStr("0.667"),
+			VetoThreshold: sdk.MustNewDecFromStr("0.334"),
+		}
+	}
+
+	tests := []struct {
+		name   string
+		change func(r *ChamberRule)
+		valid  bool
+	}{
+		{name: "valid rule", change: func(r *ChamberRule) {}, valid: true},
+		{name: "unset rule", change: func(r *ChamberRule) { *r = NewUnsetChamberRule() }, valid: true},
+		{name: "unset rule with a quorum", change: func(r *ChamberRule) { r.Threshold = sdk.ZeroDec() }},
+		{name: "quorum above 1", change: func(r *ChamberRule) { r.Quorum = sdk.NewDec(2) }},
+		{name: "threshold above 1", change: func(r *ChamberRule) { r.Threshold = sdk.NewDec(2) }},
+		{name: "zero veto threshold", change: func(r *ChamberRule) { r.VetoThreshold = sdk.ZeroDec() }},
+		{name: "missing quorum", change: func(r *ChamberRule) { r.Quorum = sdk.Dec{} }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := valid()
+			tt.change(&rule)
+			err := rule.Validate()
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,474 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: membershipmodule/membership/term.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GuardianTerm is a guardian's current or most recent term in office
+type GuardianTerm struct {
+	// guardian is the address of the guardian
+	Guardian string `protobuf:"bytes,1,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// start_time is the time the term began
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time is the time the term expires, or the time it was cut short. It
+	// is unset if the term began while term limits were disabled.
+	EndTime time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// consecutive_terms is the number of consecutive terms the guardian has
+	// served, including this one
+	ConsecutiveTerms uint64 `protobuf:"varint,4,opt,name=consecutive_terms,json=consecutiveTerms,proto3" json:"consecutive_terms,omitempty"`
+}
+
+func (m *GuardianTerm) Reset()         { *m = GuardianTerm{} }
+func (m *GuardianTerm) String() string { return proto.CompactTextString(m) }
+func (*GuardianTerm) ProtoMessage()    {}
+func (*GuardianTerm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd4bc2880d10e214, []int{0}
+}
+func (m *GuardianTerm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GuardianTerm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GuardianTerm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GuardianTerm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GuardianTerm.Merge(m, src)
+}
+func (m *GuardianTerm) XXX_Size() int {
+	return m.Size()
+}
+func (m *GuardianTerm) XXX_DiscardUnknown() {
+	xxx_messageInfo_GuardianTerm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GuardianTerm proto.InternalMessageInfo
+
+func (m *GuardianTerm) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+func (m *GuardianTerm) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *GuardianTerm) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *GuardianTerm) GetConsecutiveTerms() uint64 {
+	if m != nil {
+		return m.ConsecutiveTerms
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GuardianTerm)(nil), "membershipmodule.membership.GuardianTerm")
+}
+
+func init() {
+	proto.RegisterFile("membershipmodule/membership/term.proto", fileDescriptor_dd4bc2880d10e214)
+}
+
+var fileDescriptor_dd4bc2880d10e214 = []byte{
+	// 295 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x51, 0x3d, 0x4f, 0xc3, 0x30,
+	0x10, 0x8d, 0xa1, 0x82, 0xd6, 0x30, 0x40, 0xc4, 0x50, 0x05, 0xc9, 0xad, 0x18, 0x50, 0x24, 0x54,
+	0x5b, 0x82, 0x89, 0x09, 0xa9, 0x0c, 0xec, 0xa1, 0x13, 0x4b, 0x95, 0x8f, 0xc3, 0xb5, 0x54, 0xdb,
+	0x91, 0xed, 0x20, 0xf8, 0x17, 0xfd, 0x59, 0x1d, 0x3b, 0xb2, 0xf0, 0xa1, 0xe4, 0x8f, 0xa0, 0x24,
+	0x94, 0x16, 0x36, 0x36, 0xbf, 0xbb, 0x77, 0xef, 0xf9, 0xee, 0xe1, 0x73, 0x09, 0x32, 0x01, 0x63,
+	0x67, 0x22, 0x97, 0x3a, 0x2b, 0xe6, 0xc0, 0x36, 0x05, 0xe6, 0xc0, 0x48, 0x9a, 0x1b, 0xed, 0xb4,
+	0x7f, 0xfa, 0x97, 0x47, 0x37, 0x85, 0xe0, 0x84, 0x6b, 0xae, 0x1b, 0x1e, 0xab, 0x5f, 0xed, 0x48,
+	0x30, 0xe0, 0x5a, 0xf3, 0x39, 0xb0, 0x06, 0x25, 0xc5, 0x23, 0x73, 0x42, 0x82, 0x75, 0xb1, 0xcc,
+	0x5b, 0xc2, 0xd9, 0x1b, 0xc2, 0x87, 0x77, 0x45, 0x6c, 0x32, 0x11, 0xab, 0x09, 0x18, 0xe9, 0x07,
+	0xb8, 0xcb, 0xbf, 0x71, 0x1f, 0x0d, 0x51, 0xd8, 0x8b, 0x7e, 0xb0, 0x7f, 0x8b, 0xb1, 0x75, 0xb1,
+	0x71, 0xd3, 0x5a, 0xa5, 0xbf, 0x33, 0x44, 0xe1, 0xc1, 0x65, 0x40, 0x5b, 0x0b, 0xba, 0xb6, 0xa0,
+	0x93, 0xb5, 0xc5, 0xb8, 0xbb, 0x7c, 0x1f, 0x78, 0x8b, 0x8f, 0x01, 0x8a, 0x7a, 0xcd, 0x5c, 0xdd,
+	0xf1, 0x6f, 0x70, 0x17, 0x54, 0xd6, 0x4a, 0xec, 0xfe, 0x43, 0x62, 0x1f, 0x54, 0xd6, 0x08, 0x5c,
+	0xe0, 0xe3, 0x54, 0x2b, 0x0b, 0x69, 0xe1, 0xc4, 0x13, 0x4c, 0xeb, 0x03, 0xd9, 0x7e, 0x67, 0x88,
+	0xc2, 0x4e, 0x74, 0xb4, 0xd5, 0xa8, 0xb7, 0xb1, 0xe3, 0xfb, 0x65, 0x49, 0xd0, 0xaa, 0x24, 0xe8,
+	0xb3, 0x24, 0x68, 0x51, 0x11, 0x6f, 0x55, 0x11, 0xef, 0xb5, 0x22, 0xde, 0xc3, 0x35, 0x17, 0x6e,
+	0x56, 0x24, 0x34, 0xd5, 0x92, 0x29, 0x6d, 0x44, 0x3c, 0x52, 0xe0, 0x58, 0x7b, 0xd8, 0xd1, 0x56,
+	0x00, 0xcf, 0xbf, 0xd2, 0x78, 0xc9, 0xc1, 0x26, 0x7b, 0xcd, 0x47, 0xaf, 0xbe, 0x06, 0x00, 0xfc,
+	0x6a, 0x0f, 0xd3, 0xb9, 0x01, 0x00, 0x00,
+}
+
+func (m *GuardianTerm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GuardianTerm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GuardianTerm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsecutiveTerms != 0 {
+		i = encodeVarintTerm(dAtA, i, uint64(m.ConsecutiveTerms))
+		i--
+		dAtA[i] = 0x20
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTerm(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTerm(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintTerm(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTerm(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTerm(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GuardianTerm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovTerm(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTerm(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTerm(uint64(l))
+	if m.ConsecutiveTerms != 0 {
+		n += 1 + sovTerm(uint64(m.ConsecutiveTerms))
+	}
+	return n
+}
+
+func sovTerm(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTerm(x uint64) (n int) {
+	return sovTerm(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GuardianTerm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTerm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GuardianTerm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GuardianTerm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTerm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTerm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTerm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTerm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTerm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTerm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTerm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTerm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTerm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveTerms", wireType)
+			}
+			m.ConsecutiveTerms = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTerm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveTerms |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTerm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTerm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTerm(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTerm
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTerm
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTerm
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTerm
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTerm
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTerm
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTerm        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTerm          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTerm = fmt.Errorf("proto: unexpected end of group")
+)
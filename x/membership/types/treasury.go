@@ -0,0 +1,32 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VestedAmount returns the part of the spend's amount that is due by the
+// given time. Streamed spends vest linearly between their start and end time,
+// and other spends vest at once.
+func (s TreasurySpend) VestedAmount(t time.Time) sdk.Coins {
+	if !t.Before(s.EndTime) {
+		return s.Amount
+	}
+	if !t.After(s.StartTime) {
+		return sdk.NewCoins()
+	}
+
+	elapsed := sdk.NewInt(t.Sub(s.StartTime).Nanoseconds())
+	duration := sdk.NewInt(s.EndTime.Sub(s.StartTime).Nanoseconds())
+	vested := sdk.NewCoins()
+	for _, coin := range s.Amount {
+		vested = vested.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(elapsed).Quo(duration)))
+	}
+	return vested
+}
+
+// Remaining returns the part of the spend's amount that has not been paid out
+func (s TreasurySpend) Remaining() sdk.Coins {
+	return s.Amount.Sub(s.Paid...)
+}
@@ -0,0 +1,35 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noria-net/module-membership/x/membership/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTreasurySpendVestedAmount(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000), sdk.NewInt64Coin("token", 3))
+	streamed := types.TreasurySpend{Amount: amount, StartTime: start, EndTime: start.Add(100 * time.Hour)}
+
+	// Nothing vests before the stream starts
+	require.True(t, streamed.VestedAmount(start).IsZero())
+	require.True(t, streamed.VestedAmount(start.Add(-time.Hour)).IsZero())
+
+	// Streams vest linearly, rounding down
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 250)), streamed.VestedAmount(start.Add(25*time.Hour)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 500), sdk.NewInt64Coin("token", 1)), streamed.VestedAmount(start.Add(50*time.Hour)))
+
+	// Everything has vested once the stream ends
+	require.Equal(t, amount, streamed.VestedAmount(start.Add(100*time.Hour)))
+	require.Equal(t, amount, streamed.VestedAmount(start.Add(200*time.Hour)))
+
+	// Spends that are not streamed vest at once
+	immediate := types.TreasurySpend{Amount: amount, StartTime: start, EndTime: start}
+	require.Equal(t, amount, immediate.VestedAmount(start))
+
+	streamed.Paid = sdk.NewCoins(sdk.NewInt64Coin("stake", 400))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 600), sdk.NewInt64Coin("token", 3)), streamed.Remaining())
+}